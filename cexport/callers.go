@@ -0,0 +1,60 @@
+// Copyright 2023 Peter Hebert. Licensed under the MIT license.
+
+package main
+
+// Test files can't use cgo, so the functions here call the exported functions
+// the way a C caller would, for main_test.go.
+
+// #include <stdint.h>
+// #include <stdlib.h>
+import "C"
+
+import "unsafe"
+
+// callEncode calls gotoken_encode, and returns the tokens, the return code,
+// and the error message, freeing the buffers with gotoken_free.
+func callEncode(encoding, text string) ([]int, int, string) {
+	cEncoding := C.CString(encoding)
+	defer C.free(unsafe.Pointer(cEncoding))
+	cText := C.CString(text)
+	defer C.free(unsafe.Pointer(cText))
+
+	var tokens *C.int32_t
+	var nTokens C.size_t
+	var errOut *C.char
+	code := gotoken_encode(cEncoding, cText, C.size_t(len(text)), &tokens, &nTokens, &errOut)
+	if errOut != nil {
+		defer gotoken_free(unsafe.Pointer(errOut))
+		return nil, int(code), C.GoString(errOut)
+	}
+	defer gotoken_free(unsafe.Pointer(tokens))
+	result := make([]int, int(nTokens))
+	for i, t := range unsafe.Slice(tokens, int(nTokens)) {
+		result[i] = int(t)
+	}
+	return result, int(code), ""
+}
+
+// callDecode calls gotoken_decode, and returns the text, the return code, and
+// the error message, freeing the buffers with gotoken_free.
+func callDecode(encoding string, tokens []int) (string, int, string) {
+	cEncoding := C.CString(encoding)
+	defer C.free(unsafe.Pointer(cEncoding))
+	cTokens := (*C.int32_t)(C.malloc(C.size_t(len(tokens)+1) * C.sizeof_int32_t))
+	defer C.free(unsafe.Pointer(cTokens))
+	buf := unsafe.Slice(cTokens, len(tokens))
+	for i, t := range tokens {
+		buf[i] = C.int32_t(t)
+	}
+
+	var text *C.char
+	var textLen C.size_t
+	var errOut *C.char
+	code := gotoken_decode(cEncoding, cTokens, C.size_t(len(tokens)), &text, &textLen, &errOut)
+	if errOut != nil {
+		defer gotoken_free(unsafe.Pointer(errOut))
+		return "", int(code), C.GoString(errOut)
+	}
+	defer gotoken_free(unsafe.Pointer(text))
+	return C.GoStringN(text, C.int(textLen)), int(code), ""
+}
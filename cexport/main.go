@@ -0,0 +1,234 @@
+// Copyright 2023 Peter Hebert. Licensed under the MIT license.
+
+// Command cexport exports gotoken as a C library, for use from C, C++, Rust,
+// Python, or any other language with a C foreign function interface. Build it
+// with:
+//
+//	go build -buildmode=c-shared -o libgotoken.so ./cexport
+//
+// This writes libgotoken.so and a header, libgotoken.h, that declares:
+//
+//	int gotoken_encode(char* encoding, char* text, size_t textLen,
+//	                   int32_t** tokens, size_t* nTokens, char** errOut);
+//	int gotoken_decode(char* encoding, int32_t* tokens, size_t nTokens,
+//	                   char** text, size_t* textLen, char** errOut);
+//	int gotoken_count(char* encoding, char* text, size_t textLen,
+//	                  size_t* count, char** errOut);
+//	void gotoken_free(void* p);
+//
+// Each function returns GOTOKEN_OK (0) on success, or one of the GOTOKEN_ERR_*
+// codes defined in the header. GOTOKEN_ERR_TOO_LARGE means a length passed in
+// is too large for Go, and GOTOKEN_ERR_NO_MEMORY that malloc failed. Encoding
+// names are NUL-terminated, like "cl100k_base". Text is passed as a pointer and
+// length, and does not need to be NUL-terminated.
+//
+// Memory ownership: the library never keeps or frees memory passed in by the
+// caller. Output buffers (*tokens, *text, and *errOut) are allocated by the
+// library with malloc, and the caller owns them and must release them with
+// gotoken_free. The text returned by gotoken_decode is also NUL-terminated,
+// for convenience. If errOut is not NULL and a call fails, *errOut is set to
+// an error message; otherwise *errOut is set to NULL. Other outputs are not set
+// when a call fails.
+//
+// Tokenizers use the default options, so special tokens like "<|endoftext|>"
+// in the input cause GOTOKEN_ERR_SPECIAL_TOKEN. All functions are safe to call
+// from multiple threads.
+package main
+
+/*
+#include <stdint.h>
+#include <stdlib.h>
+
+#define GOTOKEN_OK 0
+#define GOTOKEN_ERR_UNKNOWN_ENCODING 1
+#define GOTOKEN_ERR_SPECIAL_TOKEN 2
+#define GOTOKEN_ERR_INVALID_TOKEN 3
+#define GOTOKEN_ERR_OTHER 4
+#define GOTOKEN_ERR_TOO_LARGE 5
+#define GOTOKEN_ERR_NO_MEMORY 6
+*/
+import "C"
+
+import (
+	"errors"
+	"math"
+	"sync"
+	"unsafe"
+
+	"github.com/peterheb/gotoken"
+	_ "github.com/peterheb/gotoken/cl100kbase"
+	_ "github.com/peterheb/gotoken/p50kbase"
+	_ "github.com/peterheb/gotoken/r50kbase"
+)
+
+// main is required by -buildmode=c-shared, but is never called.
+func main() {}
+
+//export gotoken_encode
+func gotoken_encode(encoding *C.char, text *C.char, textLen C.size_t, tokens **C.int32_t, nTokens *C.size_t, errOut **C.char) C.int {
+	input, err := goString(text, textLen)
+	if err != nil {
+		return setError(errOut, err)
+	}
+	encoded, err := encode(C.GoString(encoding), input)
+	if err != nil {
+		return setError(errOut, err)
+	}
+
+	p := (*C.int32_t)(C.malloc(C.size_t(len(encoded)+1) * C.sizeof_int32_t))
+	if p == nil {
+		return setError(errOut, errNoMemory)
+	}
+	buf := unsafe.Slice(p, len(encoded))
+	for i, t := range encoded {
+		buf[i] = C.int32_t(t)
+	}
+	*tokens = p
+	*nTokens = C.size_t(len(encoded))
+	return setError(errOut, nil)
+}
+
+//export gotoken_decode
+func gotoken_decode(encoding *C.char, tokens *C.int32_t, nTokens C.size_t, text **C.char, textLen *C.size_t, errOut **C.char) C.int {
+	if uint64(nTokens) > math.MaxInt/4 { // 4 is the size of an int32_t
+		return setError(errOut, errTooLarge)
+	}
+	input := make([]int, int(nTokens))
+	if nTokens > 0 {
+		for i, t := range unsafe.Slice(tokens, int(nTokens)) {
+			input[i] = int(t)
+		}
+	}
+	decoded, err := decode(C.GoString(encoding), input)
+	if err != nil {
+		return setError(errOut, err)
+	}
+
+	p := cString(decoded)
+	if p == nil {
+		return setError(errOut, errNoMemory)
+	}
+	*text = p
+	*textLen = C.size_t(len(decoded))
+	return setError(errOut, nil)
+}
+
+//export gotoken_count
+func gotoken_count(encoding *C.char, text *C.char, textLen C.size_t, count *C.size_t, errOut **C.char) C.int {
+	input, err := goString(text, textLen)
+	if err != nil {
+		return setError(errOut, err)
+	}
+	encoded, err := encode(C.GoString(encoding), input)
+	if err != nil {
+		return setError(errOut, err)
+	}
+	*count = C.size_t(len(encoded))
+	return setError(errOut, nil)
+}
+
+//export gotoken_free
+func gotoken_free(p unsafe.Pointer) {
+	C.free(p)
+}
+
+var (
+	errTooLarge = errors.New("length is too large")
+	errNoMemory = errors.New("out of memory")
+)
+
+var (
+	tokenizers   = make(map[string]gotoken.Tokenizer)
+	tokenizersMu sync.Mutex
+)
+
+// tokenizer returns the shared tokenizer for an encoding, creating it if
+// needed.
+func tokenizer(encoding string) (gotoken.Tokenizer, error) {
+	tokenizersMu.Lock()
+	defer tokenizersMu.Unlock()
+	if tok, ok := tokenizers[encoding]; ok {
+		return tok, nil
+	}
+	tok, err := gotoken.GetTokenizer(encoding)
+	if err != nil {
+		return nil, err
+	}
+	tokenizers[encoding] = tok
+	return tok, nil
+}
+
+func encode(encoding, text string) ([]int, error) {
+	tok, err := tokenizer(encoding)
+	if err != nil {
+		return nil, err
+	}
+	return tok.Encode(text)
+}
+
+func decode(encoding string, tokens []int) (string, error) {
+	tok, err := tokenizer(encoding)
+	if err != nil {
+		return "", err
+	}
+	return tok.Decode(tokens)
+}
+
+// errorCode returns the GOTOKEN_ERR_* code for err, or GOTOKEN_OK if err is
+// nil.
+func errorCode(err error) C.int {
+	switch {
+	case err == nil:
+		return C.GOTOKEN_OK
+	case errors.Is(err, gotoken.ErrUnknownEncoding):
+		return C.GOTOKEN_ERR_UNKNOWN_ENCODING
+	case errors.Is(err, gotoken.ErrSpecialToken):
+		return C.GOTOKEN_ERR_SPECIAL_TOKEN
+	case errors.Is(err, gotoken.ErrInvalidToken):
+		return C.GOTOKEN_ERR_INVALID_TOKEN
+	case errors.Is(err, errTooLarge):
+		return C.GOTOKEN_ERR_TOO_LARGE
+	case errors.Is(err, errNoMemory):
+		return C.GOTOKEN_ERR_NO_MEMORY
+	default:
+		return C.GOTOKEN_ERR_OTHER
+	}
+}
+
+// setError stores the message of err in *errOut, if errOut is not NULL, and
+// returns the error code for err.
+func setError(errOut **C.char, err error) C.int {
+	if errOut != nil {
+		*errOut = nil
+		if err != nil {
+			*errOut = cString(err.Error())
+		}
+	}
+	return errorCode(err)
+}
+
+// goString returns a copy of the textLen bytes at text. Unlike C.GoStringN,
+// the length is not narrowed to a C int, so lengths over 2 GB work on 64-bit
+// systems, and lengths that don't fit in a Go int are an error.
+func goString(text *C.char, textLen C.size_t) (string, error) {
+	if textLen == 0 {
+		return "", nil
+	}
+	if uint64(textLen) > math.MaxInt {
+		return "", errTooLarge
+	}
+	return string(unsafe.Slice((*byte)(unsafe.Pointer(text)), int(textLen))), nil
+}
+
+// cString returns a malloc'd, NUL-terminated copy of s, or nil if malloc
+// fails. Unlike C.CString, s may contain NUL bytes.
+func cString(s string) *C.char {
+	p := C.malloc(C.size_t(len(s) + 1))
+	if p == nil {
+		return nil
+	}
+	buf := unsafe.Slice((*byte)(p), len(s)+1)
+	copy(buf, s)
+	buf[len(s)] = 0
+	return (*C.char)(p)
+}
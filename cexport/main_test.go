@@ -0,0 +1,58 @@
+// Copyright 2023 Peter Hebert. Licensed under the MIT license.
+
+//go:build cgo
+
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestEncodeDecode(t *testing.T) {
+	tokens, err := encode("cl100k_base", "hello world")
+	if err != nil || !reflect.DeepEqual(tokens, []int{15339, 1917}) {
+		t.Errorf("encode() got %v, %v; expected [15339 1917]", tokens, err)
+	}
+	text, err := decode("cl100k_base", tokens)
+	if err != nil || text != "hello world" {
+		t.Errorf("decode() got %q, %v; expected \"hello world\"", text, err)
+	}
+}
+
+func TestErrorCode(t *testing.T) {
+	_, errUnknown := encode("nope", "x")
+	_, errSpecial := encode("r50k_base", "<|endoftext|>")
+	_, errInvalid := decode("r50k_base", []int{-1})
+	tests := []struct {
+		err  error
+		want int
+	}{
+		{nil, 0}, {errUnknown, 1}, {errSpecial, 2}, {errInvalid, 3},
+		{errTooLarge, 5}, {errNoMemory, 6},
+	}
+	for _, tc := range tests {
+		if got := int(errorCode(tc.err)); got != tc.want {
+			t.Errorf("errorCode(%v) got %d; expected %d", tc.err, got, tc.want)
+		}
+	}
+}
+
+func TestExported(t *testing.T) {
+	tokens, code, msg := callEncode("cl100k_base", "hello world")
+	if code != 0 || msg != "" || !reflect.DeepEqual(tokens, []int{15339, 1917}) {
+		t.Errorf("gotoken_encode() got %v, %d, %q; expected [15339 1917], 0", tokens, code, msg)
+	}
+	text, code, msg := callDecode("cl100k_base", tokens)
+	if code != 0 || msg != "" || text != "hello world" {
+		t.Errorf("gotoken_decode() got %q, %d, %q; expected \"hello world\", 0", text, code, msg)
+	}
+
+	// Errors return a code and a message, and no other outputs
+	if _, code, msg := callEncode("r50k_base", "<|endoftext|>"); code != 2 || msg == "" {
+		t.Errorf("gotoken_encode() special token got %d, %q; expected 2 and a message", code, msg)
+	}
+	if _, code, msg := callDecode("nope", []int{1}); code != 1 || msg == "" {
+		t.Errorf("gotoken_decode() unknown encoding got %d, %q; expected 1 and a message", code, msg)
+	}
+}
@@ -0,0 +1,17 @@
+// Copyright 2023 Peter Hebert. Licensed under the MIT license.
+
+//go:build !cgo
+
+package main
+
+import (
+	"fmt"
+	"os"
+)
+
+// main is defined without cgo so that the package still builds, and go vet
+// and go test work across the module; the library itself requires cgo.
+func main() {
+	fmt.Fprintln(os.Stderr, "cexport must be built with cgo and -buildmode=c-shared")
+	os.Exit(1)
+}
@@ -0,0 +1,121 @@
+// Copyright 2023 Peter Hebert. Licensed under the MIT license.
+
+package gotoken
+
+import (
+	"fmt"
+	"strings"
+)
+
+// The special tokens that frame the messages of a ChatML conversation, in
+// encodings that have them, like cl100k_base.
+const (
+	imStart = "<|im_start|>"
+	imEnd   = "<|im_end|>"
+)
+
+// ChatMessage is a message in a conversation encoded by [ChatML].
+type ChatMessage struct {
+	Role    string // the author of the message, like "system" or "user"
+	Name    string // the name of the author, if any
+	Content string // the text of the message
+}
+
+// ChatML encodes a conversation in the ChatML format, which frames each
+// message with special tokens:
+//
+//	<|im_start|>user
+//	Hello!<|im_end|>
+//
+// It keeps the tokens of the conversation so far, so the token count of a
+// prompt is exact, rather than estimated from the lengths of its messages.
+// Build a prompt by calling Add for each message and then Reply for the role
+// the model is to answer as. A ChatML is created with [NewChatML].
+type ChatML struct {
+	tok        Tokenizer
+	start, end int
+	tokens     []int
+}
+
+// NewChatML returns an empty ChatML that encodes with tok. The encoding of tok
+// must define the "<|im_start|>" and "<|im_end|>" special tokens. They are
+// added as tokens regardless of the options of tok; special tokens in the
+// messages are handled as they are by [Tokenizer.Encode].
+func NewChatML(tok Tokenizer) (*ChatML, error) {
+	start, ok := tok.SpecialTokenID(imStart)
+	if !ok {
+		return nil, fmt.Errorf("encoding %s has no %s token", tok.Name(), imStart)
+	}
+	end, ok := tok.SpecialTokenID(imEnd)
+	if !ok {
+		return nil, fmt.Errorf("encoding %s has no %s token", tok.Name(), imEnd)
+	}
+	return &ChatML{tok: tok, start: start, end: end}, nil
+}
+
+// Add appends msg to the conversation and returns the number of tokens that it
+// took. The header line of the message is its role, followed by " name=" and
+// its name if it has one. If msg can't be encoded, the conversation is
+// unchanged and an error is returned.
+func (c *ChatML) Add(msg ChatMessage) (int, error) {
+	header, err := chatMLHeader(msg.Role, msg.Name)
+	if err != nil {
+		return 0, err
+	}
+	tokens, err := c.tok.Encode(header + "\n" + msg.Content)
+	if err != nil {
+		return 0, fmt.Errorf("encoding %s message: %w", msg.Role, err)
+	}
+	newline, err := c.tok.Encode("\n")
+	if err != nil {
+		return 0, err
+	}
+	n := len(c.tokens)
+	c.tokens = append(c.tokens, c.start)
+	c.tokens = append(c.tokens, tokens...)
+	c.tokens = append(c.tokens, c.end)
+	c.tokens = append(c.tokens, newline...)
+	return len(c.tokens) - n, nil
+}
+
+// Reply appends the start of a message from role, which the model is to
+// complete, and returns the number of tokens that it took. After Reply, Tokens
+// returns the complete prompt.
+func (c *ChatML) Reply(role string) (int, error) {
+	header, err := chatMLHeader(role, "")
+	if err != nil {
+		return 0, err
+	}
+	tokens, err := c.tok.Encode(header + "\n")
+	if err != nil {
+		return 0, fmt.Errorf("encoding %s reply: %w", role, err)
+	}
+	c.tokens = append(c.tokens, c.start)
+	c.tokens = append(c.tokens, tokens...)
+	return len(tokens) + 1, nil
+}
+
+// Tokens returns the tokens of the conversation so far. The slice is shared
+// with c until the next call to Add or Reply.
+func (c *ChatML) Tokens() []int {
+	return c.tokens
+}
+
+// Count returns the number of tokens in the conversation so far.
+func (c *ChatML) Count() int {
+	return len(c.tokens)
+}
+
+// chatMLHeader returns the header line of a message, without its line break.
+func chatMLHeader(role, name string) (string, error) {
+	if role == "" {
+		return "", fmt.Errorf("message has no role")
+	}
+	if strings.ContainsAny(role+name, "\r\n") {
+		return "", fmt.Errorf("role or name of %q message contains a line break", role)
+	}
+	if name != "" {
+		return role + " name=" + name, nil
+	}
+	return role, nil
+}
@@ -0,0 +1,38 @@
+// Copyright 2023 Peter Hebert. Licensed under the MIT license.
+
+package gotoken
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestNewChatML(t *testing.T) {
+	tok, err := GetTokenizer("runes")
+	if err != nil {
+		t.Fatalf("GetTokenizer('runes'): %v", err)
+	}
+	_, err = NewChatML(tok)
+	if err == nil || !strings.Contains(err.Error(), "no <|im_start|> token") {
+		t.Errorf("NewChatML() with no ChatML tokens: err = %v", err)
+	}
+}
+
+func TestChatMLHeader(t *testing.T) {
+	tests := []struct {
+		role, name, want string
+		wantErr          bool
+	}{
+		{"user", "", "user", false},
+		{"system", "example_user", "system name=example_user", false},
+		{"", "", "", true},
+		{"user\n", "", "", true},
+		{"user", "a\r\nb", "", true},
+	}
+	for _, tt := range tests {
+		got, err := chatMLHeader(tt.role, tt.name)
+		if got != tt.want || (err != nil) != tt.wantErr {
+			t.Errorf("chatMLHeader(%q, %q) = %q, %v; want %q, error %v", tt.role, tt.name, got, err, tt.want, tt.wantErr)
+		}
+	}
+}
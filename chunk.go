@@ -0,0 +1,115 @@
+// Copyright 2023 Peter Hebert. Licensed under the MIT license.
+
+package gotoken
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// DefaultChunkTokens is the default maximum number of tokens in a chunk
+// returned by [Chunk], which is the input limit of OpenAI's text embedding
+// models.
+const DefaultChunkTokens = 8191
+
+// Chunk splits text into chunks of at most maxTokens tokens each, for example
+// to compute embeddings of a document that is longer than the model's input
+// limit. If maxTokens <= 0, [DefaultChunkTokens] is used.
+//
+// Chunks end at the best boundary in the second half of the tokens that would
+// fit: preferably the end of a paragraph, then the end of a line, a sentence,
+// or a word, and otherwise any token that starts a new character. No text is
+// dropped, so concatenating the chunks gives text, and each chunk encodes to
+// at most maxTokens tokens on its own, unless maxTokens is too small to hold a
+// single character. Special tokens in text are handled as they are by
+// [Tokenizer.Encode].
+func Chunk(tok Tokenizer, text string, maxTokens int) ([]string, error) {
+	if maxTokens <= 0 {
+		maxTokens = DefaultChunkTokens
+	}
+	tokens, offsets, err := tok.EncodeWithOffsets(text)
+	if err != nil {
+		return nil, err
+	}
+	// offset returns where token i starts, including the end of text for
+	// i == len(tokens)
+	offset := func(i int) int {
+		if i == len(tokens) {
+			return len(text)
+		}
+		return offsets[i]
+	}
+
+	var chunks []string
+	for start := 0; start < len(tokens); {
+		end := len(tokens)
+		for limit := maxTokens; ; {
+			if end-start > limit {
+				end = chunkCut(text, offsets, start, start+limit)
+			}
+			// Encoded on its own, a chunk can be split into tokens
+			// differently at its ends, so check that it still fits
+			if end-start <= 1 || tok.Count(text[offset(start):offset(end)]) <= maxTokens {
+				break
+			}
+			limit = end - start - 1
+		}
+		chunks = append(chunks, text[offset(start):offset(end)])
+		start = end
+	}
+	return chunks, nil
+}
+
+// Boundary scores for chunkCut, from best to worst. A cut in the middle of a
+// character is never chosen if there is any other.
+const (
+	cutParagraph = 5 - iota
+	cutLine
+	cutSentence
+	cutWord
+	cutRune
+	cutNone
+)
+
+// chunkCut returns the index of the token to end a chunk of text before, which
+// is in (start, limit]. It picks the last token with the best boundary score in
+// the second half of that range, or in all of it if the second half has no
+// token that starts a character.
+func chunkCut(text string, offsets []int, start, limit int) int {
+	best, bestScore := limit, cutNone
+	for i := limit; i > start; i-- {
+		if i <= start+(limit-start)/2 && bestScore > cutNone {
+			break
+		}
+		if score := cutScore(text, offsets[i]); score > bestScore {
+			best, bestScore = i, score
+		}
+	}
+	return best
+}
+
+// cutScore returns how good a place offset is to end a chunk of text, which is
+// in (0, len(text)).
+func cutScore(text string, offset int) int {
+	before, after := text[:offset], text[offset:]
+	switch {
+	case !utf8.RuneStart(after[0]):
+		return cutNone
+	case strings.HasSuffix(before, "\n\n") || strings.HasSuffix(before, "\n\r\n"):
+		return cutParagraph
+	case before[len(before)-1] == '\n':
+		return cutLine
+	case endsSentence(before) && (after[0] == ' ' || after[0] == '\t'):
+		return cutSentence
+	case after[0] == ' ' || after[0] == '\t':
+		return cutWord
+	}
+	return cutRune
+}
+
+// endsSentence reports whether s ends with sentence punctuation, optionally
+// followed by a closing quote or parenthesis.
+func endsSentence(s string) bool {
+	s = strings.TrimRight(s, `"')]`)
+	return strings.HasSuffix(s, ".") || strings.HasSuffix(s, "!") || strings.HasSuffix(s, "?")
+}
@@ -0,0 +1,62 @@
+// Copyright 2023 Peter Hebert. Licensed under the MIT license.
+
+package gotoken
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestChunk(t *testing.T) {
+	tok, err := GetTokenizer("runes")
+	if err != nil {
+		t.Fatalf("GetTokenizer('runes'): %v", err)
+	}
+
+	// The runes tokenizer has one token per rune, so maxTokens is in runes
+	tests := []struct {
+		text      string
+		maxTokens int
+		want      []string
+	}{
+		{"", 10, nil},
+		{"short", 10, []string{"short"}},
+		{"one two three four", 10, []string{"one two", " three", " four"}},
+		{"First. Then one.", 11, []string{"First.", " Then one."}},
+		{"a line\nand another", 12, []string{"a line\n", "and another"}},
+		{"para one.\n\nline\npara two", 16, []string{"para one.\n\n", "line\npara two"}},
+		{"abcdefghij", 4, []string{"abcd", "efgh", "ij"}},
+		{"日本語のテキスト", 3, []string{"日本語", "のテキ", "スト"}},
+	}
+	for _, tt := range tests {
+		got, err := Chunk(tok, tt.text, tt.maxTokens)
+		if err != nil {
+			t.Fatalf("Chunk(%q, %d): %v", tt.text, tt.maxTokens, err)
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("Chunk(%q, %d) = %q, want %q", tt.text, tt.maxTokens, got, tt.want)
+		}
+	}
+}
+
+func TestCutScore(t *testing.T) {
+	tests := []struct {
+		text   string
+		offset int
+		want   int
+	}{
+		{"a\n\nb", 3, cutParagraph},
+		{"a\n\r\nb", 4, cutParagraph},
+		{"a\nb", 2, cutLine},
+		{"end. Next", 4, cutSentence},
+		{`"Yes!" she`, 6, cutSentence},
+		{"a word", 1, cutWord},
+		{"ab", 1, cutRune},
+		{"é", 1, cutNone},
+	}
+	for _, tt := range tests {
+		if got := cutScore(tt.text, tt.offset); got != tt.want {
+			t.Errorf("cutScore(%q, %d) = %d, want %d", tt.text, tt.offset, got, tt.want)
+		}
+	}
+}
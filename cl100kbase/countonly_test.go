@@ -0,0 +1,55 @@
+// Copyright 2023 Peter Hebert. Licensed under the MIT license.
+
+//go:build gotoken_countonly
+
+package cl100kbase_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/peterheb/gotoken"
+	"github.com/peterheb/gotoken/internal"
+)
+
+// TestCountOnly checks that a count-only build encodes the same as a full
+// build, and that decoding fails. Run it with:
+//
+//	go test -tags gotoken_countonly -run CountOnly ./cl100kbase
+func TestCountOnly(t *testing.T) {
+	tpr, err := internal.NewTestPairReader(testInput, testExpected)
+	if err != nil {
+		t.Fatalf("loading test data: %v", err)
+	}
+	defer tpr.Close()
+
+	tok, err := gotoken.GetTokenizer("cl100k_base")
+	if err != nil {
+		t.Fatalf("instantiating tokenizer: %v", err)
+	}
+
+	for {
+		tc, err := tpr.Next()
+		if err != nil {
+			t.Fatalf("loading test data: %v", err)
+		}
+		if tc == nil {
+			break
+		}
+		actual, err := tok.Encode(tc.Input)
+		if err != nil {
+			t.Errorf("Encode(%q): %v", tc.Input, err)
+		}
+		if !reflect.DeepEqual(actual, tc.Expected) {
+			t.Errorf("Encode(%q) got %#v; expected %#v", tc.Input, actual, tc.Expected)
+		}
+		if count := tok.Count(tc.Input); count != len(tc.Expected) {
+			t.Errorf("Count(%q) got %d; expected %d", tc.Input, count, len(tc.Expected))
+		}
+	}
+
+	if _, err := tok.Decode([]int{1}); !errors.Is(err, gotoken.ErrCountOnly) {
+		t.Errorf("Decode: got error %v; expected %v", err, gotoken.ErrCountOnly)
+	}
+}
@@ -3,6 +3,9 @@
 package cl100kbase
 
 import (
+	"sync"
+	"time"
+
 	"github.com/peterheb/gotoken"
 	"github.com/peterheb/gotoken/internal"
 )
@@ -15,45 +18,92 @@ const (
 	FIMSuffix   = "<|fim_suffix|>"
 	IMStart     = "<|im_start|>" // these are documented in the tiktoken README
 	IMEnd       = "<|im_end|>"   // but aren't in the Python code
+	IMSep       = "<|im_sep|>"
 	EndOfPrompt = "<|endofprompt|>"
 )
 
-// pairsToToken is a lookup table that maps pairs of bytes to their token, or -1
-// if the pair is not present in the encoding. This is used to bootstrap
-// byte-pair-encoding and is generated from bytePairLookup in data.go.
-var pairsToToken []int
+// These are the token IDs of the special tokens.
+const (
+	EndOfTextID   = 100257
+	FIMPrefixID   = 100258
+	FIMMiddleID   = 100259
+	FIMSuffixID   = 100260
+	IMStartID     = 100264
+	IMEndID       = 100265
+	IMSepID       = 100266
+	EndOfPromptID = 100276
+)
 
-func init() {
-	// inflate bytePairLookup into pairsToToken
-	pairsToToken = make([]int, 65536)
-	for i := 0; i < 65536; i++ {
-		pairsToToken[i] = -1
-	}
-	for _, pair := range bytePairLookup {
-		pairsToToken[pair>>20] = int(pair) & 0xfffff
+// ChatTokens are the special tokens of OpenAI's chat format (ChatML), which
+// can be allowed with gotoken.WithSpecialTokens(cl100kbase.ChatTokens...) to
+// encode a formatted chat prompt. Only encode trusted text this way.
+var ChatTokens = []string{IMStart, IMEnd, IMSep}
+
+var (
+	baseParams     internal.BPEParams
+	baseParamsErr  error
+	baseParamsOnce sync.Once
+
+	// the complete params, shared by every tokenizer of this encoding
+	paramsCache internal.ParamsCache
+)
+
+// getBaseParams returns the BPEParams shared by the tokenizers in this
+// package, without Name, Splitter, or SpecialTokens. The data from data.go is
+// loaded and the lookup tables are built on first use. If opts name a data
+// file, the data is loaded from that instead.
+func getBaseParams(opts gotoken.TokenizerOptions) (internal.BPEParams, error) {
+	if opts.DataPath != "" {
+		return internal.ExternalParams(opts.DataFS, opts.DataPath, "cl100k_base")
 	}
+	baseParamsOnce.Do(func() {
+		var data *internal.EncodingData
+		data, baseParamsErr = loadData()
+		if baseParamsErr == nil {
+			baseParams = data.Params()
+			baseParams.DataInfo = DataInfo()
+		}
+	})
+	return baseParams, baseParamsErr
 }
 
 // getTokenizer returns a BPE tokenizer that uses the OpenAI cl100k_base
 // encoding.
-func getTokenizer(allowSpecialAsText bool, allowedSpecial []string) (gotoken.Tokenizer, error) {
-	return internal.NewBPETokenizer(&internal.BPEParams{
-		Name:        "cl100k_base",
-		Splitter:    cl100KBaseSplitter,
-		ByteEncoder: byteToToken,
-		DecoderMap:  tokenList,
-		EncoderTrie: tokenTrie,
-		SpecialTokens: map[string]int{
-			EndOfText:   100257,
-			FIMPrefix:   100258,
-			FIMMiddle:   100259,
-			FIMSuffix:   100260,
-			IMStart:     100264,
-			IMEnd:       100265,
-			EndOfPrompt: 100276,
-		},
-		BytePairLookup: pairsToToken,
-	}, allowSpecialAsText, allowedSpecial)
+func getTokenizer(opts gotoken.TokenizerOptions) (gotoken.Tokenizer, error) {
+	params, err := paramsCache.Get(opts, func() (internal.BPEParams, error) {
+		params, err := getBaseParams(opts)
+		params.Name = "cl100k_base"
+		params.Splitter = internal.CL100KBaseSpanSplitter
+		params.SpecialTokens = SpecialTokens()
+		return params, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return internal.NewBPETokenizer(params, opts)
+}
+
+// SpecialTokens returns the special tokens of this encoding, mapped to their
+// token IDs. The map is a new copy on each call.
+func SpecialTokens() map[string]int {
+	return map[string]int{
+		EndOfText:   EndOfTextID,
+		FIMPrefix:   FIMPrefixID,
+		FIMMiddle:   FIMMiddleID,
+		FIMSuffix:   FIMSuffixID,
+		IMStart:     IMStartID,
+		IMEnd:       IMEndID,
+		IMSep:       IMSepID,
+		EndOfPrompt: EndOfPromptID,
+	}
+}
+
+// DataInfo returns the source URL and SHA-256 of the .tiktoken file that the
+// data in this package was generated from, and when it was generated. In
+// gotoken_nodata builds, which embed no data, it returns the zero DataInfo.
+func DataInfo() gotoken.DataInfo {
+	generated, _ := time.Parse(time.RFC3339, dataGenerated)
+	return gotoken.DataInfo{Source: dataSource, SHA256: dataSHA256, Generated: generated}
 }
 
 func init() {
@@ -4,9 +4,12 @@ package cl100kbase_test
 
 import (
 	"bytes"
+	"fmt"
 	"os"
 	"reflect"
+	"strings"
 	"testing"
+	"unicode/utf8"
 
 	"github.com/peterheb/gotoken"
 	"github.com/peterheb/gotoken/cl100kbase"
@@ -75,6 +78,393 @@ func TestTokenizer(t *testing.T) {
 	}
 }
 
+// TestEncodeSuffix checks that splitting each sample at every byte offset and
+// joining the halves with EncodeSuffix gives the same tokens as Encode.
+func TestEncodeSuffix(t *testing.T) {
+	tpr, err := internal.NewTestPairReader(testInput, testExpected)
+	if err != nil {
+		t.Fatalf("loading test data: %v", err)
+	}
+	defer tpr.Close()
+
+	tok, err := gotoken.GetTokenizer("cl100k_base")
+	if err != nil {
+		t.Fatalf("instantiating tokenizer: %v", err)
+	}
+
+	for {
+		tc, err := tpr.Next()
+		if err != nil {
+			t.Fatalf("loading test data: %v", err)
+		}
+		if tc == nil {
+			break
+		}
+		for i := 0; i <= len(tc.Input); i++ {
+			prompt, err := tok.Encode(tc.Input[:i])
+			if err != nil {
+				t.Fatalf("Encode(%q): %v", tc.Input[:i], err)
+			}
+			actual, err := tok.EncodeSuffix(prompt, tc.Input[i:])
+			if err != nil {
+				t.Fatalf("EncodeSuffix(%q, %q): %v", tc.Input[:i], tc.Input[i:], err)
+			}
+			if !reflect.DeepEqual(actual, tc.Expected) {
+				t.Errorf("%s: EncodeSuffix(%q, %q) got %#v; expected %#v", tpr.CaseName(), tc.Input[:i], tc.Input[i:], actual, tc.Expected)
+			}
+		}
+	}
+}
+
+func TestBuildFIMPrompt(t *testing.T) {
+	tok, err := gotoken.GetTokenizer("cl100k_base")
+	if err != nil {
+		t.Fatalf("instantiating tokenizer: %v", err)
+	}
+	encode := func(s string) []int {
+		tokens, err := tok.Encode(s)
+		if err != nil {
+			t.Fatalf("Encode(%q): %v", s, err)
+		}
+		return tokens
+	}
+
+	prefix, suffix := "def add(a, b):\n    return ", "\n\nprint(add(1, 2))\n"
+	got, err := gotoken.BuildFIMPrompt(tok, prefix, suffix, 100)
+	if err != nil {
+		t.Fatalf("BuildFIMPrompt: %v", err)
+	}
+	want := append([]int{100258}, encode(prefix)...)
+	want = append(want, 100260)
+	want = append(want, encode(suffix)...)
+	want = append(want, 100259)
+	if !reflect.DeepEqual(got.Tokens, want) || got.Prefix != prefix || got.Suffix != suffix {
+		t.Errorf("BuildFIMPrompt() = %+v, want tokens %v", got, want)
+	}
+
+	// Long inputs are cut at line breaks, keeping the text next to the middle
+	var sbPrefix, sbSuffix strings.Builder
+	for i := 0; i < 40; i++ {
+		fmt.Fprintf(&sbPrefix, "prefix line %d\n", i)
+		fmt.Fprintf(&sbSuffix, "suffix line %d\n", i)
+	}
+	prefix, suffix = sbPrefix.String(), sbSuffix.String()
+	for _, maxTokens := range []int{3, 4, 20, 51, 200} {
+		got, err := gotoken.BuildFIMPrompt(tok, prefix, suffix, maxTokens)
+		if err != nil {
+			t.Fatalf("BuildFIMPrompt(%d): %v", maxTokens, err)
+		}
+		if len(got.Tokens) > maxTokens {
+			t.Errorf("BuildFIMPrompt(%d) returned %d tokens", maxTokens, len(got.Tokens))
+		}
+		if !strings.HasSuffix(prefix, got.Prefix) || !strings.HasPrefix(suffix, got.Suffix) {
+			t.Errorf("BuildFIMPrompt(%d) kept %q and %q", maxTokens, got.Prefix, got.Suffix)
+		}
+		if maxTokens > 50 && (!strings.HasPrefix(got.Prefix, "prefix") || !strings.HasSuffix(got.Suffix, "\n")) {
+			t.Errorf("BuildFIMPrompt(%d) did not cut at line breaks: %q and %q", maxTokens, got.Prefix, got.Suffix)
+		}
+		decoded, err := tok.Decode(got.Tokens)
+		if err != nil {
+			t.Fatalf("Decode: %v", err)
+		}
+		if want := cl100kbase.FIMPrefix + got.Prefix + cl100kbase.FIMSuffix + got.Suffix + cl100kbase.FIMMiddle; decoded != want {
+			t.Errorf("BuildFIMPrompt(%d) decodes to %q, want %q", maxTokens, decoded, want)
+		}
+	}
+
+	// Without line breaks, cuts are made between characters
+	got, err = gotoken.BuildFIMPrompt(tok, strings.Repeat("日本語の", 20), strings.Repeat("テキスト", 20), 30)
+	if err != nil {
+		t.Fatalf("BuildFIMPrompt: %v", err)
+	}
+	if len(got.Tokens) > 30 || got.Prefix == "" || got.Suffix == "" || !utf8.ValidString(got.Prefix) || !utf8.ValidString(got.Suffix) {
+		t.Errorf("BuildFIMPrompt() = %+v, want valid UTF-8 in 30 tokens", got)
+	}
+
+	if _, err := gotoken.BuildFIMPrompt(tok, "a", "b", 2); err == nil {
+		t.Error("BuildFIMPrompt() with maxTokens 2: no error")
+	}
+	if _, err := gotoken.BuildFIMPrompt(tok, cl100kbase.EndOfText, "b", 10); err == nil {
+		t.Error("BuildFIMPrompt() with a special token in the prefix: no error")
+	}
+}
+
+func TestChatML(t *testing.T) {
+	tok, err := gotoken.GetTokenizer("cl100k_base")
+	if err != nil {
+		t.Fatalf("instantiating tokenizer: %v", err)
+	}
+	c, err := gotoken.NewChatML(tok)
+	if err != nil {
+		t.Fatalf("NewChatML: %v", err)
+	}
+	messages := []gotoken.ChatMessage{
+		{Role: "system", Content: "You are a helpful assistant."},
+		{Role: "user", Name: "alice", Content: "Hello!"},
+	}
+	total := 0
+	for _, msg := range messages {
+		n, err := c.Add(msg)
+		if err != nil {
+			t.Fatalf("Add(%+v): %v", msg, err)
+		}
+		total += n
+	}
+	n, err := c.Reply("assistant")
+	if err != nil {
+		t.Fatalf("Reply: %v", err)
+	}
+	total += n
+	if c.Count() != total || len(c.Tokens()) != total {
+		t.Errorf("Count() = %d, len(Tokens()) = %d; want %d", c.Count(), len(c.Tokens()), total)
+	}
+
+	// The tokens are those of the whole prompt encoded with the special tokens
+	// allowed
+	prompt := "<|im_start|>system\nYou are a helpful assistant.<|im_end|>\n" +
+		"<|im_start|>user name=alice\nHello!<|im_end|>\n" +
+		"<|im_start|>assistant\n"
+	stok, err := gotoken.GetTokenizer("cl100k_base", gotoken.WithSpecialTokens(cl100kbase.ChatTokens...))
+	if err != nil {
+		t.Fatalf("instantiating tokenizer: %v", err)
+	}
+	want, err := stok.Encode(prompt)
+	if err != nil {
+		t.Fatalf("Encode(%q): %v", prompt, err)
+	}
+	if !reflect.DeepEqual(c.Tokens(), want) {
+		t.Errorf("Tokens() = %v, want %v", c.Tokens(), want)
+	}
+
+	// A message that fails to encode leaves the conversation unchanged
+	if _, err := c.Add(gotoken.ChatMessage{Role: "user", Content: cl100kbase.IMEnd}); err == nil {
+		t.Error("Add() with a special token in the content: no error")
+	}
+	if c.Count() != total {
+		t.Errorf("after an error, Count() = %d, want %d", c.Count(), total)
+	}
+}
+
+// TestChunk checks that chunks of the samples are within the limit when encoded
+// on their own, and that no text is lost.
+func TestChunk(t *testing.T) {
+	tok, err := gotoken.GetTokenizer("cl100k_base", gotoken.WithSpecialTokensAsText())
+	if err != nil {
+		t.Fatalf("instantiating tokenizer: %v", err)
+	}
+	samples, err := os.ReadFile(testInput)
+	if err != nil {
+		t.Fatalf("loading test data: %v", err)
+	}
+	text := string(samples)
+	for _, maxTokens := range []int{2, 7, 50, 500, 0} {
+		chunks, err := gotoken.Chunk(tok, text, maxTokens)
+		if err != nil {
+			t.Fatalf("Chunk(%d): %v", maxTokens, err)
+		}
+		if joined := strings.Join(chunks, ""); joined != text {
+			t.Errorf("Chunk(%d): chunks don't add up to the input", maxTokens)
+		}
+		limit := maxTokens
+		if limit == 0 {
+			limit = gotoken.DefaultChunkTokens
+		}
+		for i, chunk := range chunks {
+			if n := tok.Count(chunk); n > limit || n == 0 {
+				t.Errorf("Chunk(%d): chunk %d %q has %d tokens", maxTokens, i, chunk, n)
+			}
+		}
+	}
+}
+
+// TestStreamDecoder decodes the samples one token at a time, and checks that
+// each piece of output is valid UTF-8 and that together they are the input.
+func TestStreamDecoder(t *testing.T) {
+	tok, err := gotoken.GetTokenizer("cl100k_base", gotoken.WithSpecialTokensAsText())
+	if err != nil {
+		t.Fatalf("instantiating tokenizer: %v", err)
+	}
+	samples, err := os.ReadFile(testInput)
+	if err != nil {
+		t.Fatalf("loading test data: %v", err)
+	}
+	tokens, err := tok.Encode(string(samples))
+	if err != nil {
+		t.Fatalf("Encode: %v", err)
+	}
+
+	sd := gotoken.NewStreamDecoder(tok)
+	var sb strings.Builder
+	for i, token := range tokens {
+		text, err := sd.Decode([]int{token})
+		if err != nil {
+			t.Fatalf("Decode(%d): %v", token, err)
+		}
+		if !utf8.ValidString(text) {
+			t.Errorf("Decode() of token %d returned invalid UTF-8 %q", i, text)
+		}
+		sb.WriteString(text)
+	}
+	sb.WriteString(sd.Flush())
+	if sb.String() != string(samples) {
+		t.Errorf("streamed text is not the input")
+	}
+
+	// An incomplete character at the end is returned by Flush
+	text, err := sd.Decode(tokens[:1])
+	if err != nil {
+		t.Fatalf("Decode: %v", err)
+	}
+	emoji, err := tok.Encode("😄")
+	if err != nil {
+		t.Fatalf("Encode: %v", err)
+	}
+	if got, err := sd.Decode(emoji[:1]); got != "" || err != nil {
+		t.Errorf("Decode() of a partial emoji = %q, %v; want \"\", nil", got, err)
+	}
+	if _, err := sd.Decode([]int{-1}); err == nil {
+		t.Error("Decode() of an invalid token: no error")
+	}
+	if got, want := sd.Flush(), "😄"[:len("😄")-1]; got != want || text == "" {
+		t.Errorf("Flush() = %q, want %q", got, want)
+	}
+	if got := sd.Flush(); got != "" {
+		t.Errorf("second Flush() = %q, want \"\"", got)
+	}
+}
+
+func TestTruncate(t *testing.T) {
+	tok, err := gotoken.GetTokenizer("cl100k_base")
+	if err != nil {
+		t.Fatalf("instantiating tokenizer: %v", err)
+	}
+	// Emoji and CJK are split into tokens in the middle of characters
+	text := "Emoji 😄👋🏽 and 日本語のテキスト, then ASCII."
+	n := tok.Count(text)
+	for maxTokens := 0; maxTokens <= n; maxTokens++ {
+		got, err := gotoken.Truncate(tok, text, maxTokens)
+		if err != nil {
+			t.Fatalf("Truncate(%d): %v", maxTokens, err)
+		}
+		if !strings.HasPrefix(text, got) || !utf8.ValidString(got) || tok.Count(got) > maxTokens {
+			t.Errorf("Truncate(%d) = %q", maxTokens, got)
+		}
+		got, err = gotoken.TruncateStart(tok, text, maxTokens)
+		if err != nil {
+			t.Fatalf("TruncateStart(%d): %v", maxTokens, err)
+		}
+		if !strings.HasSuffix(text, got) || !utf8.ValidString(got) || tok.Count(got) > maxTokens {
+			t.Errorf("TruncateStart(%d) = %q", maxTokens, got)
+		}
+	}
+}
+
+func TestDescriber(t *testing.T) {
+	tok, err := gotoken.GetTokenizer("cl100k_base")
+	if err != nil {
+		t.Fatalf("instantiating tokenizer: %v", err)
+	}
+	d, ok := tok.(gotoken.Describer)
+	if !ok {
+		t.Fatalf("%T does not implement gotoken.Describer", tok)
+	}
+	if got := d.Name(); got != "cl100k_base" {
+		t.Errorf("Name() = %q; expected %q", got, "cl100k_base")
+	}
+	if got := d.VocabSize(); got != 100277 {
+		t.Errorf("VocabSize() = %d; expected %d", got, 100277)
+	}
+	if got, ok := tok.SpecialTokenID(cl100kbase.IMStart); got != 100264 || !ok {
+		t.Errorf("SpecialTokenID(%q) = %d, %v; expected %d, true", cl100kbase.IMStart, got, ok, 100264)
+	}
+	if _, ok := tok.SpecialTokenID("<|im_middle|>"); ok {
+		t.Errorf("SpecialTokenID(%q) found a token", "<|im_middle|>")
+	}
+	if got, ok := d.EOTToken(); got != cl100kbase.EndOfTextID || !ok {
+		t.Errorf("EOTToken() = %d, %v; expected %d, true", got, ok, cl100kbase.EndOfTextID)
+	}
+	info := d.DataInfo()
+	if info != cl100kbase.DataInfo() {
+		t.Errorf("DataInfo() = %+v; expected %+v", info, cl100kbase.DataInfo())
+	}
+	if !strings.HasSuffix(info.Source, "/cl100k_base.tiktoken") || len(info.SHA256) != 64 || info.Generated.IsZero() {
+		t.Errorf("DataInfo() = %+v; expected the source URL, SHA-256, and time", info)
+	}
+}
+
+func TestValidAndSpecialTokens(t *testing.T) {
+	tok, err := gotoken.GetTokenizer("cl100k_base")
+	if err != nil {
+		t.Fatalf("instantiating tokenizer: %v", err)
+	}
+	if got := tok.MaxToken(); got != 100276 {
+		t.Errorf("MaxToken() = %d; expected %d", got, 100276)
+	}
+	if got, ok := tok.SpecialTokenID(cl100kbase.IMSep); got != 100266 || !ok {
+		t.Errorf("SpecialTokenID(%q) = %d, %v; expected %d, true", cl100kbase.IMSep, got, ok, 100266)
+	}
+	tests := map[int]bool{
+		-1:     false,
+		0:      true,
+		100255: true,
+		100256: false,
+		100257: true, // <|endoftext|>
+		100261: false,
+		100263: false,
+		100264: true, // <|im_start|>
+		100266: true, // <|im_sep|>
+		100267: false,
+		100270: false,
+		100276: true, // <|endofprompt|>
+		100277: false,
+	}
+	for token, want := range tests {
+		if got := tok.IsValidToken(token); got != want {
+			t.Errorf("IsValidToken(%d) = %v; expected %v", token, got, want)
+		}
+		if got, want := tok.IsSpecialToken(token), token > 100256 && want; got != want {
+			t.Errorf("IsSpecialToken(%d) = %v; expected %v", token, got, want)
+		}
+		if _, err := tok.Decode([]int{token}); (err == nil) != want {
+			t.Errorf("Decode([]int{%d}) error = %v; IsValidToken expected %v", token, err, want)
+		}
+	}
+}
+
+// BenchmarkPieceCache compares encoding natural text with and without the
+// piece cache.
+func BenchmarkPieceCache(b *testing.B) {
+	samples, err := os.ReadFile(testInput)
+	if err != nil {
+		b.Fatalf("loading test data: %v", err)
+	}
+	lines := strings.Split(string(samples), "\n")
+
+	for _, bc := range []struct {
+		name string
+		opts []gotoken.Option
+	}{
+		{"cache", nil},
+		{"nocache", []gotoken.Option{gotoken.WithCacheSize(-1)}},
+	} {
+		b.Run(bc.name, func(b *testing.B) {
+			tok, err := gotoken.GetTokenizer("cl100k_base", append(bc.opts, gotoken.WithSpecialTokensAsText())...)
+			if err != nil {
+				b.Fatalf("instantiating tokenizer: %v", err)
+			}
+			b.SetBytes(int64(len(samples)))
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				for _, line := range lines {
+					if _, err := tok.Encode(line); err != nil {
+						b.Fatalf("Encode(%q): %v", line, err)
+					}
+				}
+			}
+		})
+	}
+}
+
 func FuzzCL100K(f *testing.F) {
 	tok, err := gotoken.GetTokenizer("cl100k_base", gotoken.WithSpecialTokensAsText())
 	if err != nil {
@@ -109,3 +499,41 @@ func FuzzCL100K(f *testing.F) {
 		}
 	})
 }
+
+func TestSpecialTokens(t *testing.T) {
+	for _, tc := range []struct {
+		encoding string
+		specials map[string]int
+	}{
+		{"cl100k_base", cl100kbase.SpecialTokens()},
+	} {
+		tok, err := gotoken.GetTokenizer(tc.encoding)
+		if err != nil {
+			t.Fatalf("instantiating tokenizer: %v", err)
+		}
+		for name, want := range tc.specials {
+			if got, ok := tok.SpecialTokenID(name); got != want || !ok {
+				t.Errorf("%s: SpecialTokenID(%q) = %d, %v; expected %d, true", tc.encoding, name, got, ok, want)
+			}
+			if got, err := tok.Decode([]int{want}); got != name || err != nil {
+				t.Errorf("%s: Decode([]int{%d}) = %q, %v; expected %q", tc.encoding, want, got, err, name)
+			}
+		}
+		n := 0
+		tok.VocabIter()(func(token int, _ []byte) bool {
+			if tok.IsSpecialToken(token) {
+				n++
+			}
+			return true
+		})
+		if n != len(tc.specials) {
+			t.Errorf("%s: %d special tokens in the vocabulary; expected %d", tc.encoding, n, len(tc.specials))
+		}
+	}
+
+	// Each call returns a new map
+	cl100kbase.SpecialTokens()[cl100kbase.EndOfText] = -1
+	if got := cl100kbase.SpecialTokens()[cl100kbase.EndOfText]; got == -1 {
+		t.Error("SpecialTokens() returned a map modified by the caller")
+	}
+}
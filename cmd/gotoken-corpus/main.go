@@ -0,0 +1,343 @@
+// Copyright 2023 Peter Hebert. Licensed under the MIT license.
+
+// Command gotoken-corpus tokenizes a directory of text and JSONL files into a
+// packed binary file of token IDs, which is the usual preprocessing step for
+// training a language model:
+//
+//	gotoken-corpus -encoding r50k_base -o data/train corpus/
+//
+// Each .txt file is one document, and so is each line of a .jsonl file, whose
+// text is the string in its "text" field (-field). Other files are skipped.
+// The arguments can be directories, which are searched recursively, or files.
+// Files are read in lexical order, so the output is the same every time.
+// Special tokens in the text are encoded as text.
+//
+// The output is written to three files named after the -o prefix, or with
+// -format megatron, to the files of a Megatron-LM indexed dataset (see below):
+//
+//   - prefix.bin has the tokens of every document, each followed by the
+//     end-of-text token (unless -eot=false), as little-endian uint16 or uint32
+//     values (-dtype). By default, the smallest type that fits every token of
+//     the encoding is used.
+//   - prefix.index.jsonl has a line for each document, with its source, its
+//     offset in prefix.bin in tokens, and its length in tokens, including the
+//     end-of-text token: {"source":"corpus/a.jsonl:3","offset":120,"tokens":57}
+//   - prefix.json describes the output: the encoding, the dtype, the
+//     end-of-text token, and the number of documents and tokens.
+//
+// With -format megatron, prefix.index.jsonl is replaced by prefix.idx, the
+// index of Megatron-LM's MMapIndexedDataset, so that prefix.bin and
+// prefix.idx can be given to Megatron-LM and the training stacks that read its
+// format as the data-path prefix, like the output of its preprocess_data.py
+// with --append-eod. Each document is one sequence. Megatron-LM has no uint32
+// dtype, so 4-byte tokens are declared as int32, which is the same bytes for
+// every token ID.
+//
+// Documents are encoded in parallel, in batches of about 16 MB.
+package main
+
+import (
+	"bufio"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"io/fs"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/peterheb/gotoken"
+	_ "github.com/peterheb/gotoken/cl100kbase"
+	_ "github.com/peterheb/gotoken/p50kbase"
+	_ "github.com/peterheb/gotoken/r50kbase"
+)
+
+var (
+	encoding = flag.String("encoding", "cl100k_base", "tokenizer `encoding` to use")
+	output   = flag.String("o", "corpus", "`prefix` of the output files")
+	format   = flag.String("format", "packed", "output `format`: packed (with a JSONL index) or megatron (.bin and .idx)")
+	dtype    = flag.String("dtype", "", "type of the token IDs in the output: uint16 or uint32 (default the smallest that fits)")
+	field    = flag.String("field", "text", "`name` of the field with the text of each document in JSONL files")
+	eot      = flag.Bool("eot", true, "write the end-of-text token after each document")
+	workers  = flag.Int("workers", 0, "number of documents to encode in parallel (default GOMAXPROCS)")
+)
+
+// batchBytes is the amount of text that is read before it is encoded, in
+// parallel, and written out.
+const batchBytes = 16 << 20
+
+func main() {
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "usage: gotoken-corpus [flags] dir|file ...\n\nFlags:\n")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	tok, err := gotoken.GetTokenizer(*encoding, gotoken.WithSpecialTokensAsText())
+	onErrFatalf(err, "create tokenizer")
+	files, err := findFiles(flag.Args())
+	onErrFatalf(err, "find files")
+	cfg := config{format: *format, dtype: *dtype, field: *field, eot: *eot, workers: *workers}
+	meta, err := writeCorpus(tok, files, *output, cfg)
+	onErrFatalf(err, "write %s", *output)
+	fmt.Printf("wrote %d tokens of %d documents from %d files to %s.bin (%s)\n",
+		meta.Tokens, meta.Documents, len(files), *output, meta.DType)
+}
+
+// config holds the settings from the flags that affect the output.
+type config struct {
+	format  string
+	dtype   string
+	field   string
+	eot     bool
+	workers int
+}
+
+// metadata is the contents of prefix.json.
+type metadata struct {
+	Encoding  string `json:"encoding"`
+	Format    string `json:"format"`
+	DType     string `json:"dtype"`
+	EOT       *int   `json:"eot"` // null if no end-of-text token was written
+	Documents int64  `json:"documents"`
+	Tokens    int64  `json:"tokens"`
+}
+
+// indexEntry is one line of prefix.index.jsonl.
+type indexEntry struct {
+	Source string `json:"source"`
+	Offset int64  `json:"offset"`
+	Tokens int    `json:"tokens"`
+}
+
+// document is the text of one document, and where it came from.
+type document struct {
+	source string
+	text   string
+}
+
+// findFiles returns the .txt and .jsonl files in paths, which are files or
+// directories to search, in lexical order.
+func findFiles(paths []string) ([]string, error) {
+	var files []string
+	for _, path := range paths {
+		err := filepath.WalkDir(path, func(p string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if !d.IsDir() && (strings.HasSuffix(p, ".txt") || strings.HasSuffix(p, ".jsonl")) {
+				files = append(files, p)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	sort.Strings(files)
+	return files, nil
+}
+
+// writeCorpus encodes the documents in files with tok, and writes the output
+// files named after prefix.
+func writeCorpus(tok gotoken.Tokenizer, files []string, prefix string, cfg config) (metadata, error) {
+	meta := metadata{Encoding: tok.Name(), Format: cfg.format, DType: cfg.dtype}
+	if meta.Format == "" {
+		meta.Format = "packed"
+	}
+	if meta.Format != "packed" && meta.Format != "megatron" {
+		return meta, fmt.Errorf("unknown format %q", meta.Format)
+	}
+	if meta.DType == "" {
+		meta.DType = "uint32"
+		if tok.MaxToken() <= 0xffff {
+			meta.DType = "uint16"
+		}
+	}
+	width := 4
+	switch meta.DType {
+	case "uint16":
+		width = 2
+		if tok.MaxToken() > 0xffff {
+			return meta, fmt.Errorf("%s has tokens up to %d, which don't fit in uint16", tok.Name(), tok.MaxToken())
+		}
+	case "uint32":
+	default:
+		return meta, fmt.Errorf("unknown dtype %q", meta.DType)
+	}
+	if cfg.eot {
+		token, ok := tok.EOTToken()
+		if !ok {
+			return meta, fmt.Errorf("%s has no end-of-text token; use -eot=false", tok.Name())
+		}
+		meta.EOT = &token
+	}
+
+	bin, err := os.Create(prefix + ".bin")
+	if err != nil {
+		return meta, err
+	}
+	defer bin.Close()
+	binW := bufio.NewWriter(bin)
+
+	// The JSONL index is written as documents are encoded; the Megatron index
+	// starts with its sizes, so they are kept until the end
+	var index *os.File
+	var indexW *bufio.Writer
+	var enc *json.Encoder
+	var sizes []int32
+	if meta.Format == "packed" {
+		index, err = os.Create(prefix + ".index.jsonl")
+		if err != nil {
+			return meta, err
+		}
+		defer index.Close()
+		indexW = bufio.NewWriter(index)
+		enc = json.NewEncoder(indexW)
+	}
+
+	// Encode the documents in batches, writing each batch in order
+	var batch []document
+	size := 0
+	buf := make([]byte, 0, 64<<10)
+	flush := func() error {
+		inputs := make([]string, len(batch))
+		for i, doc := range batch {
+			inputs[i] = doc.text
+		}
+		results, err := tok.EncodeBatch(inputs, cfg.workers)
+		var batchErr *gotoken.BatchError
+		if errors.As(err, &batchErr) {
+			for i, err := range batchErr.Errs {
+				if err != nil {
+					return fmt.Errorf("encode %s: %w", batch[i].source, err)
+				}
+			}
+		} else if err != nil {
+			return err
+		}
+		for i, tokens := range results {
+			if meta.EOT != nil {
+				tokens = append(tokens, *meta.EOT)
+			}
+			// The first two bytes of a little-endian uint32 are the uint16 of
+			// the same value, if it fits
+			buf = buf[:0]
+			var b [4]byte
+			for _, token := range tokens {
+				binary.LittleEndian.PutUint32(b[:], uint32(token))
+				buf = append(buf, b[:width]...)
+			}
+			if _, err := binW.Write(buf); err != nil {
+				return err
+			}
+			if enc == nil {
+				if len(tokens) > math.MaxInt32 {
+					return fmt.Errorf("%s has %d tokens, more than a Megatron-LM sequence can have", batch[i].source, len(tokens))
+				}
+				sizes = append(sizes, int32(len(tokens)))
+			} else if err := enc.Encode(indexEntry{batch[i].source, meta.Tokens, len(tokens)}); err != nil {
+				return err
+			}
+			meta.Tokens += int64(len(tokens))
+			meta.Documents++
+		}
+		batch, size = batch[:0], 0
+		return nil
+	}
+	for _, file := range files {
+		err := readDocuments(file, cfg.field, func(doc document) error {
+			batch = append(batch, doc)
+			if size += len(doc.text); size >= batchBytes {
+				return flush()
+			}
+			return nil
+		})
+		if err != nil {
+			return meta, err
+		}
+	}
+	if err := flush(); err != nil {
+		return meta, err
+	}
+
+	if err := binW.Flush(); err != nil {
+		return meta, err
+	}
+	if err := bin.Close(); err != nil {
+		return meta, err
+	}
+	if index != nil {
+		if err := indexW.Flush(); err != nil {
+			return meta, err
+		}
+		if err := index.Close(); err != nil {
+			return meta, err
+		}
+	} else if err := writeMegatronIndex(prefix+".idx", width, sizes); err != nil {
+		return meta, err
+	}
+	b, err := json.MarshalIndent(meta, "", "  ")
+	if err != nil {
+		return meta, err
+	}
+	return meta, os.WriteFile(prefix+".json", append(b, '\n'), 0o644)
+}
+
+// readDocuments calls fn with each document in file: the whole file for a .txt
+// file, or the text in field of each line of a .jsonl file.
+func readDocuments(file, field string, fn func(document) error) error {
+	f, err := os.Open(file)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	if !strings.HasSuffix(file, ".jsonl") {
+		text, err := io.ReadAll(f)
+		if err != nil {
+			return err
+		}
+		return fn(document{file, string(text)})
+	}
+
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(nil, 256<<20)
+	for line := 1; scanner.Scan(); line++ {
+		if len(strings.TrimSpace(scanner.Text())) == 0 {
+			continue
+		}
+		source := fmt.Sprintf("%s:%d", file, line)
+		var record map[string]json.RawMessage
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			return fmt.Errorf("%s: %w", source, err)
+		}
+		var text string
+		if raw, ok := record[field]; !ok {
+			return fmt.Errorf("%s: no %q field", source, field)
+		} else if err := json.Unmarshal(raw, &text); err != nil {
+			return fmt.Errorf("%s: field %q: %w", source, field, err)
+		}
+		if err := fn(document{source, text}); err != nil {
+			return err
+		}
+	}
+	return scanner.Err()
+}
+
+// onErrFatalf prints a message and ends the program if err!=nil.
+func onErrFatalf(err error, format string, args ...any) {
+	if err != nil {
+		fmt.Fprintf(os.Stderr, format, args...)
+		fmt.Fprintf(os.Stderr, ": %v\n", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,264 @@
+// Copyright 2023 Peter Hebert. Licensed under the MIT license.
+
+package main
+
+import (
+	"bufio"
+	"encoding/binary"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/peterheb/gotoken"
+	_ "github.com/peterheb/gotoken/cl100kbase"
+	_ "github.com/peterheb/gotoken/r50kbase"
+)
+
+// TestWriteCorpus writes a corpus of .txt and .jsonl files, and checks that
+// the packed tokens, index, and metadata match encoding each document.
+func TestWriteCorpus(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"a.txt":       "Hello, world!",
+		"b/c.jsonl":   `{"text":"one <|endoftext|> two","id":1}` + "\n\n" + `{"id":2,"text":"three"}` + "\n",
+		"b/skip.md":   "not a document",
+		"b/d/e.txt":   "",
+		"z.jsonl":     `{"text":"last"}`,
+		"notes.jsonl": "",
+	}
+	for name, text := range files {
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(text), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	found, err := findFiles([]string{dir})
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantFiles := []string{"a.txt", "b/c.jsonl", "b/d/e.txt", "notes.jsonl", "z.jsonl"}
+	for i := range wantFiles {
+		wantFiles[i] = filepath.Join(dir, wantFiles[i])
+	}
+	if !reflect.DeepEqual(found, wantFiles) {
+		t.Fatalf("findFiles = %q; expected %q", found, wantFiles)
+	}
+
+	docs := []indexEntry{
+		{Source: wantFiles[0]},
+		{Source: wantFiles[1] + ":1"},
+		{Source: wantFiles[1] + ":3"},
+		{Source: wantFiles[2]},
+		{Source: wantFiles[4] + ":1"},
+	}
+	texts := []string{"Hello, world!", "one <|endoftext|> two", "three", "", "last"}
+
+	for _, tc := range []struct {
+		encoding, dtype string
+		eot             bool
+		width           int
+	}{
+		{"r50k_base", "", true, 2},
+		{"r50k_base", "uint32", false, 4},
+		{"cl100k_base", "", true, 4},
+	} {
+		tok, err := gotoken.GetTokenizer(tc.encoding, gotoken.WithSpecialTokensAsText())
+		if err != nil {
+			t.Fatal(err)
+		}
+		eotToken, _ := tok.EOTToken()
+		prefix := filepath.Join(t.TempDir(), "out")
+		meta, err := writeCorpus(tok, found, prefix, config{dtype: tc.dtype, field: "text", eot: tc.eot})
+		if err != nil {
+			t.Fatalf("%s: %v", tc.encoding, err)
+		}
+
+		// Build the expected tokens and index from encoding each document
+		var want []int
+		var wantIndex []indexEntry
+		for i, text := range texts {
+			tokens, err := tok.Encode(text)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tc.eot {
+				tokens = append(tokens, eotToken)
+			}
+			wantIndex = append(wantIndex, indexEntry{docs[i].Source, int64(len(want)), len(tokens)})
+			want = append(want, tokens...)
+		}
+
+		bin, err := os.ReadFile(prefix + ".bin")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(bin) != len(want)*tc.width {
+			t.Fatalf("%s: .bin has %d bytes; expected %d", tc.encoding, len(bin), len(want)*tc.width)
+		}
+		got := make([]int, len(want))
+		for i := range got {
+			if tc.width == 2 {
+				got[i] = int(binary.LittleEndian.Uint16(bin[i*2:]))
+			} else {
+				got[i] = int(binary.LittleEndian.Uint32(bin[i*4:]))
+			}
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("%s: .bin = %v; expected %v", tc.encoding, got, want)
+		}
+
+		f, err := os.Open(prefix + ".index.jsonl")
+		if err != nil {
+			t.Fatal(err)
+		}
+		var gotIndex []indexEntry
+		scanner := bufio.NewScanner(f)
+		for scanner.Scan() {
+			var entry indexEntry
+			if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+				t.Fatal(err)
+			}
+			gotIndex = append(gotIndex, entry)
+		}
+		f.Close()
+		if !reflect.DeepEqual(gotIndex, wantIndex) {
+			t.Errorf("%s: index = %+v; expected %+v", tc.encoding, gotIndex, wantIndex)
+		}
+
+		b, err := os.ReadFile(prefix + ".json")
+		if err != nil {
+			t.Fatal(err)
+		}
+		var gotMeta metadata
+		if err := json.Unmarshal(b, &gotMeta); err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(gotMeta, meta) || meta.Documents != int64(len(texts)) || meta.Tokens != int64(len(want)) {
+			t.Errorf("%s: metadata = %+v; expected %d documents and %d tokens", tc.encoding, gotMeta, len(texts), len(want))
+		}
+		if (meta.EOT != nil) != tc.eot {
+			t.Errorf("%s: metadata EOT = %v; expected eot=%v", tc.encoding, meta.EOT, tc.eot)
+		}
+	}
+}
+
+// TestWriteCorpusErrors checks that bad settings and input are reported.
+func TestWriteCorpusErrors(t *testing.T) {
+	dir := t.TempDir()
+	tok, err := gotoken.GetTokenizer("cl100k_base")
+	if err != nil {
+		t.Fatal(err)
+	}
+	bad := filepath.Join(dir, "bad.jsonl")
+	if err := os.WriteFile(bad, []byte(`{"body":"no text"}`+"\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	prefix := filepath.Join(dir, "out")
+	if _, err := writeCorpus(tok, nil, prefix, config{dtype: "uint16"}); err == nil {
+		t.Error("cl100k_base with uint16: expected an error")
+	}
+	if _, err := writeCorpus(tok, nil, prefix, config{dtype: "int8"}); err == nil {
+		t.Error("dtype int8: expected an error")
+	}
+	if _, err := writeCorpus(tok, nil, prefix, config{format: "arrow"}); err == nil {
+		t.Error("format arrow: expected an error")
+	}
+	if _, err := writeCorpus(tok, []string{bad}, prefix, config{field: "text"}); err == nil {
+		t.Error("missing field: expected an error")
+	}
+	if _, err := writeCorpus(tok, []string{bad}, prefix, config{field: "body"}); err != nil {
+		t.Errorf("field body: %v", err)
+	}
+}
+
+// TestWriteMegatron writes a corpus in the Megatron-LM format, and reads the
+// documents back through the .idx file.
+func TestWriteMegatron(t *testing.T) {
+	dir := t.TempDir()
+	input := filepath.Join(dir, "docs.jsonl")
+	texts := []string{"Hello, world!", "", "The quick brown fox jumps over the lazy dog."}
+	var lines []byte
+	for _, text := range texts {
+		line, _ := json.Marshal(map[string]string{"text": text})
+		lines = append(append(lines, line...), '\n')
+	}
+	if err := os.WriteFile(input, lines, 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tc := range []struct {
+		encoding string
+		width    int
+		code     byte
+	}{
+		{"r50k_base", 2, megatronUint16},
+		{"cl100k_base", 4, megatronInt32},
+	} {
+		tok, err := gotoken.GetTokenizer(tc.encoding, gotoken.WithSpecialTokensAsText())
+		if err != nil {
+			t.Fatal(err)
+		}
+		eotToken, _ := tok.EOTToken()
+		prefix := filepath.Join(t.TempDir(), "out")
+		meta, err := writeCorpus(tok, []string{input}, prefix, config{format: "megatron", field: "text", eot: true})
+		if err != nil {
+			t.Fatalf("%s: %v", tc.encoding, err)
+		}
+		if meta.Format != "megatron" {
+			t.Errorf("%s: format = %q; expected megatron", tc.encoding, meta.Format)
+		}
+		if _, err := os.Stat(prefix + ".index.jsonl"); err == nil {
+			t.Errorf("%s: wrote an index.jsonl file", tc.encoding)
+		}
+
+		idx, err := os.ReadFile(prefix + ".idx")
+		if err != nil {
+			t.Fatal(err)
+		}
+		bin, err := os.ReadFile(prefix + ".bin")
+		if err != nil {
+			t.Fatal(err)
+		}
+		n := len(texts)
+		if want := 9 + 8 + 1 + 8 + 8 + n*4 + n*8 + (n+1)*8; len(idx) != want {
+			t.Fatalf("%s: .idx has %d bytes; expected %d", tc.encoding, len(idx), want)
+		}
+		if string(idx[:9]) != megatronMagic || binary.LittleEndian.Uint64(idx[9:]) != 1 || idx[17] != tc.code {
+			t.Errorf("%s: .idx header = % x", tc.encoding, idx[:18])
+		}
+		if binary.LittleEndian.Uint64(idx[18:]) != uint64(n) || binary.LittleEndian.Uint64(idx[26:]) != uint64(n+1) {
+			t.Errorf("%s: .idx counts = % x", tc.encoding, idx[18:34])
+		}
+		sizes, pointers, docs := idx[34:], idx[34+n*4:], idx[34+n*12:]
+		for i, text := range texts {
+			want, err := tok.Encode(text)
+			if err != nil {
+				t.Fatal(err)
+			}
+			want = append(want, eotToken)
+			size := int(binary.LittleEndian.Uint32(sizes[i*4:]))
+			ptr := int(binary.LittleEndian.Uint64(pointers[i*8:]))
+			got := make([]int, size)
+			for j := range got {
+				if tc.width == 2 {
+					got[j] = int(binary.LittleEndian.Uint16(bin[ptr+j*2:]))
+				} else {
+					got[j] = int(binary.LittleEndian.Uint32(bin[ptr+j*4:]))
+				}
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("%s: document %d = %v; expected %v", tc.encoding, i, got, want)
+			}
+		}
+		for i := 0; i <= n; i++ {
+			if got := binary.LittleEndian.Uint64(docs[i*8:]); got != uint64(i) {
+				t.Errorf("%s: document index %d = %d; expected %d", tc.encoding, i, got, i)
+			}
+		}
+	}
+}
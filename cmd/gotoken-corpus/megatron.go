@@ -0,0 +1,80 @@
+// Copyright 2023 Peter Hebert. Licensed under the MIT license.
+
+package main
+
+import (
+	"bufio"
+	"encoding/binary"
+	"os"
+)
+
+// megatronMagic starts the .idx file of a Megatron-LM MMapIndexedDataset, and
+// megatronVersion follows it.
+const (
+	megatronMagic   = "MMIDIDX\x00\x00"
+	megatronVersion = 1
+)
+
+// Megatron-LM's codes for the dtypes of the .bin file. It has no uint32, so
+// 4-byte token IDs are written as int32.
+const (
+	megatronInt32  = 4
+	megatronUint16 = 8
+)
+
+// writeMegatronIndex writes the .idx file of a Megatron-LM indexed dataset to
+// path, for a .bin file of tokens that are width bytes each, with one sequence
+// of each length in sizes, and each sequence a document. The format is:
+//
+//   - the magic string, the version as a uint64, and the dtype code as a byte
+//   - the number of sequences, and the number of document indices, as uint64s
+//   - the length in tokens of each sequence, as int32s
+//   - the byte offset in the .bin file of each sequence, as int64s
+//   - the index of the sequence each document starts at, as int64s, followed
+//     by the number of sequences
+//
+// All numbers are little-endian.
+func writeMegatronIndex(path string, width int, sizes []int32) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	w := bufio.NewWriter(f)
+
+	code := byte(megatronInt32)
+	if width == 2 {
+		code = megatronUint16
+	}
+	w.WriteString(megatronMagic)
+	writeUint64(w, megatronVersion)
+	w.WriteByte(code)
+	writeUint64(w, uint64(len(sizes)))
+	writeUint64(w, uint64(len(sizes)+1))
+	var b [4]byte
+	for _, size := range sizes {
+		binary.LittleEndian.PutUint32(b[:], uint32(size))
+		w.Write(b[:])
+	}
+	offset := uint64(0)
+	for _, size := range sizes {
+		writeUint64(w, offset)
+		offset += uint64(size) * uint64(width)
+	}
+	for i := 0; i <= len(sizes); i++ {
+		writeUint64(w, uint64(i))
+	}
+
+	// Errors from the writes are kept by w and returned by Flush
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	return f.Close()
+}
+
+// writeUint64 writes v to w as a little-endian uint64.
+func writeUint64(w *bufio.Writer, v uint64) {
+	var b [8]byte
+	binary.LittleEndian.PutUint64(b[:], v)
+	w.Write(b[:])
+}
@@ -0,0 +1,1017 @@
+// Copyright 2023 Peter Hebert. Licensed under the MIT license.
+
+// Command gotoken-gen generates the data for gotoken's encoding packages from
+// the ".tiktoken" files provided by OpenAI. In the gotoken module, run "go
+// generate ./cmd/gotoken-gen" to regenerate every encoding package; this
+// overwrites the data files in {encoding}/ under the module root!
+//
+// The ".tiktoken" files provided by OpenAI are licensed under the MIT license
+// and include the following notice: Copyright (c) 2022 OpenAI, Shantanu Jain.
+//
+//   - See also: https://github.com/openai/tiktoken/blob/main/LICENSE
+//
+// By default, the tables are emitted as Go literals. With the -binary flag,
+// they are instead written to {encoding}/data.bin in a compact binary format
+// and embedded, which compiles much faster. With -compress, the binary data is
+// gzipped into {encoding}/data.bin.gz, which also makes binaries smaller at the
+// cost of inflating the data at first use. See README.md for the trade-offs. With -datadir, data files that can be loaded at
+// runtime with gotoken.WithDataFile are also written. With -encoding, only the
+// named encoding is generated.
+//
+// With -standalone, gotoken-gen instead generates the data for a package
+// outside of gotoken, which registers its encoding with the
+// github.com/peterheb/gotoken/vocab package. Use -encoding to name the
+// encoding, -url or -source for its .tiktoken file, and -out and -pkg for the
+// directory and name of the package to write.
+//
+//go:generate go run . -root ../..
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	_ "embed"
+	"encoding/base64"
+	"encoding/hex"
+	"flag"
+	"fmt"
+	"go/format"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/peterheb/gotoken"
+	"github.com/peterheb/gotoken/internal"
+)
+
+// countOnlyTag is the build tag that leaves the token strings out of the
+// encoding packages, for programs that only encode and count.
+const countOnlyTag = "gotoken_countonly"
+
+// noDataTag is the build tag that leaves all of the data out of the encoding
+// packages, for programs that load it with gotoken.WithDataFile.
+const noDataTag = "gotoken_nodata"
+
+var (
+	binary   = flag.Bool("binary", false, "embed the data in the binary format instead of as Go literals")
+	compress = flag.Bool("compress", false, "embed the data in the binary format, gzipped, instead of as Go literals")
+	dataDir  = flag.String("datadir", "", "also write data files for gotoken.WithDataFile to this `directory`")
+	only     = flag.String("encoding", "", "only generate the encoding with this `name`")
+	source   = flag.String("source", "", "read the .tiktoken `file` from this path instead of its URL; requires -encoding")
+	cacheDir = flag.String("cache", "", "read .tiktoken files from this `directory` if present, and save downloaded ones there")
+	offline  = flag.Bool("offline", false, "never download; fail if a .tiktoken file is not in -source or -cache")
+	rootDir  = flag.String("root", ".", "the gotoken module root `directory`, which contains the encoding packages")
+	srcURL   = flag.String("url", "", "the `URL` of the .tiktoken file, for an encoding gotoken-gen doesn't know; requires -encoding")
+	outDir   = flag.String("out", "", "write the package to this `directory` instead of {root}/{pkg}; requires -encoding")
+	pkgName  = flag.String("pkg", "", "the `name` of the generated package, instead of the encoding name without underscores; requires -encoding")
+
+	pinSHA256  = flag.String("sha256", "", "the expected SHA-256 of the .tiktoken file, in `hex`; requires -encoding")
+	updatePins = flag.Bool("update-pins", false, "accept source data that doesn't match its pinned SHA-256, and update the pin")
+	standalone = flag.Bool("standalone", false, "generate a package outside of gotoken, for use with gotoken/vocab; requires -encoding")
+)
+
+// encodingSpec describes an encoding to generate a package for. The package's
+// tokenizer.go is written by hand, except that if the package doesn't exist
+// yet, one is created from tokenizerTemplate using Splitter and SpecialTokens.
+// A new pre-tokenization pattern needs its splitter implemented by hand, in
+// the internal package, and added to splitterFuncs before the new package will
+// build. The splitter is also used to write the package's golden test data.
+type encodingSpec struct {
+	Name          string         // the encoding name, like "cl100k_base"
+	URL           string         // the .tiktoken file to generate from
+	Splitter      string         // Go expression for the splitter function
+	SpecialTokens []specialToken // the special tokens of the encoding
+}
+
+// specialToken is a special token of an encodingSpec. ConstName is the name
+// of the exported constant for Text in the encoding package.
+type specialToken struct {
+	ConstName string
+	Text      string
+	Token     int
+}
+
+var encodings = []encodingSpec{
+	{
+		Name:     "r50k_base",
+		URL:      "https://openaipublic.blob.core.windows.net/encodings/r50k_base.tiktoken",
+		Splitter: "internal.GPT2SpanSplitter",
+	},
+	{
+		Name:     "p50k_base",
+		URL:      "https://openaipublic.blob.core.windows.net/encodings/p50k_base.tiktoken",
+		Splitter: "internal.GPT2SpanSplitter",
+	},
+	{
+		Name:     "cl100k_base",
+		URL:      "https://openaipublic.blob.core.windows.net/encodings/cl100k_base.tiktoken",
+		Splitter: "internal.CL100KBaseSpanSplitter",
+	},
+	{
+		Name:     "o200k_base",
+		URL:      "https://openaipublic.blob.core.windows.net/encodings/o200k_base.tiktoken",
+		Splitter: "internal.O200KBaseSpanSplitter",
+		SpecialTokens: []specialToken{
+			{"EndOfText", "<|endoftext|>", 199999},
+			{"EndOfPrompt", "<|endofprompt|>", 200018},
+		},
+	},
+}
+
+// splitterFuncs maps the Splitter expressions used in encodings to the
+// functions they name, for writing golden test data.
+var splitterFuncs = map[string]func(dst []internal.Span, input []byte) []internal.Span{
+	"internal.GPT2SpanSplitter":       internal.GPT2SpanSplitter,
+	"internal.CL100KBaseSpanSplitter": internal.CL100KBaseSpanSplitter,
+	"internal.O200KBaseSpanSplitter":  internal.O200KBaseSpanSplitter,
+}
+
+func main() {
+	flag.Parse()
+	assert(*only != "" || *source == "" && *srcURL == "" && *outDir == "" && *pkgName == "" && *pinSHA256 == "" && !*standalone,
+		"-source, -url, -out, -pkg, -sha256, and -standalone require -encoding\n")
+	pins = parsePins(embeddedPins)
+	if *pinSHA256 != "" {
+		pins[*only] = strings.ToLower(*pinSHA256)
+	}
+	var selected []encodingSpec
+	for _, spec := range encodings {
+		if *only == "" || *only == spec.Name {
+			selected = append(selected, spec)
+		}
+	}
+	if len(selected) == 0 && (*srcURL != "" || *source != "") {
+		// an encoding that isn't in the table
+		selected = append(selected, encodingSpec{Name: *only})
+	}
+	assert(len(selected) > 0, "unknown encoding %q; use -url or -source to generate it\n", *only)
+	if *srcURL != "" {
+		selected[0].URL = *srcURL
+	}
+
+	// when offline, check that every source is available before generating
+	// anything, rather than failing partway through
+	if *offline {
+		for _, spec := range selected {
+			_, err := os.Stat(localSourcePath(spec))
+			onErrFatalf(err, "-offline: no local source for %s", spec.Name)
+		}
+	}
+	for _, spec := range selected {
+		generate(spec)
+	}
+	if pinsChanged {
+		writePins()
+	}
+}
+
+// embeddedPins is the contents of pins.sha256, which has a line with the hex
+// SHA-256 and name of each encoding, like the output of sha256sum.
+//
+//go:embed pins.sha256
+var embeddedPins string
+
+var (
+	pins        map[string]string // encoding name -> pinned SHA-256
+	pinsChanged bool              // pins were updated by -update-pins
+)
+
+// parsePins parses text in the format of pins.sha256. Blank lines and lines
+// starting with # are ignored.
+func parsePins(text string) map[string]string {
+	ret := make(map[string]string)
+	for _, line := range strings.Split(text, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) == 0 || strings.HasPrefix(fields[0], "#") {
+			continue
+		}
+		assert(len(fields) == 2, "bad line in pins.sha256: %q\n", line)
+		ret[fields[1]] = fields[0]
+	}
+	return ret
+}
+
+// checkPin refuses source data for the named encoding that doesn't match its
+// pinned SHA-256, unless -update-pins is set, in which case the pin is
+// updated. Data for an encoding without a pin is accepted with a warning.
+func checkPin(name string, contents []byte) {
+	sum := calcSHA256(contents)
+	pin, ok := pins[name]
+	switch {
+	case ok && pin == sum:
+		return
+	case *updatePins:
+		fmt.Printf("pinning %s to SHA-256 %s (was %q)\n", name, sum, pin)
+		pins[name] = sum
+		pinsChanged = true
+	case ok:
+		assert(false, "SHA-256 of the %s source is %s, but %s is pinned; "+
+			"if the new data is trusted, rerun with -update-pins\n", name, sum, pin)
+	default:
+		fmt.Printf("warning: no pinned SHA-256 for %s (it is %s); use -sha256 to check it\n", name, sum)
+	}
+}
+
+// writePins writes the pins to pins.sha256 under -root, for the next build of
+// gotoken-gen to embed. If that file doesn't exist, as when gotoken-gen was
+// installed and is run elsewhere, the pins are printed instead.
+func writePins() {
+	filename := filepath.Join(*rootDir, "cmd", "gotoken-gen", "pins.sha256")
+	old, err := os.ReadFile(filename)
+	if err != nil {
+		fmt.Printf("not updating %s: %v\n", filename, err)
+		return
+	}
+
+	// keep the comment at the top of the file, and write the pins sorted
+	var f bytes.Buffer
+	for _, line := range strings.SplitAfter(string(old), "\n") {
+		if !strings.HasPrefix(line, "#") {
+			break
+		}
+		f.WriteString(line)
+	}
+	names := make([]string, 0, len(pins))
+	for name := range pins {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		fmt.Fprintf(&f, "%s  %s\n", pins[name], name)
+	}
+	err = os.WriteFile(filename, f.Bytes(), 0644)
+	onErrFatalf(err, "writing %s", filename)
+	fmt.Printf("updated %s\n", filename)
+}
+
+func generate(spec encodingSpec) {
+	encoding, src := spec.Name, spec.URL
+	encodingPkg := strings.ReplaceAll(encoding, "_", "")
+	if *pkgName != "" {
+		encodingPkg = *pkgName
+	}
+	pkgDir := filepath.Join(*rootDir, encodingPkg)
+	if *outDir != "" {
+		pkgDir = *outDir
+	} else if !*standalone {
+		_, err := os.Stat(filepath.Join(*rootDir, "internal"))
+		onErrFatalf(err, "stat '%s/internal' (is -root the gotoken module root?)", *rootDir)
+	}
+	outFilename := filepath.Join(pkgDir, "data.go")
+	binFilename := filepath.Join(pkgDir, "data.bin")
+	gzFilename := filepath.Join(pkgDir, "data.bin.gz")
+	tokensFilename := filepath.Join(pkgDir, "data_tokens.go")
+	countOnlyFilename := filepath.Join(pkgDir, "data_countonly.go")
+	noDataFilename := filepath.Join(pkgDir, "data_nodata.go")
+	dir, err := os.Stat(pkgDir)
+	if os.IsNotExist(err) {
+		if *standalone {
+			err = os.MkdirAll(pkgDir, 0755)
+			onErrFatalf(err, "creating package directory")
+		} else {
+			createPackage(spec, encodingPkg, pkgDir)
+		}
+		dir, err = os.Stat(pkgDir)
+	}
+	onErrFatalf(err, "stat '%s'", pkgDir)
+	assert(dir.IsDir(), "'%s' is not a directory", pkgDir)
+
+	contents := readSource(spec)
+
+	// decode the input file into a map first to get our bearings
+	fmt.Print("decoding... ")
+	lines := strings.Split(string(contents), "\n")
+	tokens := make(map[int]string)
+	byteTokens := make([]int, 256)
+	lo, hi := 0xfffffff, -1
+	for i, line := range lines {
+		if len(line) == 0 {
+			continue
+		}
+
+		fields := strings.Fields(line)
+		assert(len(fields) == 2, "expected 2 fields, got %d on line %d: %q", len(fields), i, line)
+		token, err := base64.StdEncoding.DecodeString(fields[0])
+		onErrFatalf(err, "decoding base64 '%s'", fields[0])
+		rank, err := strconv.Atoi(fields[1])
+		onErrFatalf(err, "strconv.Atoi('%s')", fields[1])
+		tokens[rank] = string(token)
+		if len(token) == 1 {
+			// sanity check: if this is not true, we need to rethink our data structures!
+			assert(rank < 256, "expected single byte token %q to have rank<256, got %d", token, rank)
+			byteTokens[token[0]] = rank
+		}
+		lo = min(lo, rank)
+		hi = max(hi, rank)
+	}
+
+	// convert the map to a slice
+	assert(lo == 0, "lo == %d, expected 0", lo)
+	allTokens := make([]string, hi+1)
+	for k, v := range tokens {
+		allTokens[k] = v
+	}
+
+	// allTokens = allTokens[:512]    // uncomment to make the data in baby_test.go
+	bytePairLookup := createPairList(allTokens)
+	fmt.Println("OK")
+
+	fmt.Print("building trie... ")
+	trie := BuildTrie(allTokens)
+	serialized := trie.serialize()
+	assert(serialized[0]&0xff == 0, "trie root node is not 256-ary: got %d", serialized[0]>>8)
+	// verify serialized trie integrity by looking up every token
+	for i, token := range allTokens {
+		if len(token) == 0 {
+			continue
+		}
+		lkup := internal.TrieLookup(serialized, []byte(token))
+		assert(i == lkup, "trie build failure: lookup(%q): wanted=%d got=%d\n", token, i, lkup)
+	}
+	fmt.Printf("OK (%d nodes)\n", len(serialized))
+
+	fmt.Print("building minimal perfect hash... ")
+	mphSeeds := internal.BuildMPHSeeds(allTokens)
+	// verify the hash by looking up every token
+	tokenList := internal.NewTokenListFromStrings(allTokens)
+	mph := internal.NewMPH(mphSeeds, tokenList)
+	for i, token := range allTokens {
+		if len(token) == 0 {
+			continue
+		}
+		lkup := mph.Lookup([]byte(token))
+		assert(i == lkup, "MPH build failure: lookup(%q): wanted=%d got=%d\n", token, i, lkup)
+	}
+	fmt.Printf("OK (%d buckets)\n", len(mphSeeds))
+
+	// The data in the binary format is embedded by -binary and -compress, and
+	// is the format of the files written by -datadir
+	encodingData := &internal.EncodingData{
+		Name:           encoding,
+		ByteToToken:    byteTokensAsBytes(byteTokens),
+		TokenList:      tokenList,
+		TokenTrie:      serialized,
+		TokenMPHSeeds:  mphSeeds,
+		BytePairLookup: intsAsInt64s(bytePairLookup),
+	}
+	data := internal.MarshalEncodingData(encodingData)
+	var gz bytes.Buffer
+	zw, err := gzip.NewWriterLevel(&gz, gzip.BestCompression)
+	onErrFatalf(err, "creating gzip writer")
+	_, err = zw.Write(data)
+	onErrFatalf(err, "compressing data")
+	onErrFatalf(zw.Close(), "compressing data")
+	if *dataDir != "" {
+		dataFilename := filepath.Join(*dataDir, encoding+".gotoken")
+		fmt.Printf("creating %s... ", dataFilename)
+		err = os.WriteFile(dataFilename, gz.Bytes(), 0644)
+		onErrFatalf(err, "writing data file")
+		fmt.Printf("wrote %d bytes\n", gz.Len())
+	}
+
+	if !*standalone {
+		writeGolden(spec, encodingData)
+	}
+
+	info := dataInfo{
+		source:    src,
+		sha256:    calcSHA256(contents),
+		generated: time.Now().UTC().Truncate(time.Second),
+	}
+	sourceDesc := "Source URL: " + src
+	if src == "" {
+		info.source = filepath.Base(*source)
+		sourceDesc = "Source file: " + info.source
+	}
+	if *standalone {
+		writeStandalone(encoding, encodingPkg, pkgDir, sourceDesc, info, len(data), gz.Bytes())
+		return
+	}
+
+	fmt.Printf("creating %s... ", noDataFilename)
+	f := &bytes.Buffer{}
+	fmt.Fprint(f, "// Code generated programmatically by go generate; DO NOT EDIT\n\n")
+	fmt.Fprintf(f, "//go:build %s\n\n", noDataTag)
+	fmt.Fprintf(f, "package %s\n\n", encodingPkg)
+	fmt.Fprintln(f, "import (")
+	fmt.Fprintln(f, `	"errors"`)
+	fmt.Fprintln(f)
+	fmt.Fprintln(f, `	"github.com/peterheb/gotoken/internal"`)
+	fmt.Fprintln(f, ")")
+	fmt.Fprintln(f)
+	fmt.Fprintln(f, "// The source of the data is unknown, since it is not embedded in this build")
+	dataInfo{}.writeConsts(f)
+	fmt.Fprintln(f, "// loadData returns an error, since the data is not embedded in this build.")
+	fmt.Fprintln(f, "func loadData() (*internal.EncodingData, error) {")
+	fmt.Fprintf(f, "	return nil, errors.New(\"%s data is not embedded in %s builds; use gotoken.WithDataFile\")\n", encoding, noDataTag)
+	fmt.Fprintln(f, "}")
+	writeGoFile(noDataFilename, f.Bytes())
+
+	fmt.Printf("creating %s... ", outFilename)
+	f.Reset()
+	fmt.Fprint(f, "// Code generated programmatically by go generate; DO NOT EDIT\n\n")
+	fmt.Fprintf(f, "//go:build !%s\n\n", noDataTag)
+	fmt.Fprintf(f, "// Package %s registers the %#v tokenizer with gotoken.\n", encodingPkg, encoding)
+	fmt.Fprintln(f, "// To use this tokenizer:")
+	fmt.Fprintln(f, "//")
+	fmt.Fprintln(f, "//   import (")
+	fmt.Fprintln(f, `//       "github.com/peterheb/gotoken"`)
+	fmt.Fprintf(f, "//       _ \"github.com/peterheb/gotoken/%s\"\n", encodingPkg)
+	fmt.Fprintln(f, "//   )")
+	fmt.Fprintln(f, "//   ...")
+	fmt.Fprintf(f, "//   tok, err := gotoken.GetTokenizer(%#v)\n", encoding)
+	fmt.Fprintln(f, "//")
+	fmt.Fprintln(f, "// This file was generated from the following data:")
+	fmt.Fprintln(f, "//")
+	fmt.Fprintf(f, "//   - %s\n", sourceDesc)
+	fmt.Fprintf(f, "//   - Source SHA-256: %s\n", info.sha256)
+	fmt.Fprintf(f, "//   - Generated: %s\n", info.generated.Format(time.RFC3339))
+	fmt.Fprintf(f, "package %s\n", encodingPkg)
+	fmt.Fprintln(f)
+	if *binary || *compress {
+		embedName, embedData, varName, desc := binFilename, data, "binaryData", "encoding data"
+		if *compress {
+			embedName, embedData, varName = gzFilename, gz.Bytes(), "compressedData"
+			desc = fmt.Sprintf("gzipped encoding data (%d bytes inflated)", len(data))
+		}
+		err = os.WriteFile(embedName, embedData, 0644)
+		onErrFatalf(err, "writing %s", filepath.Base(embedName))
+
+		fmt.Fprintln(f, "import (")
+		fmt.Fprintln(f, `	_ "embed"`)
+		fmt.Fprintln(f)
+		fmt.Fprintln(f, `	"github.com/peterheb/gotoken/internal"`)
+		fmt.Fprintln(f, ")")
+		fmt.Fprintln(f)
+		fmt.Fprintln(f, "// The source of this data, returned by DataInfo")
+		info.writeConsts(f)
+		fmt.Fprintf(f, "// %s is the %s, in the format of\n", varName, desc)
+		fmt.Fprintln(f, "// internal.MarshalEncodingData")
+		fmt.Fprintln(f, "//")
+		fmt.Fprintf(f, "//go:embed %s\n", filepath.Base(embedName))
+		fmt.Fprintf(f, "var %s []byte\n", varName)
+		fmt.Fprintln(f)
+		fmt.Fprintf(f, "// loadData decodes %s. It is called once, on first use.\n", varName)
+		fmt.Fprintln(f, "func loadData() (*internal.EncodingData, error) {")
+		fmt.Fprintf(f, "	return internal.InflateEncodingData(%s)\n", varName)
+		fmt.Fprintln(f, "}")
+		writeGoFile(outFilename, f.Bytes())
+
+		// the token strings are part of the binary data, and only one of the
+		// binary files is embedded
+		removeIfExists(tokensFilename)
+		removeIfExists(countOnlyFilename)
+		if *compress {
+			removeIfExists(binFilename)
+		} else {
+			removeIfExists(gzFilename)
+		}
+		return
+	}
+
+	// remove data from a previous -binary or -compress run, so it isn't
+	// embedded
+	removeIfExists(binFilename)
+	removeIfExists(gzFilename)
+
+	fmt.Fprintln(f, `import "github.com/peterheb/gotoken/internal"`)
+	fmt.Fprintln(f)
+	fmt.Fprintln(f, "// The source of this data, returned by DataInfo")
+	info.writeConsts(f)
+	fmt.Fprintln(f, "// loadData returns the data in this file, plus the token strings if this is")
+	fmt.Fprintln(f, "// not a count-only build. It is called once, on first use.")
+	fmt.Fprintln(f, "func loadData() (*internal.EncodingData, error) {")
+	fmt.Fprintln(f, "	d := &internal.EncodingData{")
+	fmt.Fprintf(f, "		Name:           %q,\n", encoding)
+	fmt.Fprintln(f, "		ByteToToken:    byteToToken,")
+	fmt.Fprintln(f, "		TokenTrie:      tokenTrie,")
+	fmt.Fprintln(f, "		BytePairLookup: bytePairLookup,")
+	fmt.Fprintln(f, "	}")
+	fmt.Fprintln(f, "	loadTokenList(d)")
+	fmt.Fprintln(f, "	return d, nil")
+	fmt.Fprintln(f, "}")
+	fmt.Fprintln(f)
+	fmt.Fprintln(f, "// byteToToken translates raw bytes to their token values")
+	fmt.Fprintln(f, "var byteToToken = []byte{")
+	emitSlice(f, byteTokens, formatted("%d,"))
+	fmt.Fprintln(f, "\n}")
+	fmt.Fprintln(f)
+	fmt.Fprintln(f, "// tokenTrie is a serialized map[string]int of token string -> rank")
+	fmt.Fprintln(f, "var tokenTrie = []uint32{")
+	emitSlice(f, serialized, hexOrDigit)
+	fmt.Fprintln(f, "\n}")
+	fmt.Fprintln(f)
+	fmt.Fprintln(f, "// bytePairLookup maps pairs of bytes to tokens, for kicking off BPE")
+	fmt.Fprintln(f, "var bytePairLookup = []int64{")
+	emitSlice(f, bytePairLookup, hexOrDigit)
+	fmt.Fprintln(f, "\n}")
+	writeGoFile(outFilename, f.Bytes())
+
+	// The token strings, and the MPH that needs them, go in a separate file
+	// that count-only builds leave out
+	fmt.Printf("creating %s... ", tokensFilename)
+	f.Reset()
+	fmt.Fprint(f, "// Code generated programmatically by go generate; DO NOT EDIT\n\n")
+	fmt.Fprintf(f, "//go:build !%s && !%s\n\n", countOnlyTag, noDataTag)
+	fmt.Fprintf(f, "package %s\n\n", encodingPkg)
+	fmt.Fprintln(f, `import "github.com/peterheb/gotoken/internal"`)
+	fmt.Fprintln(f)
+	fmt.Fprintln(f, "// loadTokenList adds the token strings and the MPH seeds to d.")
+	fmt.Fprintln(f, "func loadTokenList(d *internal.EncodingData) {")
+	fmt.Fprintln(f, "	d.TokenList = internal.NewTokenList(tokenData, tokenOffsets)")
+	fmt.Fprintln(f, "	d.TokenMPHSeeds = tokenMPHSeeds")
+	fmt.Fprintln(f, "}")
+	fmt.Fprintln(f)
+	fmt.Fprintln(f, "// tokenData is the strings of all tokens concatenated, for decoding")
+	fmt.Fprintf(f, "const tokenData = %q\n", strings.Join(allTokens, ""))
+	fmt.Fprintln(f)
+	fmt.Fprintln(f, "// tokenOffsets are the offsets of each token in tokenData; token i is")
+	fmt.Fprintln(f, "// tokenData[tokenOffsets[i]:tokenOffsets[i+1]]")
+	fmt.Fprintln(f, "var tokenOffsets = []uint32{")
+	offsets := make([]uint32, 1, len(allTokens)+1)
+	for _, token := range allTokens {
+		offsets = append(offsets, offsets[len(offsets)-1]+uint32(len(token)))
+	}
+	emitSlice(f, offsets, hexOrDigit)
+	fmt.Fprintln(f, "\n}")
+	fmt.Fprintln(f)
+	fmt.Fprintln(f, "// tokenMPHSeeds are the bucket seeds of a minimal perfect hash of token")
+	fmt.Fprintln(f, "// string -> rank, see internal.MPH")
+	fmt.Fprintln(f, "var tokenMPHSeeds = []uint32{")
+	emitSlice(f, mphSeeds, hexOrDigit)
+	fmt.Fprintln(f, "\n}")
+	writeGoFile(tokensFilename, f.Bytes())
+
+	fmt.Printf("creating %s... ", countOnlyFilename)
+	f.Reset()
+	fmt.Fprint(f, "// Code generated programmatically by go generate; DO NOT EDIT\n\n")
+	fmt.Fprintf(f, "//go:build %s && !%s\n\n", countOnlyTag, noDataTag)
+	fmt.Fprintf(f, "package %s\n\n", encodingPkg)
+	fmt.Fprintln(f, `import "github.com/peterheb/gotoken/internal"`)
+	fmt.Fprintln(f)
+	fmt.Fprintln(f, "// loadTokenList does nothing, since this is a count-only build. Without the")
+	fmt.Fprintln(f, "// token strings, the tokenizer can encode but not decode.")
+	fmt.Fprintln(f, "func loadTokenList(d *internal.EncodingData) {}")
+	writeGoFile(countOnlyFilename, f.Bytes())
+}
+
+// writeStandalone writes the data for a package outside of gotoken: the gzipped
+// data in data.bin.gz, and a data.go that embeds it for vocab.Encoding.
+func writeStandalone(encoding, encodingPkg, pkgDir, sourceDesc string, info dataInfo, size int, compressed []byte) {
+	binFilename := filepath.Join(pkgDir, "data.bin.gz")
+	fmt.Printf("creating %s... ", binFilename)
+	err := os.WriteFile(binFilename, compressed, 0644)
+	onErrFatalf(err, "writing data.bin.gz")
+	fmt.Printf("wrote %d bytes\n", len(compressed))
+
+	outFilename := filepath.Join(pkgDir, "data.go")
+	fmt.Printf("creating %s... ", outFilename)
+	f := &bytes.Buffer{}
+	fmt.Fprint(f, "// Code generated by gotoken-gen; DO NOT EDIT.\n\n")
+	fmt.Fprintf(f, "package %s\n\n", encodingPkg)
+	fmt.Fprintln(f, "import (")
+	fmt.Fprintln(f, `	_ "embed"`)
+	fmt.Fprintln(f, `	"time"`)
+	fmt.Fprintln(f)
+	fmt.Fprintln(f, `	"github.com/peterheb/gotoken"`)
+	fmt.Fprintln(f, ")")
+	fmt.Fprintln(f)
+	fmt.Fprintf(f, "// encodingData is the gzipped data of the %q encoding (%d bytes\n", encoding, size)
+	fmt.Fprintln(f, "// inflated), to register with vocab.Register as vocab.Encoding.Data. It was")
+	fmt.Fprintln(f, "// generated from the following data:")
+	fmt.Fprintln(f, "//")
+	fmt.Fprintf(f, "//   - %s\n", sourceDesc)
+	fmt.Fprintf(f, "//   - Source SHA-256: %s\n", info.sha256)
+	fmt.Fprintf(f, "//   - Generated: %s\n", info.generated.Format(time.RFC3339))
+	fmt.Fprintln(f, "//")
+	fmt.Fprintln(f, "//go:embed data.bin.gz")
+	fmt.Fprintln(f, "var encodingData []byte")
+	fmt.Fprintln(f)
+	fmt.Fprintln(f, "// encodingDataInfo is the source of encodingData, to register as")
+	fmt.Fprintln(f, "// vocab.Encoding.DataInfo")
+	fmt.Fprintln(f, "var encodingDataInfo = gotoken.DataInfo{")
+	fmt.Fprintf(f, "	Source:    %q,\n", info.source)
+	fmt.Fprintf(f, "	SHA256:    %q,\n", info.sha256)
+	g := info.generated
+	fmt.Fprintf(f, "	Generated: time.Date(%d, %d, %d, %d, %d, %d, 0, time.UTC),\n", g.Year(), g.Month(), g.Day(), g.Hour(), g.Minute(), g.Second())
+	fmt.Fprintln(f, "}")
+	writeGoFile(outFilename, f.Bytes())
+}
+
+// dataInfo is the source of an encoding's data, written into its package for
+// gotoken.DataInfo.
+type dataInfo struct {
+	source    string // the URL of the .tiktoken file, or its file name
+	sha256    string
+	generated time.Time
+}
+
+// writeConsts writes the dataSource, dataSHA256, and dataGenerated constants
+// that an encoding package's DataInfo function returns. The zero dataInfo
+// writes empty strings.
+func (info dataInfo) writeConsts(f io.Writer) {
+	generated := ""
+	if !info.generated.IsZero() {
+		generated = info.generated.Format(time.RFC3339)
+	}
+	fmt.Fprintln(f, "const (")
+	fmt.Fprintf(f, "	dataSource    = %q\n", info.source)
+	fmt.Fprintf(f, "	dataSHA256    = %q\n", info.sha256)
+	fmt.Fprintf(f, "	dataGenerated = %q\n", generated)
+	fmt.Fprintln(f, ")")
+	fmt.Fprintln(f)
+}
+
+// writeGolden writes the expected tokens for each line of testdata/samples.txt
+// under -root to testdata/{encoding}.txt, using a tokenizer built from d, so
+// that a new encoding's package comes with data for its conformance test. The
+// format is the same as the files written by gotoken-truth, whose -reference
+// tiktoken mode should be used to check the output for a new encoding.
+func writeGolden(spec encodingSpec, d *internal.EncodingData) {
+	samplesFilename := filepath.Join(*rootDir, "testdata", "samples.txt")
+	goldenFilename := filepath.Join(*rootDir, "testdata", spec.Name+".txt")
+	split := splitterFuncs[spec.Splitter]
+	if split == nil {
+		fmt.Printf("not creating %s: no splitter for %s\n", goldenFilename, spec.Name)
+		return
+	}
+	samples, err := os.Open(samplesFilename)
+	if os.IsNotExist(err) {
+		fmt.Printf("not creating %s: %s doesn't exist\n", goldenFilename, samplesFilename)
+		return
+	}
+	onErrFatalf(err, "opening %s", samplesFilename)
+	defer samples.Close()
+
+	fmt.Printf("creating %s... ", goldenFilename)
+	params := d.Params()
+	params.Name = spec.Name
+	params.Splitter = split
+	params.SpecialTokens = make(map[string]int)
+	for _, st := range spec.SpecialTokens {
+		params.SpecialTokens[st.Text] = st.Token
+	}
+	tok, err := internal.NewBPETokenizer(&params, gotoken.TokenizerOptions{})
+	onErrFatalf(err, "creating %s tokenizer", spec.Name)
+
+	// Lines are read like internal.TestPairReader reads them, and the tokens
+	// are formatted like Python's json.dumps
+	f := &bytes.Buffer{}
+	scanner := bufio.NewScanner(samples)
+	lines := 0
+	for scanner.Scan() {
+		tokens, err := tok.Encode(scanner.Text())
+		onErrFatalf(err, "encoding line %d of %s", lines+1, samplesFilename)
+		f.WriteByte('[')
+		for i, token := range tokens {
+			if i > 0 {
+				f.WriteString(", ")
+			}
+			f.WriteString(strconv.Itoa(token))
+		}
+		f.WriteString("]\n")
+		lines++
+	}
+	onErrFatalf(scanner.Err(), "reading %s", samplesFilename)
+	err = os.WriteFile(goldenFilename, f.Bytes(), 0644)
+	onErrFatalf(err, "writing %s", goldenFilename)
+	fmt.Printf("wrote %d test cases\n", lines)
+}
+
+// createPackage creates the directory for a new encoding package, and its
+// tokenizer.go from tokenizerTemplate.
+func createPackage(spec encodingSpec, encodingPkg, pkgDir string) {
+	assert(spec.Splitter != "", "no splitter to create package %s with\n", encodingPkg)
+	filename := filepath.Join(pkgDir, "tokenizer.go")
+	fmt.Printf("creating %s... ", filename)
+	err := os.MkdirAll(pkgDir, 0755)
+	onErrFatalf(err, "creating package directory")
+
+	f := &bytes.Buffer{}
+	err = tokenizerTemplate.Execute(f, struct {
+		encodingSpec
+		Package string
+		Year    int
+	}{spec, encodingPkg, time.Now().Year()})
+	onErrFatalf(err, "executing template")
+	writeGoFile(filename, f.Bytes())
+}
+
+// tokenizerTemplate is the tokenizer.go of a new encoding package. It follows
+// the hand-written ones, and can be edited like them once it exists.
+var tokenizerTemplate = template.Must(template.New("tokenizer.go").Parse(`// Copyright {{.Year}} Peter Hebert. Licensed under the MIT license.
+
+package {{.Package}}
+
+import (
+	"sync"
+	"time"
+
+	"github.com/peterheb/gotoken"
+	"github.com/peterheb/gotoken/internal"
+)
+
+// These special tokens are defined by this encoding.
+const (
+{{- range .SpecialTokens}}
+	{{.ConstName}} = {{printf "%q" .Text}}
+{{- end}}
+)
+
+// These are the token IDs of the special tokens.
+const (
+{{- range .SpecialTokens}}
+	{{.ConstName}}ID = {{.Token}}
+{{- end}}
+)
+
+var (
+	baseParams     internal.BPEParams
+	baseParamsErr  error
+	baseParamsOnce sync.Once
+
+	// the complete params, shared by every tokenizer of this encoding
+	paramsCache internal.ParamsCache
+)
+
+// getBaseParams returns the BPEParams for this encoding, without Name,
+// Splitter, or SpecialTokens. The data from data.go is loaded and the lookup
+// tables are built on first use. If opts name a data file, the data is loaded
+// from that instead.
+func getBaseParams(opts gotoken.TokenizerOptions) (internal.BPEParams, error) {
+	if opts.DataPath != "" {
+		return internal.ExternalParams(opts.DataFS, opts.DataPath, {{printf "%q" .Name}})
+	}
+	baseParamsOnce.Do(func() {
+		var data *internal.EncodingData
+		data, baseParamsErr = loadData()
+		if baseParamsErr == nil {
+			baseParams = data.Params()
+			baseParams.DataInfo = DataInfo()
+		}
+	})
+	return baseParams, baseParamsErr
+}
+
+// getTokenizer returns a BPE tokenizer that uses the OpenAI {{.Name}}
+// encoding.
+func getTokenizer(opts gotoken.TokenizerOptions) (gotoken.Tokenizer, error) {
+	params, err := paramsCache.Get(opts, func() (internal.BPEParams, error) {
+		params, err := getBaseParams(opts)
+		params.Name = {{printf "%q" .Name}}
+		params.Splitter = {{.Splitter}}
+		params.SpecialTokens = SpecialTokens()
+		return params, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return internal.NewBPETokenizer(params, opts)
+}
+
+// SpecialTokens returns the special tokens of this encoding, mapped to their
+// token IDs. The map is a new copy on each call.
+func SpecialTokens() map[string]int {
+	return map[string]int{
+{{- range .SpecialTokens}}
+		{{.ConstName}}: {{.ConstName}}ID,
+{{- end}}
+	}
+}
+
+// DataInfo returns the source URL and SHA-256 of the .tiktoken file that the
+// data in this package was generated from, and when it was generated. In
+// gotoken_nodata builds, which embed no data, it returns the zero DataInfo.
+func DataInfo() gotoken.DataInfo {
+	generated, _ := time.Parse(time.RFC3339, dataGenerated)
+	return gotoken.DataInfo{Source: dataSource, SHA256: dataSHA256, Generated: generated}
+}
+
+func init() {
+	gotoken.RegisterTokenizer({{printf "%q" .Name}}, getTokenizer)
+}
+`))
+
+// writeGoFile formats code with "go fmt" and saves it to filename.
+func writeGoFile(filename string, code []byte) {
+	formatted, err := format.Source(code)
+	if err != nil {
+		err2 := os.WriteFile("./broken.txt", code, 0644)
+		onErrFatalf(err2, "writing broken.txt")
+	}
+	onErrFatalf(err, "formatting output (see ./broken.txt)")
+
+	err = os.WriteFile(filename, formatted, 0644)
+	onErrFatalf(err, "writing output file")
+	fmt.Printf("wrote %d bytes\n", len(formatted))
+}
+
+// removeIfExists removes filename, which is left over from a previous run with
+// different flags, if it exists.
+func removeIfExists(filename string) {
+	err := os.Remove(filename)
+	assert(err == nil || os.IsNotExist(err), "removing %s: %v", filename, err)
+}
+
+// byteTokensAsBytes converts the byte-to-token table to the []byte form that
+// is embedded.
+func byteTokensAsBytes(byteTokens []int) []byte {
+	ret := make([]byte, len(byteTokens))
+	for i, v := range byteTokens {
+		ret[i] = byte(v)
+	}
+	return ret
+}
+
+// intsAsInt64s converts a []int to []int64.
+func intsAsInt64s(v []int) []int64 {
+	ret := make([]int64, len(v))
+	for i := range v {
+		ret[i] = int64(v[i])
+	}
+	return ret
+}
+
+// localSourcePath returns the path that the .tiktoken file for spec is read
+// from instead of its URL, if it exists: the -source file, or the file of the
+// same name as the URL's in the -cache directory. It returns "" if neither flag
+// is set.
+func localSourcePath(spec encodingSpec) string {
+	switch {
+	case *source != "":
+		return *source
+	case *cacheDir != "" && spec.URL != "":
+		return filepath.Join(*cacheDir, spec.URL[strings.LastIndex(spec.URL, "/")+1:])
+	case *cacheDir != "":
+		return filepath.Join(*cacheDir, spec.Name+".tiktoken")
+	}
+	return ""
+}
+
+// readSource returns the contents of the .tiktoken file for spec, from a local
+// file if there is one, or else from its URL. Downloaded files are saved to the
+// -cache directory, if set.
+func readSource(spec encodingSpec) []byte {
+	local := localSourcePath(spec)
+	if local != "" {
+		contents, err := os.ReadFile(local)
+		if err == nil {
+			fmt.Printf("read %s (%d bytes)\n", local, len(contents))
+			checkPin(spec.Name, contents)
+			return contents
+		}
+		assert(os.IsNotExist(err) && *source == "" && !*offline, "reading %s: %v\n", local, err)
+	}
+
+	assert(spec.URL != "", "no source for %s; use -url or -source\n", spec.Name)
+	fmt.Printf("retrieving %s... ", spec.URL)
+	contents, err := readFileFromURL(spec.URL)
+	onErrFatalf(err, "loading source data")
+	fmt.Println("OK")
+	checkPin(spec.Name, contents)
+	if local != "" {
+		err = os.MkdirAll(*cacheDir, 0755)
+		onErrFatalf(err, "creating cache directory")
+		err = os.WriteFile(local, contents, 0644)
+		onErrFatalf(err, "saving %s", local)
+	}
+	return contents
+}
+
+// readFileFromURL loads the contents of a URL into a byte slice.
+func readFileFromURL(url string) ([]byte, error) {
+	resp, err := http.Get(url)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching URL: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+	}
+
+	data, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading response body: %w", err)
+	}
+
+	return data, nil
+}
+
+// This style of error handling is a little weird for Go programs, but since
+// this is a CLI program that does one task, it's appropriate to just fatal on
+// an error if we can't recover. As a plus, it's fewer lines than if err { ... }
+// over and over and over...
+
+// onErrFatalf prints a message and ends the program if err!=nil.
+func onErrFatalf(err error, format string, args ...any) {
+	if err != nil {
+		fmt.Printf(format, args...)
+		fmt.Printf(": %v\n", err)
+		os.Exit(1)
+	}
+}
+
+// assert prints a message and ends the program if cond==false.
+func assert(cond bool, format string, args ...any) {
+	if !cond {
+		fmt.Print("assertion failed: ")
+		fmt.Printf(format, args...)
+		os.Exit(1)
+	}
+}
+
+// emitSlice emits a slice of any type, one element per line, using format
+// string "format" and a maximum line length of 72-ish characters. We don't
+// count the leading tab in that 72, hence the "ish".
+func emitSlice(f io.Writer, data any, formatter func(v reflect.Value) string) {
+	val := reflect.ValueOf(data)
+	assert(val.Kind() == reflect.Slice, "emitSlice: not a slice")
+	chars := 0
+	for i := 0; i < val.Len(); i++ {
+		valueStr := formatter(val.Index(i))
+		if chars+len(valueStr) > 72 {
+			fmt.Fprint(f, "\n")
+			chars = 0
+		}
+		if chars > 0 {
+			fmt.Fprint(f, " ")
+			chars++
+		}
+		fmt.Fprint(f, valueStr)
+		chars += len(valueStr)
+	}
+}
+
+// formatted returns a func that formats v with fstr.
+func formatted(fstr string) func(v reflect.Value) string {
+	return func(v reflect.Value) string {
+		return fmt.Sprintf(fstr, v)
+	}
+}
+
+// hexOrDigit formats v as a hex string if it's >=10, or just emits a single
+// digit otherwise. It also outputs a comma.
+func hexOrDigit(v reflect.Value) string {
+	val := 0
+	switch v.Kind() {
+	case reflect.Uint32:
+		val = int(v.Uint())
+	case reflect.Int:
+		val = int(v.Int())
+	}
+	if val < 10 {
+		return fmt.Sprintf("%d,", val)
+	}
+	return fmt.Sprintf("0x%x,", val)
+}
+
+// calcSHA256 returns the SHA256 of a []byte as a hex string.
+func calcSHA256(b []byte) string {
+	h := sha256.New()
+	h.Write(b)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// createPairList returns a list of left<<28|right<<20|n for all two-character
+// tokens. left and right are the string byte values.
+func createPairList(tokenList []string) []int {
+	pairList := make([]int, 0)
+	byteToToken := make([]int, 256)
+	for i := 0; i < 256; i++ {
+		byteToToken[tokenList[i][0]] = i
+	}
+
+	for i := 256; i < len(tokenList); i++ {
+		if len(tokenList[i]) == 2 {
+			pairList = append(pairList, (int(tokenList[i][0])<<28)|(int(tokenList[i][1])<<20)|i)
+		}
+	}
+
+	sort.Ints(pairList)
+	return pairList
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func max(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,138 @@
+// Copyright 2023 Peter Hebert. Licensed under the MIT license.
+
+package main
+
+import (
+	"encoding/base64"
+	"fmt"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/peterheb/gotoken"
+	"github.com/peterheb/gotoken/vocab"
+)
+
+// TestPins checks that every encoding in the table has a pinned SHA-256.
+func TestPins(t *testing.T) {
+	pins := parsePins(embeddedPins)
+	for _, spec := range encodings {
+		if len(pins[spec.Name]) != 64 {
+			t.Errorf("pin for %s = %q; expected a SHA-256", spec.Name, pins[spec.Name])
+		}
+	}
+	if len(pins) != len(encodings) {
+		t.Errorf("pins.sha256 has %d pins; expected %d", len(pins), len(encodings))
+	}
+}
+
+// TestSplitters checks that the splitter of every encoding in the table is
+// known, so that its golden test data can be written.
+func TestSplitters(t *testing.T) {
+	for _, spec := range encodings {
+		if splitterFuncs[spec.Splitter] == nil {
+			t.Errorf("%s: splitter %q is not in splitterFuncs", spec.Name, spec.Splitter)
+		}
+	}
+}
+
+// TestStandalone generates a standalone package for a small vocabulary, and
+// checks that a tokenizer registered with its data works.
+func TestStandalone(t *testing.T) {
+	dir := t.TempDir()
+	var sb strings.Builder
+	vocabulary := []string{"he", "ll", "hell", "hello", " w", " wor", " world"}
+	for i := 0; i < 256+len(vocabulary); i++ {
+		token := string([]byte{byte(i)})
+		if i >= 256 {
+			token = vocabulary[i-256]
+		}
+		fmt.Fprintf(&sb, "%s %d\n", base64.StdEncoding.EncodeToString([]byte(token)), i)
+	}
+	src := filepath.Join(dir, "test_vocab.tiktoken")
+	if err := os.WriteFile(src, []byte(sb.String()), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	*only, *source, *outDir, *pkgName, *standalone = "test_vocab", src, filepath.Join(dir, "testvocab"), "testvocab", true
+	defer func() {
+		*only, *source, *outDir, *pkgName, *standalone = "", "", "", "", false
+	}()
+	pins = parsePins(embeddedPins)
+	generate(encodingSpec{Name: "test_vocab"})
+
+	dataGo, err := os.ReadFile(filepath.Join(dir, "testvocab", "data.go"))
+	if err != nil || !strings.Contains(string(dataGo), "package testvocab") {
+		t.Fatalf("reading data.go: %q, %v", dataGo, err)
+	}
+	if !strings.Contains(string(dataGo), `Source:    "test_vocab.tiktoken"`) {
+		t.Errorf("data.go does not record the source file in encodingDataInfo:\n%s", dataGo)
+	}
+	data, err := os.ReadFile(filepath.Join(dir, "testvocab", "data.bin.gz"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	vocab.Register(vocab.Encoding{
+		Name:          "test_vocab",
+		Data:          data,
+		DataInfo:      gotoken.DataInfo{Source: "test_vocab.tiktoken"},
+		Splitter:      vocab.GPT2Splitter,
+		SpecialTokens: map[string]int{"<|end|>": 300},
+	})
+	defer gotoken.UnregisterTokenizer("test_vocab")
+
+	tok, err := gotoken.GetTokenizer("test_vocab", gotoken.WithSpecialTokens("<|end|>"))
+	if err != nil {
+		t.Fatalf("instantiating tokenizer: %v", err)
+	}
+	const input = "hello world<|end|>"
+	tokens, err := tok.Encode(input)
+	if want := []int{259, 262, 300}; err != nil || !reflect.DeepEqual(tokens, want) {
+		t.Errorf("Encode(%q) = %v, %v; expected %v", input, tokens, err, want)
+	}
+	if decoded, err := tok.Decode(tokens); err != nil || decoded != input {
+		t.Errorf("Decode(%v) = %q, %v; expected %q", tokens, decoded, err, input)
+	}
+	if info := tok.(gotoken.Describer).DataInfo(); info.Source != "test_vocab.tiktoken" {
+		t.Errorf("DataInfo().Source = %q; expected %q", info.Source, "test_vocab.tiktoken")
+	}
+}
+
+// TestCreatePackage writes the tokenizer.go of a new encoding package from
+// tokenizerTemplate, and checks that it is valid Go that registers the
+// encoding with its splitter and special tokens.
+func TestCreatePackage(t *testing.T) {
+	pkgDir := filepath.Join(t.TempDir(), "testbase")
+	createPackage(encodingSpec{
+		Name:     "test_base",
+		Splitter: "internal.CL100KBaseSpanSplitter",
+		SpecialTokens: []specialToken{
+			{"EndOfText", "<|endoftext|>", 1000},
+			{"EndOfPrompt", "<|endofprompt|>", 1001},
+		},
+	}, "testbase", pkgDir)
+
+	src, err := os.ReadFile(filepath.Join(pkgDir, "tokenizer.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := parser.ParseFile(token.NewFileSet(), "tokenizer.go", src, 0); err != nil {
+		t.Fatalf("tokenizer.go does not parse: %v\n%s", err, src)
+	}
+	for _, want := range []string{
+		"package testbase",
+		`EndOfText   = "<|endoftext|>"`,
+		"EndOfPromptID = 1001",
+		"params.Splitter = internal.CL100KBaseSpanSplitter",
+		`internal.ExternalParams(opts.DataFS, opts.DataPath, "test_base")`,
+		`gotoken.RegisterTokenizer("test_base", getTokenizer)`,
+	} {
+		if !strings.Contains(string(src), want) {
+			t.Errorf("tokenizer.go does not contain %q:\n%s", want, src)
+		}
+	}
+}
@@ -98,66 +98,100 @@ func (node *TrieNode) walk(fn func(*TrieNode, int), depth int) int {
 	return count
 }
 
-// serialize serializes the trie into a flat array of uint32s.
+// serialize serializes the trie into a flat array of uint32s. Each node with
+// children is one of:
 //
 //   - uint32: node header
-//     -- bits 0-7: child count for this node
-//     -- bits 8-31: token# of this node plus 1 (-1 encodes as 0, 0 as 1, etc.)
+//     -- bits 0-7: child count for this node (0 means 256)
+//     -- bits 8-30: token# of this node plus 1 (-1 encodes as 0, 0 as 1, etc.)
+//     -- bit 31: 0
 //   - []uint32: child data, one per child in sorted order
 //     -- bits 0-7: byte value specifying which child this is
 //     -- bit 8: 1 = this is a leaf , 0 = this points to a child node
 //     -- bits 9-31: leaf: token# of child (not +1); node: ofs of child node
 //
+// or, for a chain of at least two nodes that each have one child and no token
+// (like the middle of "ization"), a path node:
+//
+//   - uint32: node header
+//     -- bits 0-7: number of bytes in the path, n
+//     -- bit 31: 1
+//   - []uint32: the first n-1 bytes of the path, four per uint32, low bits first
+//   - uint32: child data for the last byte of the path, as above
+//
 // This structure is designed to be easy to walk with the associated lookup()
 // function. We output the trie one layer at a time, in the hopes of creating a
 // little cache locality with the topmost layers that will get hit a lot.
 // Ultimately, however, cache locality is not a trie's strength.
 func (node *TrieNode) serialize() []uint32 {
-	// Determine the size of the output and depth of the trie by walking it
-	outputSize, maxDepth := 0, 0
+	// Determine the depth of the trie by walking it
+	maxDepth := 0
 	node.walk(func(node *TrieNode, depth int) {
-		if len(node.children) > 0 {
-			outputSize += len(node.children) + 1
-		}
 		maxDepth = max(maxDepth, depth)
 	}, 0)
 
-	// Allocate the output array
-	output := make([]uint32, outputSize)
-	i := 0
-
 	// Walk the trie maxDepth+1 times, outputting each layer one by one. The
 	// only important thing about the order from a correctness perspective is
 	// that parent nodes must get emitted before child nodes, so that we can fix
 	// up the parent's pointer to each child.
+	var output []uint32
+	skipped := make(map[*TrieNode]bool) // nodes inside a path node
 	for layer := 0; layer <= maxDepth; layer++ {
 		node.walk(func(node *TrieNode, depth int) {
-			if depth == layer {
-				if node.fixup > 0 {
-					// Modify our parent to point to where we landed in the output
-					output[node.fixup] |= uint32((i & 0x7fffff) << 9)
-				}
-				if len(node.children) > 0 {
-					// Leaf nodes do not get emitted; their info is stored
-					// inside their parent's child pointer.
-					output[i] = uint32(((node.index+1)&0xffffff)<<8 | (len(node.children) & 0xff))
-					i++
-					for _, child := range node.children {
-						if len(child.children) == 0 {
-							// Store leaf as value | index with bit 8 set
-							output[i] = uint32(int(child.value) | child.index<<9 | 0x100)
-						} else {
-							// Store child pointer as value with bit 8 clear, pending fixup
-							output[i] = uint32(int(child.value))
-							child.fixup = i
-						}
-						i++
+			if depth != layer || skipped[node] {
+				return
+			}
+			if node.fixup > 0 {
+				// Modify our parent to point to where we landed in the output
+				output[node.fixup] |= uint32((len(output) & 0x7fffff) << 9)
+			}
+			if len(node.children) == 0 {
+				// Leaf nodes do not get emitted; their info is stored inside
+				// their parent's child pointer.
+				return
+			}
+
+			children := node.children
+			if path := node.path(); len(path) >= 2 {
+				output = append(output, pathNodeFlag|uint32(len(path)))
+				for i := 0; i < len(path)-1; i++ {
+					if i%4 == 0 {
+						output = append(output, 0)
 					}
+					output[len(output)-1] |= uint32(path[i].value) << (8 * (i % 4))
+					skipped[path[i]] = true
+				}
+				children = path[len(path)-1:]
+			} else {
+				assert(node.index+1 < 1<<23, "token %d is too large for the trie", node.index)
+				output = append(output, uint32(((node.index+1)&0x7fffff)<<8|(len(node.children)&0xff)))
+			}
+			for _, child := range children {
+				if len(child.children) == 0 {
+					// Store leaf as value | index with bit 8 set
+					output = append(output, uint32(int(child.value)|child.index<<9|0x100))
+				} else {
+					// Store child pointer as value with bit 8 clear, pending fixup
+					output = append(output, uint32(int(child.value)))
+					child.fixup = len(output) - 1
 				}
 			}
 		}, 0)
 	}
-
-	assert(len(output) == i, "serialized trie not the expected size: got %d, expected %d", i, len(output))
 	return output
 }
+
+// pathNodeFlag is set in the header of a path node; see serialize.
+const pathNodeFlag = 1 << 31
+
+// path returns the nodes of the chain starting at node in which every node but
+// the last has one child and no token, up to the length a path node can hold.
+// It returns nil if node doesn't start such a chain.
+func (node *TrieNode) path() []*TrieNode {
+	var path []*TrieNode
+	for current := node; len(current.children) == 1 && current.index == -1 && len(path) < 255; {
+		current = current.children[0]
+		path = append(path, current)
+	}
+	return path
+}
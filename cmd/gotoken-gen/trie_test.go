@@ -3,6 +3,7 @@
 package main
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/peterheb/gotoken/internal"
@@ -52,6 +53,40 @@ func TestTrieNode(t *testing.T) {
 	}
 }
 
+// TestTrieNodePaths checks that chains of single-child nodes are serialized as
+// path nodes, and can still be looked up at every point along them.
+func TestTrieNodePaths(t *testing.T) {
+	words := []string{"a", "internationalization", "internationally", "intern", strings.Repeat("z", 600)}
+	words = append(words, wordList...)
+	root := BuildTrie(words)
+	serialized := root.serialize()
+
+	// the size without path nodes is a header plus a child entry per node
+	// with children
+	unpacked := 0
+	root.walk(func(node *TrieNode, depth int) {
+		if len(node.children) > 0 {
+			unpacked += len(node.children) + 1
+		}
+	}, 0)
+	if len(serialized) >= unpacked {
+		t.Errorf("serialized trie has %d entries; expected fewer than %d", len(serialized), unpacked)
+	}
+
+	for _, word := range words {
+		for end := 1; end <= len(word)+1; end++ {
+			prefix := word[:min(end, len(word))]
+			if end > len(word) {
+				prefix += "x"
+			}
+			want := root.lookup(prefix)
+			if got := internal.TrieLookup(serialized, []byte(prefix)); got != want {
+				t.Errorf("serialized lookup(%q) = %d, expected %d", prefix, got, want)
+			}
+		}
+	}
+}
+
 // word list extracted from Moby Words II (public domain), deduplicated and
 // shuffled
 //
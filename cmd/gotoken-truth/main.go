@@ -0,0 +1,244 @@
+// Copyright 2023 Peter Hebert. Licensed under the MIT license.
+
+// Command gotoken-truth writes the "ground truth" files that gotoken's tests
+// compare against: for each encoding, the expected tokens for each line of
+// testdata/samples.txt, as a JSON array per line, in testdata/{encoding}.txt.
+// Run it from the gotoken module root, or set -root:
+//
+//	go run ./cmd/gotoken-truth
+//
+// By default, the tokens come from gotoken's own tokenizers, and the output is
+// checked against verified.sha256, the pinned SHA-256 of the output of OpenAI's
+// tiktoken library for the same samples.txt. If gotoken disagrees, nothing is
+// written. If samples.txt has changed, the output can't be checked, and is
+// written with a warning.
+//
+// With -reference tiktoken, the tokens come from tiktoken instead, by running
+// Python with the tiktoken package installed. This is how new samples are
+// verified; -update-pins then pins the new output.
+//
+// With -1gb, the input is testdata/pae-enwiki-2023-04-1gb.txt instead, and the
+// output is written to testdata/{encoding}_1gb.txt, without "_base". See
+// testdata/README.md.
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	_ "embed"
+	"encoding/hex"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/peterheb/gotoken"
+	_ "github.com/peterheb/gotoken/cl100kbase"
+	_ "github.com/peterheb/gotoken/p50kbase"
+	_ "github.com/peterheb/gotoken/r50kbase"
+)
+
+var (
+	rootDir    = flag.String("root", ".", "the gotoken module root `directory`, which contains testdata")
+	encodings  = flag.String("encoding", "r50k_base,p50k_base,cl100k_base", "comma-separated `names` of the encodings to write")
+	reference  = flag.String("reference", "gotoken", "where the tokens come from: gotoken or tiktoken")
+	python     = flag.String("python", "python3", "the Python `interpreter` to run tiktoken with")
+	oneGB      = flag.Bool("1gb", false, "write the ground truth for the 1GB Wikipedia extract instead of samples.txt")
+	updatePins = flag.Bool("update-pins", false, "pin the SHA-256 of samples.txt and of the output in verified.sha256")
+)
+
+const (
+	samplesFile = "samples.txt"
+	oneGBFile   = "pae-enwiki-2023-04-1gb.txt"
+)
+
+// tiktokenScript encodes each line of the file named by its second argument
+// with the tiktoken encoding named by its first, writing the tokens as a JSON
+// array per line. Special tokens in the text are an error, as in gotoken.
+const tiktokenScript = `
+import json, sys, tiktoken
+encoding = tiktoken.get_encoding(sys.argv[1])
+with open(sys.argv[2], 'r') as f:
+    for line in f:
+        print(json.dumps(encoding.encode(line.rstrip('\n'), allowed_special={""})))
+`
+
+// embeddedPins is the contents of verified.sha256, which has a line with the
+// hex SHA-256 and name of samples.txt and of each verified output file, like
+// the output of sha256sum.
+//
+//go:embed verified.sha256
+var embeddedPins string
+
+func main() {
+	flag.Parse()
+	if *reference != "gotoken" && *reference != "tiktoken" {
+		fatalf("unknown -reference %q; expected gotoken or tiktoken\n", *reference)
+	}
+	dir := filepath.Join(*rootDir, "testdata")
+	inputFilename := filepath.Join(dir, samplesFile)
+	if *oneGB {
+		inputFilename = filepath.Join(dir, oneGBFile)
+	}
+	input, err := os.ReadFile(inputFilename)
+	if err != nil {
+		fatalf("reading input: %v\n", err)
+	}
+
+	// Outputs can only be checked against the pins if they are for the same
+	// samples.txt
+	pins := parsePins(embeddedPins)
+	verifiable := !*oneGB && pins[samplesFile] == calcSHA256(input)
+	if !verifiable && !*oneGB && !*updatePins {
+		fmt.Printf("warning: %s has changed since the output was verified; check the output with -reference tiktoken\n", samplesFile)
+	}
+
+	for _, name := range strings.Split(*encodings, ",") {
+		outName := name + ".txt"
+		if *oneGB {
+			outName = strings.TrimSuffix(name, "_base") + "_1gb.txt"
+		}
+		fmt.Printf("%s: writing to %s with %s... ", name, outName, *reference)
+		var output []byte
+		if *reference == "tiktoken" {
+			output, err = encodeTiktoken(*python, name, inputFilename)
+		} else {
+			output, err = encodeGotoken(name, inputFilename)
+		}
+		if err != nil {
+			fatalf("%v\n", err)
+		}
+
+		sum := calcSHA256(output)
+		switch {
+		case *updatePins:
+			pins[outName] = sum
+		case verifiable && pins[outName] == "":
+			fmt.Printf("(no pin for %s) ", outName)
+		case verifiable && pins[outName] != sum && *reference == "gotoken":
+			fatalf("output does not match the verified SHA-256 %s; not writing it\n", pins[outName])
+		case verifiable && pins[outName] != sum:
+			fmt.Printf("(differs from the pinned SHA-256; check it and use -update-pins) ")
+		}
+		if err := os.WriteFile(filepath.Join(dir, outName), output, 0644); err != nil {
+			fatalf("%v\n", err)
+		}
+		fmt.Printf("OK! (%d test cases)\n", bytes.Count(output, []byte{'\n'}))
+	}
+
+	if *updatePins && !*oneGB {
+		pins[samplesFile] = calcSHA256(input)
+		writePins(pins)
+	}
+}
+
+// encodeGotoken returns the tokens for each line of the named file, encoded by
+// gotoken with the named encoding, in the format written by tiktokenScript.
+// Lines are read like internal.TestPairReader reads them.
+func encodeGotoken(encoding, filename string) ([]byte, error) {
+	tok, err := gotoken.GetTokenizer(encoding)
+	if err != nil {
+		return nil, err
+	}
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var out bytes.Buffer
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(nil, 1<<20)
+	for line := 1; scanner.Scan(); line++ {
+		tokens, err := tok.Encode(scanner.Text())
+		if err != nil {
+			return nil, fmt.Errorf("%s:%d: %w", filepath.Base(filename), line, err)
+		}
+		out.WriteByte('[')
+		for i, token := range tokens {
+			if i > 0 {
+				out.WriteString(", ")
+			}
+			out.WriteString(strconv.Itoa(token))
+		}
+		out.WriteString("]\n")
+	}
+	return out.Bytes(), scanner.Err()
+}
+
+// encodeTiktoken returns the output of tiktokenScript for the named encoding
+// and file, run with the given Python interpreter.
+func encodeTiktoken(python, encoding, filename string) ([]byte, error) {
+	cmd := exec.Command(python, "-c", tiktokenScript, encoding, filename)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) {
+			return nil, fmt.Errorf("running tiktoken (is it installed? pip install tiktoken): %v\n%s", err, stderr.Bytes())
+		}
+		return nil, fmt.Errorf("running %s: %w", python, err)
+	}
+	return out, nil
+}
+
+// parsePins parses text in the format of verified.sha256. Blank lines and
+// lines starting with # are ignored.
+func parsePins(text string) map[string]string {
+	ret := make(map[string]string)
+	for _, line := range strings.Split(text, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) == 2 && !strings.HasPrefix(fields[0], "#") {
+			ret[fields[1]] = fields[0]
+		}
+	}
+	return ret
+}
+
+// writePins writes pins to verified.sha256 under -root, keeping the comment at
+// the top of the file, for the next build of gotoken-truth to embed.
+func writePins(pins map[string]string) {
+	filename := filepath.Join(*rootDir, "cmd", "gotoken-truth", "verified.sha256")
+	old, err := os.ReadFile(filename)
+	if err != nil {
+		fatalf("not updating pins: %v\n", err)
+	}
+	var f bytes.Buffer
+	for _, line := range strings.SplitAfter(string(old), "\n") {
+		if !strings.HasPrefix(line, "#") {
+			break
+		}
+		f.WriteString(line)
+	}
+	names := make([]string, 0, len(pins))
+	for name := range pins {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		fmt.Fprintf(&f, "%s  %s\n", pins[name], name)
+	}
+	if err := os.WriteFile(filename, f.Bytes(), 0644); err != nil {
+		fatalf("writing %s: %v\n", filename, err)
+	}
+	fmt.Printf("updated %s\n", filename)
+}
+
+// calcSHA256 returns the hex SHA-256 of b.
+func calcSHA256(b []byte) string {
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:])
+}
+
+// fatalf prints a message and ends the program.
+func fatalf(format string, args ...any) {
+	fmt.Printf(format, args...)
+	os.Exit(1)
+}
@@ -0,0 +1,72 @@
+// Copyright 2023 Peter Hebert. Licensed under the MIT license.
+
+package main
+
+import (
+	"bytes"
+	"flag"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+var samplesPath = filepath.Join("..", "..", "testdata", samplesFile)
+
+// TestVerified checks that gotoken's output for samples.txt matches the
+// pinned SHA-256 of tiktoken's, and the checked-in files.
+func TestVerified(t *testing.T) {
+	samples, err := os.ReadFile(samplesPath)
+	if err != nil {
+		t.Fatalf("reading samples: %v", err)
+	}
+	pins := parsePins(embeddedPins)
+	if pins[samplesFile] != calcSHA256(samples) {
+		t.Skipf("%s has changed since it was pinned", samplesFile)
+	}
+	for _, name := range strings.Split(flag.Lookup("encoding").DefValue, ",") {
+		output, err := encodeGotoken(name, samplesPath)
+		if err != nil {
+			t.Fatalf("encodeGotoken(%s): %v", name, err)
+		}
+		if sum := calcSHA256(output); sum != pins[name+".txt"] {
+			t.Errorf("%s: SHA-256 of output is %s; expected %s", name, sum, pins[name+".txt"])
+		}
+		checkedIn, err := os.ReadFile(filepath.Join("..", "..", "testdata", name+".txt"))
+		if err != nil {
+			t.Fatalf("reading %s.txt: %v", name, err)
+		}
+		if !bytes.Equal(output, checkedIn) {
+			t.Errorf("%s: output differs from testdata/%s.txt", name, name)
+		}
+	}
+}
+
+// TestTiktoken compares the output of gotoken and tiktoken, if Python and
+// tiktoken are installed.
+func TestTiktoken(t *testing.T) {
+	if err := exec.Command("python3", "-c", "import tiktoken").Run(); err != nil {
+		t.Skip("tiktoken is not installed")
+	}
+	for _, name := range strings.Split(flag.Lookup("encoding").DefValue, ",") {
+		want, err := encodeTiktoken("python3", name, samplesPath)
+		if err != nil {
+			t.Fatalf("encodeTiktoken(%s): %v", name, err)
+		}
+		got, err := encodeGotoken(name, samplesPath)
+		if err != nil {
+			t.Fatalf("encodeGotoken(%s): %v", name, err)
+		}
+		if !bytes.Equal(got, want) {
+			t.Errorf("%s: gotoken and tiktoken differ", name)
+		}
+	}
+}
+
+func TestParsePins(t *testing.T) {
+	pins := parsePins("# comment\n\nabc  samples.txt\ndef  r50k_base.txt\n")
+	if len(pins) != 2 || pins["samples.txt"] != "abc" || pins["r50k_base.txt"] != "def" {
+		t.Errorf("parsePins() = %v", pins)
+	}
+}
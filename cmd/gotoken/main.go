@@ -0,0 +1,378 @@
+// Copyright 2023 Peter Hebert. Licensed under the MIT license.
+
+// Command gotoken encodes, decodes, and counts tokens from the command line.
+//
+// Usage:
+//
+//	gotoken encode [flags] [file ...]
+//	gotoken decode [flags] [file ...]
+//	gotoken count [flags] [file ...]
+//	gotoken compare [flags] [file ...]
+//	gotoken stats [flags] [file ...]
+//	gotoken list
+//
+// Input is read from the named files, or from stdin if no files are given.
+// The encode command writes the tokens as a JSON array, and decode reads
+// integers separated by white space, commas, or brackets, so the output of
+// encode can be piped to decode. With -lines, each line of input is processed
+// separately, one line of output per line of input.
+//
+// The compare command encodes each line of the input with two tokenizers,
+// which can be the same encoding with different data (-b-data), and reports
+// the first line where their tokens differ, exiting with status 1.
+//
+// The stats command writes the number of tokens in the input, tokens per byte,
+// the most frequent tokens, and a histogram of token frequencies, for one or
+// more encodings (-encoding r50k_base,cl100k_base).
+//
+// Run "gotoken <command> -h" for the flags of each command.
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/peterheb/gotoken"
+	_ "github.com/peterheb/gotoken/cl100kbase"
+	"github.com/peterheb/gotoken/compare"
+	_ "github.com/peterheb/gotoken/p50kbase"
+	_ "github.com/peterheb/gotoken/r50kbase"
+	"github.com/peterheb/gotoken/stats"
+)
+
+const usage = `usage: gotoken <command> [flags] [file ...]
+
+Commands:
+  encode    write the tokens of the input as a JSON array
+  decode    write the text of a list of tokens
+  count     write the number of tokens in the input
+  compare   report the first line that two tokenizers encode differently
+  stats     write token statistics of the input for one or more encodings
+  list      write the names of the available encodings and their aliases
+`
+
+// out buffers the output of the commands. It is flushed when main returns,
+// and by onErrFatalf, so that output written before an error isn't lost.
+var out = bufio.NewWriter(os.Stdout)
+
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+
+	defer out.Flush()
+	cmd, args := os.Args[1], os.Args[2:]
+	switch cmd {
+	case "encode":
+		runEncode(args)
+	case "decode":
+		runDecode(args)
+	case "count":
+		runCount(args)
+	case "compare":
+		runCompare(args)
+	case "stats":
+		runStats(args)
+	case "list":
+		runList()
+	default:
+		fmt.Fprintf(os.Stderr, "unknown command %q\n\n%s", cmd, usage)
+		os.Exit(2)
+	}
+}
+
+// runList writes the names of the available encodings, followed by their
+// aliases.
+func runList() {
+	for _, name := range gotoken.ListTokenizers() {
+		fmt.Println(name)
+	}
+	aliases := gotoken.ListAliases()
+	names := make([]string, 0, len(aliases))
+	for alias := range aliases {
+		names = append(names, alias)
+	}
+	sort.Strings(names)
+	for _, alias := range names {
+		fmt.Printf("%s (alias for %s)\n", alias, aliases[alias])
+	}
+}
+
+// tokenizerFlags are the flags shared by commands that need a Tokenizer.
+type tokenizerFlags struct {
+	fs           *flag.FlagSet
+	encoding     *string
+	model        *string
+	specialText  *bool
+	allowSpecial *string
+	lines        *bool
+}
+
+// newTokenizerFlags returns a FlagSet for the named command with the flags to
+// select and configure a tokenizer.
+func newTokenizerFlags(cmd string) *tokenizerFlags {
+	fs := flag.NewFlagSet(cmd, flag.ExitOnError)
+	return &tokenizerFlags{
+		fs:           fs,
+		encoding:     fs.String("encoding", "cl100k_base", "Tokenizer encoding to use (see \"gotoken list\")"),
+		model:        fs.String("model", "", "Model name to select the encoding by, like gpt-3.5-turbo (overrides -encoding)"),
+		specialText:  fs.Bool("special-as-text", false, "Encode special tokens in the input as text"),
+		allowSpecial: fs.String("allow-special", "", "Comma-separated list of special tokens to encode as special tokens"),
+		lines:        fs.Bool("lines", false, "Process each line of the input separately"),
+	}
+}
+
+// tokenizer returns the Tokenizer selected by the flags.
+func (tf *tokenizerFlags) tokenizer() gotoken.Tokenizer {
+	var opts []gotoken.Option
+	if *tf.specialText {
+		opts = append(opts, gotoken.WithSpecialTokensAsText())
+	}
+	if *tf.allowSpecial != "" {
+		opts = append(opts, gotoken.WithSpecialTokens(strings.Split(*tf.allowSpecial, ",")...))
+	}
+	encoding := *tf.encoding
+	if *tf.model != "" {
+		var err error
+		encoding, err = gotoken.EncodingForModel(*tf.model)
+		onErrFatalf(err, "find encoding")
+	}
+	tok, err := gotoken.GetTokenizer(encoding, opts...)
+	onErrFatalf(err, "create tokenizer")
+	return tok
+}
+
+// runEncode implements the encode command.
+func runEncode(args []string) {
+	tf := newTokenizerFlags("encode")
+	tf.fs.Parse(args)
+	tok := tf.tokenizer()
+
+	forEachInput(tf.fs.Args(), *tf.lines, func(name, text string) {
+		tokens, err := tok.Encode(text)
+		onErrFatalf(err, "encode %s", name)
+		if tokens == nil {
+			tokens = []int{}
+		}
+		b, _ := json.Marshal(tokens)
+		out.Write(b)
+		out.WriteByte('\n')
+	})
+}
+
+// runDecode implements the decode command.
+func runDecode(args []string) {
+	tf := newTokenizerFlags("decode")
+	tf.fs.Parse(args)
+	tok := tf.tokenizer()
+
+	forEachInput(tf.fs.Args(), *tf.lines, func(name, text string) {
+		tokens, err := parseTokens(text)
+		onErrFatalf(err, "decode %s", name)
+		decoded, err := tok.Decode(tokens)
+		onErrFatalf(err, "decode %s", name)
+		out.WriteString(decoded)
+		if *tf.lines {
+			out.WriteByte('\n')
+		}
+	})
+}
+
+// runCount implements the count command. Like wc, it prints the name of each
+// file after its count, and a total if there is more than one file.
+func runCount(args []string) {
+	tf := newTokenizerFlags("count")
+	tf.fs.Parse(args)
+	tok := tf.tokenizer()
+	files := tf.fs.Args()
+
+	total := 0
+	forEachInput(files, *tf.lines, func(name, text string) {
+		tokens, err := tok.Encode(text)
+		onErrFatalf(err, "count %s", name)
+		total += len(tokens)
+		if *tf.lines || len(files) == 0 {
+			fmt.Fprintln(out, len(tokens))
+		} else {
+			fmt.Fprintf(out, "%d %s\n", len(tokens), name)
+		}
+	})
+	if len(files) > 1 && !*tf.lines {
+		fmt.Fprintf(out, "%d total\n", total)
+	}
+}
+
+// runCompare implements the compare command.
+func runCompare(args []string) {
+	fs := flag.NewFlagSet("compare", flag.ExitOnError)
+	encodingA := fs.String("a", "cl100k_base", "Tokenizer encoding to compare")
+	encodingB := fs.String("b", "", "Tokenizer encoding to compare with (default the same as -a)")
+	dataA := fs.String("a-data", "", "Load the data for -a from this file (see gotoken-gen -datadir)")
+	dataB := fs.String("b-data", "", "Load the data for -b from this file")
+	specialText := fs.Bool("special-as-text", false, "Encode special tokens in the input as text")
+	fs.Parse(args)
+	if *encodingB == "" {
+		*encodingB = *encodingA
+	}
+	newTokenizer := func(encoding, data string) gotoken.Tokenizer {
+		var opts []gotoken.Option
+		if data != "" {
+			opts = append(opts, gotoken.WithDataFile(data))
+		}
+		if *specialText {
+			opts = append(opts, gotoken.WithSpecialTokensAsText())
+		}
+		tok, err := gotoken.GetTokenizer(encoding, opts...)
+		onErrFatalf(err, "create tokenizer")
+		return tok
+	}
+	a, b := newTokenizer(*encodingA, *dataA), newTokenizer(*encodingB, *dataB)
+
+	files := fs.Args()
+	if len(files) == 0 {
+		files = []string{"-"}
+	}
+	lines, tokens := 0, 0
+	for _, file := range files {
+		var r io.Reader = os.Stdin
+		if file != "-" {
+			f, err := os.Open(file)
+			onErrFatalf(err, "open %s", file)
+			defer f.Close()
+			r = f
+		}
+		report, err := compare.Lines(a, b, r)
+		onErrFatalf(err, "read %s", file)
+		lines += report.Lines
+		tokens += report.Tokens
+		if report.Divergence != nil {
+			fmt.Printf("%s: %s", file, report.Divergence)
+			os.Exit(1)
+		}
+	}
+	fmt.Printf("no differences in %d lines (%d tokens)\n", lines, tokens)
+}
+
+// runStats implements the stats command.
+func runStats(args []string) {
+	fs := flag.NewFlagSet("stats", flag.ExitOnError)
+	encodings := fs.String("encoding", "cl100k_base", "Comma-separated tokenizer encodings to analyze")
+	top := fs.Int("top", 10, "Number of most frequent tokens to write")
+	histogram := fs.Bool("histogram", false, "Write a histogram of how often tokens occur")
+	specialText := fs.Bool("special-as-text", false, "Encode special tokens in the input as text")
+	fs.Parse(args)
+
+	var toks []gotoken.Tokenizer
+	for _, name := range strings.Split(*encodings, ",") {
+		var opts []gotoken.Option
+		if *specialText {
+			opts = append(opts, gotoken.WithSpecialTokensAsText())
+		}
+		tok, err := gotoken.GetTokenizer(name, opts...)
+		onErrFatalf(err, "create tokenizer")
+		toks = append(toks, tok)
+	}
+
+	var r io.Reader = os.Stdin
+	if files := fs.Args(); len(files) > 0 {
+		readers := make([]io.Reader, len(files))
+		for i, file := range files {
+			f, err := os.Open(file)
+			onErrFatalf(err, "open %s", file)
+			defer f.Close()
+			readers[i] = f
+		}
+		r = io.MultiReader(readers...)
+	}
+	all, err := stats.AnalyzeEach(toks, r)
+	onErrFatalf(err, "analyze")
+
+	for i, s := range all {
+		if i > 0 {
+			out.WriteByte('\n')
+		}
+		fmt.Fprintf(out, "%s: %d tokens, %d bytes, %d lines\n", s.Name(), s.Tokens, s.Bytes, s.Lines)
+		fmt.Fprintf(out, "  %.4f tokens/byte, %d distinct tokens\n", s.TokensPerByte(), s.Distinct())
+		if *top > 0 {
+			fmt.Fprintf(out, "  top tokens:\n")
+			for _, tc := range s.Top(*top) {
+				fmt.Fprintf(out, "  %10d  %6d %q\n", tc.Count, tc.Token, tc.Text)
+			}
+		}
+		if *histogram {
+			fmt.Fprintf(out, "  occurrences  tokens\n")
+			for _, b := range s.Histogram() {
+				fmt.Fprintf(out, "  %11s  %6d\n", fmt.Sprintf("%d-%d", b.Min, b.Max), b.Tokens)
+			}
+		}
+	}
+}
+
+// forEachInput calls fn with the contents of each file, or of stdin if files is
+// empty. If lines is true, fn is called once per line instead.
+func forEachInput(files []string, lines bool, fn func(name, text string)) {
+	if len(files) == 0 {
+		processInput("<stdin>", os.Stdin, lines, fn)
+		return
+	}
+	for _, file := range files {
+		f, err := os.Open(file)
+		onErrFatalf(err, "open %s", file)
+		processInput(file, f, lines, fn)
+		f.Close()
+	}
+}
+
+// processInput calls fn for the contents of r, or for each of its lines.
+func processInput(name string, r io.Reader, lines bool, fn func(name, text string)) {
+	if !lines {
+		data, err := io.ReadAll(r)
+		onErrFatalf(err, "read %s", name)
+		fn(name, string(data))
+		return
+	}
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(nil, 64*1024*1024)
+	for i := 1; scanner.Scan(); i++ {
+		fn(fmt.Sprintf("%s:%d", name, i), scanner.Text())
+	}
+	onErrFatalf(scanner.Err(), "read %s", name)
+}
+
+// parseTokens parses a list of integers separated by white space, commas, or
+// square brackets, which includes JSON arrays.
+func parseTokens(text string) ([]int, error) {
+	fields := strings.FieldsFunc(text, func(r rune) bool {
+		return r == ',' || r == '[' || r == ']' || unicode.IsSpace(r)
+	})
+	tokens := make([]int, len(fields))
+	for i, field := range fields {
+		token, err := strconv.Atoi(field)
+		if err != nil {
+			return nil, fmt.Errorf("invalid token %q", field)
+		}
+		tokens[i] = token
+	}
+	return tokens, nil
+}
+
+// onErrFatalf prints a message and ends the program if err!=nil, after
+// flushing the output written so far.
+func onErrFatalf(err error, format string, args ...any) {
+	if err != nil {
+		out.Flush()
+		fmt.Fprintf(os.Stderr, format, args...)
+		fmt.Fprintf(os.Stderr, ": %v\n", err)
+		os.Exit(1)
+	}
+}
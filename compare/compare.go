@@ -0,0 +1,176 @@
+// Copyright 2023 Peter Hebert. Licensed under the MIT license.
+
+// Package compare checks whether two tokenizers encode a corpus the same way,
+// and reports the first place where they don't. It is for validating changes
+// to gotoken itself, like data regenerated by gotoken-gen or a rewrite of the
+// encoder, against a large corpus such as the 1GB Wikipedia extract described
+// in testdata/README.md:
+//
+//	old, err := gotoken.GetTokenizer("cl100k_base", gotoken.WithDataFile("old.gotoken"))
+//	...
+//	new, err := gotoken.GetTokenizer("cl100k_base")
+//	...
+//	report, err := compare.Lines(old, new, corpus)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	if report.Divergence != nil {
+//	    fmt.Println(report.Divergence)
+//	}
+//
+// The "gotoken compare" command does the same from the command line.
+package compare
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/peterheb/gotoken"
+)
+
+// Context is the number of tokens before and after the first differing token
+// that a Divergence includes.
+const Context = 3
+
+// Report is the result of comparing two tokenizers on a corpus.
+type Report struct {
+	Lines      int         // number of lines compared
+	Tokens     int         // number of tokens in the lines that matched
+	Divergence *Divergence // the first line the tokenizers disagree on, or nil
+}
+
+// Divergence describes where the output of two tokenizers first differs.
+type Divergence struct {
+	Line   int    // 1-based line number in the corpus, or 0 from Text
+	Text   string // the text that was encoded
+	Index  int    // index of the first token that differs
+	Offset int    // byte offset in Text where the tokens start to differ
+	A, B   Side   // the output of each tokenizer
+}
+
+// Side is the output of one of the tokenizers around a Divergence.
+type Side struct {
+	Start  int      // index of the first token in Tokens
+	Tokens []int    // the tokens from Index-Context to Index+Context
+	Pieces []string // the string of each token in Tokens
+	Err    error    // the error encoding Text, if any
+}
+
+// Text encodes text with a and b, and returns where their output first
+// differs, or nil if it is the same. If both return an error with the same
+// message, the output is considered the same.
+func Text(a, b gotoken.Tokenizer, text string) *Divergence {
+	d, _ := diff(a, b, text)
+	return d
+}
+
+// diff is like Text, but also returns the number of tokens from a.
+func diff(a, b gotoken.Tokenizer, text string) (*Divergence, int) {
+	tokensA, offsetsA, errA := a.EncodeWithOffsets(text)
+	tokensB, offsetsB, errB := b.EncodeWithOffsets(text)
+	sameErr := errA == nil && errB == nil || errA != nil && errB != nil && errA.Error() == errB.Error()
+	index := 0
+	for index < len(tokensA) && index < len(tokensB) && tokensA[index] == tokensB[index] {
+		index++
+	}
+	if sameErr && index == len(tokensA) && index == len(tokensB) {
+		return nil, len(tokensA)
+	}
+
+	// The tokens before index are the same, so both tokenizers agree on where
+	// the differing token starts
+	offset := len(text)
+	if index < len(offsetsA) {
+		offset = offsetsA[index]
+	} else if index < len(offsetsB) {
+		offset = offsetsB[index]
+	}
+	return &Divergence{
+		Text:   text,
+		Index:  index,
+		Offset: offset,
+		A:      side(a, tokensA, index, errA),
+		B:      side(b, tokensB, index, errB),
+	}, len(tokensA)
+}
+
+// side returns the Side for tokens from tok around index.
+func side(tok gotoken.Tokenizer, tokens []int, index int, err error) Side {
+	start := index - Context
+	if start < 0 {
+		start = 0
+	}
+	end := index + Context + 1
+	if end > len(tokens) {
+		end = len(tokens)
+	}
+	s := Side{Err: err}
+	if start < end {
+		s.Start = start
+		s.Tokens = tokens[start:end]
+	}
+	for _, token := range s.Tokens {
+		// Decoding fails for count-only builds, which leaves the piece empty
+		piece, _ := tok.DecodeToken(token)
+		s.Pieces = append(s.Pieces, piece)
+	}
+	return s
+}
+
+// Lines compares the output of a and b on each line of r, without its line
+// break, stopping at the first line where they differ. An error is returned
+// only if r can't be read.
+func Lines(a, b gotoken.Tokenizer, r io.Reader) (*Report, error) {
+	report := &Report{}
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(nil, 64*1024*1024)
+	for scanner.Scan() {
+		report.Lines++
+		d, tokens := diff(a, b, scanner.Text())
+		if d != nil {
+			d.Line = report.Lines
+			report.Divergence = d
+			return report, nil
+		}
+		report.Tokens += tokens
+	}
+	return report, scanner.Err()
+}
+
+// String describes d on several lines: where it is, the text around it, and
+// the tokens from each tokenizer, with the first differing token marked.
+func (d *Divergence) String() string {
+	var sb strings.Builder
+	if d.Line > 0 {
+		fmt.Fprintf(&sb, "line %d, ", d.Line)
+	}
+	fmt.Fprintf(&sb, "token %d, byte %d: ", d.Index, d.Offset)
+	start, end := d.Offset-40, d.Offset+40
+	if start < 0 {
+		start = 0
+	}
+	if end > len(d.Text) {
+		end = len(d.Text)
+	}
+	fmt.Fprintf(&sb, "%q >>> %q\n", d.Text[start:d.Offset], d.Text[d.Offset:end])
+	for _, s := range []struct {
+		name string
+		Side
+	}{{"a", d.A}, {"b", d.B}} {
+		fmt.Fprintf(&sb, "  %s:", s.name)
+		for i, token := range s.Tokens {
+			mark := ""
+			if s.Start+i == d.Index {
+				mark = ">>>"
+			}
+			fmt.Fprintf(&sb, " %s%d %q", mark, token, s.Pieces[i])
+		}
+		if s.Err != nil {
+			fmt.Fprintf(&sb, " (error: %v)", s.Err)
+		}
+		sb.WriteByte('\n')
+	}
+	return sb.String()
+}
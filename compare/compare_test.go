@@ -0,0 +1,72 @@
+// Copyright 2023 Peter Hebert. Licensed under the MIT license.
+
+package compare_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/peterheb/gotoken"
+	"github.com/peterheb/gotoken/compare"
+	_ "github.com/peterheb/gotoken/p50kbase"
+	_ "github.com/peterheb/gotoken/r50kbase"
+)
+
+func getTokenizer(t *testing.T, name string, opts ...gotoken.Option) gotoken.Tokenizer {
+	t.Helper()
+	tok, err := gotoken.GetTokenizer(name, opts...)
+	if err != nil {
+		t.Fatalf("instantiating tokenizer: %v", err)
+	}
+	return tok
+}
+
+func TestLines(t *testing.T) {
+	r50k := getTokenizer(t, "r50k_base")
+	p50k := getTokenizer(t, "p50k_base")
+	corpus := "Hello, world!\nsame here\nbut      not     here\nor here  "
+
+	report, err := compare.Lines(r50k, getTokenizer(t, "r50k_base"), strings.NewReader(corpus))
+	if err != nil || report.Divergence != nil || report.Lines != 4 || report.Tokens == 0 {
+		t.Fatalf("Lines() with the same encoding = %+v, %v", report, err)
+	}
+
+	// p50k_base has tokens for runs of spaces, which r50k_base doesn't
+	report, err = compare.Lines(r50k, p50k, strings.NewReader(corpus))
+	if err != nil || report.Divergence == nil {
+		t.Fatalf("Lines() = %+v, %v; expected a divergence", report, err)
+	}
+	d := report.Divergence
+	if report.Lines != 3 || d.Line != 3 || d.Text != "but      not     here" {
+		t.Errorf("divergence on line %d (%q) after %d lines; expected line 3", d.Line, d.Text, report.Lines)
+	}
+	if d.Index != 1 || d.Offset != 3 {
+		t.Errorf("divergence at token %d, byte %d; expected token 1, byte 3", d.Index, d.Offset)
+	}
+	if d.A.Start != 0 || d.A.Pieces[0] != "but" || d.A.Pieces[1] != " " || d.B.Pieces[1] != "     " {
+		t.Errorf("divergence context = %+v, %+v", d.A, d.B)
+	}
+	if s := d.String(); !strings.Contains(s, "line 3, token 1, byte 3") || !strings.Contains(s, `>>>220 " "`) {
+		t.Errorf("String() = %q", s)
+	}
+}
+
+func TestText(t *testing.T) {
+	a := getTokenizer(t, "r50k_base")
+	b := getTokenizer(t, "r50k_base", gotoken.WithSpecialTokensAsText())
+	if d := compare.Text(a, b, "no special tokens"); d != nil {
+		t.Errorf("Text() = %v; expected nil", d)
+	}
+
+	// an error is a divergence, unless both tokenizers return it
+	d := compare.Text(a, b, "x <|endoftext|>")
+	if d == nil || d.A.Err == nil || d.B.Err != nil || d.Index != 0 || d.Offset != 0 {
+		t.Fatalf("Text() = %+v; expected an error from a only", d)
+	}
+	if !strings.Contains(d.String(), "error:") {
+		t.Errorf("String() = %q; expected the error", d.String())
+	}
+	if d := compare.Text(a, a, "x <|endoftext|>"); d != nil {
+		t.Errorf("Text() with the same error = %v; expected nil", d)
+	}
+}
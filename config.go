@@ -0,0 +1,166 @@
+// Copyright 2023 Peter Hebert. Licensed under the MIT license.
+
+package gotoken
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+// TokenizerConfig is the configuration of a tokenizer as plain data, for
+// services that configure tokenization from a file or environment instead of
+// composing functional options in code. It has JSON and YAML field tags, and
+// [LoadTokenizerConfig] reads one from a JSON file. A TokenizerConfig is
+// turned into a tokenizer by [GetTokenizerFromConfig].
+//
+// Each field corresponds to an option of [GetTokenizer], and its zero value
+// leaves that option at its default. Options that take functions, like
+// [WithMetricsHook], have no field, but can be passed to
+// GetTokenizerFromConfig alongside the config.
+type TokenizerConfig struct {
+	// Encoding is the name of the encoding, like "cl100k_base". If it is
+	// empty, the encoding of Model is used instead.
+	Encoding string `json:"encoding,omitempty" yaml:"encoding,omitempty"`
+	// Model is the name of a model to look up with [EncodingForModel], like
+	// "gpt-3.5-turbo", for when Encoding is empty.
+	Model string `json:"model,omitempty" yaml:"model,omitempty"`
+
+	AllowedSpecialTokens []string `json:"allowed_special_tokens,omitempty" yaml:"allowed_special_tokens,omitempty"` // see WithSpecialTokens
+	SpecialTokensAsText  bool     `json:"special_tokens_as_text,omitempty" yaml:"special_tokens_as_text,omitempty"` // see WithSpecialTokensAsText
+	PartialResults       bool     `json:"partial_results,omitempty" yaml:"partial_results,omitempty"`               // see WithPartialResults
+	StrictUTF8           bool     `json:"strict_utf8,omitempty" yaml:"strict_utf8,omitempty"`                       // see WithStrictUTF8
+
+	MaxTokens      int `json:"max_tokens,omitempty" yaml:"max_tokens,omitempty"`             // see WithMaxTokens
+	MaxInputBytes  int `json:"max_input_bytes,omitempty" yaml:"max_input_bytes,omitempty"`   // see WithMaxInputBytes
+	MaxDecodeBytes int `json:"max_decode_bytes,omitempty" yaml:"max_decode_bytes,omitempty"` // see WithMaxDecodeBytes
+	MaxPieceBytes  int `json:"max_piece_bytes,omitempty" yaml:"max_piece_bytes,omitempty"`   // see WithMaxPieceBytes
+
+	Preset            Preset `json:"preset,omitempty" yaml:"preset,omitempty"`                         // see WithPreset
+	CacheSize         int    `json:"cache_size,omitempty" yaml:"cache_size,omitempty"`                 // see WithCacheSize
+	ParallelThreshold int    `json:"parallel_threshold,omitempty" yaml:"parallel_threshold,omitempty"` // see WithParallelThreshold
+
+	DataFile string `json:"data_file,omitempty" yaml:"data_file,omitempty"` // see WithDataFile
+}
+
+// Options returns the options of [GetTokenizer] that cfg stands for. Its
+// Encoding and Model are not included.
+func (cfg TokenizerConfig) Options() []Option {
+	var opts []Option
+	if len(cfg.AllowedSpecialTokens) > 0 {
+		opts = append(opts, WithSpecialTokens(cfg.AllowedSpecialTokens...))
+	}
+	if cfg.SpecialTokensAsText {
+		opts = append(opts, WithSpecialTokensAsText())
+	}
+	if cfg.PartialResults {
+		opts = append(opts, WithPartialResults())
+	}
+	if cfg.StrictUTF8 {
+		opts = append(opts, WithStrictUTF8())
+	}
+	if cfg.MaxTokens != 0 {
+		opts = append(opts, WithMaxTokens(cfg.MaxTokens))
+	}
+	if cfg.MaxInputBytes != 0 {
+		opts = append(opts, WithMaxInputBytes(cfg.MaxInputBytes))
+	}
+	if cfg.MaxDecodeBytes != 0 {
+		opts = append(opts, WithMaxDecodeBytes(cfg.MaxDecodeBytes))
+	}
+	// The preset comes before MaxPieceBytes, CacheSize, and
+	// ParallelThreshold, so they override it
+	if cfg.Preset != PresetDefault {
+		opts = append(opts, WithPreset(cfg.Preset))
+	}
+	if cfg.MaxPieceBytes != 0 {
+		opts = append(opts, WithMaxPieceBytes(cfg.MaxPieceBytes))
+	}
+	if cfg.CacheSize != 0 {
+		opts = append(opts, WithCacheSize(cfg.CacheSize))
+	}
+	if cfg.ParallelThreshold != 0 {
+		opts = append(opts, WithParallelThreshold(cfg.ParallelThreshold))
+	}
+	if cfg.DataFile != "" {
+		opts = append(opts, WithDataFile(cfg.DataFile))
+	}
+	return opts
+}
+
+// GetTokenizerFromConfig returns a tokenizer configured by cfg, like
+// [GetTokenizer] with the options of [TokenizerConfig.Options]. The opts are
+// applied after those of cfg, so they can add options that cfg can't hold, like
+// [WithTraceHook], or override it.
+func GetTokenizerFromConfig(cfg TokenizerConfig, opts ...Option) (Tokenizer, error) {
+	encoding := cfg.Encoding
+	if encoding == "" {
+		if cfg.Model == "" {
+			return nil, fmt.Errorf("%w: config has no encoding or model", ErrUnknownEncoding)
+		}
+		var err error
+		if encoding, err = EncodingForModel(cfg.Model); err != nil {
+			return nil, err
+		}
+	}
+	return GetTokenizer(encoding, append(cfg.Options(), opts...)...)
+}
+
+// LoadTokenizerConfig reads a [TokenizerConfig] from the JSON file at path.
+// Unknown fields are an error, so that a misspelled setting isn't silently
+// ignored.
+func LoadTokenizerConfig(path string) (TokenizerConfig, error) {
+	var cfg TokenizerConfig
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return cfg, err
+	}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&cfg); err != nil {
+		return TokenizerConfig{}, fmt.Errorf("loading tokenizer config %s: %w", path, err)
+	}
+	return cfg, nil
+}
+
+// presetNames are the names of the presets in a TokenizerConfig.
+var presetNames = []string{
+	PresetDefault:  "default",
+	PresetChat:     "chat",
+	PresetCode:     "code",
+	PresetLogLines: "log_lines",
+}
+
+// String returns the name of p, like "chat" for PresetChat.
+func (p Preset) String() string {
+	if p >= 0 && int(p) < len(presetNames) {
+		return presetNames[p]
+	}
+	return fmt.Sprintf("Preset(%d)", int(p))
+}
+
+// MarshalText implements [encoding.TextMarshaler], so that a Preset is written
+// by its name in JSON or YAML.
+func (p Preset) MarshalText() ([]byte, error) {
+	if p < 0 || int(p) >= len(presetNames) {
+		return nil, fmt.Errorf("unknown preset %d", int(p))
+	}
+	return []byte(presetNames[p]), nil
+}
+
+// UnmarshalText implements [encoding.TextUnmarshaler]. It accepts the names
+// returned by String, and an empty string for PresetDefault.
+func (p *Preset) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*p = PresetDefault
+		return nil
+	}
+	for i, name := range presetNames {
+		if string(text) == name {
+			*p = Preset(i)
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown preset %q", text)
+}
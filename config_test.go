@@ -0,0 +1,165 @@
+// Copyright 2023 Peter Hebert. Licensed under the MIT license.
+
+package gotoken
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestTokenizerConfigOptions(t *testing.T) {
+	cfg := TokenizerConfig{
+		Encoding:             "runes",
+		AllowedSpecialTokens: []string{"<|foo|>"},
+		SpecialTokensAsText:  true,
+		PartialResults:       true,
+		StrictUTF8:           true,
+		MaxTokens:            10,
+		MaxInputBytes:        20,
+		MaxDecodeBytes:       30,
+		MaxPieceBytes:        40,
+		Preset:               PresetCode,
+		ParallelThreshold:    50,
+		DataFile:             "data.tiktoken",
+	}
+	var got TokenizerOptions
+	for _, opt := range cfg.Options() {
+		opt(&got)
+	}
+	want := TokenizerOptions{
+		AllowSpecialAsText:   true,
+		AllowedSpecialTokens: []string{"<|foo|>"},
+		PartialResults:       true,
+		MaxTokens:            10,
+		MaxInputBytes:        20,
+		MaxDecodeBytes:       30,
+		MaxPieceBytes:        40,
+		StrictUTF8:           true,
+		DataPath:             "data.tiktoken",
+		BytesPerToken:        3,
+		ParallelThreshold:    50,
+		CacheSize:            32768, // from the preset
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Options():\n got %+v\nwant %+v", got, want)
+	}
+
+	// A CacheSize in the config overrides the preset's
+	cfg.CacheSize = 100
+	got = TokenizerOptions{}
+	for _, opt := range cfg.Options() {
+		opt(&got)
+	}
+	if got.CacheSize != 100 {
+		t.Errorf("Options() with CacheSize: CacheSize = %d, want 100", got.CacheSize)
+	}
+
+	if opts := (TokenizerConfig{Encoding: "runes"}).Options(); len(opts) != 0 {
+		t.Errorf("Options() of an empty config: got %d options, want 0", len(opts))
+	}
+}
+
+func TestGetTokenizerFromConfig(t *testing.T) {
+	tok, err := GetTokenizerFromConfig(TokenizerConfig{
+		Encoding:             "runes",
+		AllowedSpecialTokens: []string{"<|foo|>"},
+	}, WithSpecialTokensAsText())
+	if err != nil {
+		t.Fatalf("GetTokenizerFromConfig(): %v", err)
+	}
+	rt := tok.(*runeTokenizer)
+	if !rt.allowSpecialAsText || !reflect.DeepEqual(rt.allowedSpecialTokens, []string{"<|foo|>"}) {
+		t.Errorf("GetTokenizerFromConfig(): options not applied, got %+v", rt)
+	}
+
+	// Model is used when there is no Encoding
+	RegisterModel("test-config-model", "runes")
+	defer func() {
+		modelMu.Lock()
+		delete(userModels, "test-config-model")
+		modelMu.Unlock()
+	}()
+	if _, err := GetTokenizerFromConfig(TokenizerConfig{Model: "test-config-model"}); err != nil {
+		t.Errorf("GetTokenizerFromConfig() with Model: %v", err)
+	}
+
+	for _, tt := range []struct {
+		cfg  TokenizerConfig
+		want error
+	}{
+		{TokenizerConfig{}, ErrUnknownEncoding},
+		{TokenizerConfig{Encoding: "does_not_exist"}, ErrUnknownEncoding},
+		{TokenizerConfig{Model: "does-not-exist"}, ErrUnknownModel},
+	} {
+		if _, err := GetTokenizerFromConfig(tt.cfg); !errors.Is(err, tt.want) {
+			t.Errorf("GetTokenizerFromConfig(%+v): got error %v, want %v", tt.cfg, err, tt.want)
+		}
+	}
+}
+
+func TestLoadTokenizerConfig(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name, data string) string {
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, []byte(data), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		return path
+	}
+
+	path := write("good.json", `{
+		"encoding": "cl100k_base",
+		"allowed_special_tokens": ["<|endoftext|>"],
+		"max_tokens": 8192,
+		"preset": "log_lines"
+	}`)
+	cfg, err := LoadTokenizerConfig(path)
+	if err != nil {
+		t.Fatalf("LoadTokenizerConfig(): %v", err)
+	}
+	want := TokenizerConfig{
+		Encoding:             "cl100k_base",
+		AllowedSpecialTokens: []string{"<|endoftext|>"},
+		MaxTokens:            8192,
+		Preset:               PresetLogLines,
+	}
+	if !reflect.DeepEqual(cfg, want) {
+		t.Errorf("LoadTokenizerConfig():\n got %+v\nwant %+v", cfg, want)
+	}
+
+	for _, data := range []string{
+		`{"encoding": "cl100k_base", "max_token": 10}`, // misspelled field
+		`{"preset": "fast"}`,
+		`{"encoding": `,
+	} {
+		if _, err := LoadTokenizerConfig(write("bad.json", data)); err == nil {
+			t.Errorf("LoadTokenizerConfig(%s): expected error, got nil", data)
+		}
+	}
+	if _, err := LoadTokenizerConfig(filepath.Join(dir, "missing.json")); err == nil {
+		t.Errorf("LoadTokenizerConfig() of a missing file: expected error, got nil")
+	}
+}
+
+func TestPresetText(t *testing.T) {
+	for _, p := range []Preset{PresetDefault, PresetChat, PresetCode, PresetLogLines} {
+		data, err := json.Marshal(p)
+		if err != nil {
+			t.Fatalf("json.Marshal(%v): %v", p, err)
+		}
+		var got Preset
+		if err := json.Unmarshal(data, &got); err != nil || got != p {
+			t.Errorf("round trip of %v through %s: got %v, %v", p, data, got, err)
+		}
+	}
+	if s := Preset(99).String(); s != "Preset(99)" {
+		t.Errorf("Preset(99).String() = %q", s)
+	}
+	if _, err := json.Marshal(Preset(99)); err == nil {
+		t.Errorf("json.Marshal(Preset(99)): expected error, got nil")
+	}
+}
@@ -0,0 +1,109 @@
+// Copyright 2023 Peter Hebert. Licensed under the MIT license.
+
+package gotoken
+
+import (
+	"context"
+	"runtime"
+	"sync"
+)
+
+// BatchCount is the result of [CountBatch] and [CountBatchIter].
+type BatchCount struct {
+	Counts []int // the number of tokens in each input, or 0 if it failed
+	Total  int64 // the sum of Counts
+	Bytes  int64 // the total length of the inputs in bytes, including failed ones
+}
+
+// CountBatch counts the tokens in each of inputs, using a pool of workers
+// goroutines, and returns the count for each input along with the totals. If
+// workers <= 0, runtime.GOMAXPROCS(0) workers are used. Each input is counted
+// with CountCtx, so wrappers and hooks that record calls see one count per
+// input.
+//
+// If any input fails to count, a [*BatchError] is returned with the error for
+// each input, along with the counts of the others; the count of a failed input
+// is 0, and it is not included in Total.
+func CountBatch(tok Tokenizer, inputs []string, workers int) (BatchCount, error) {
+	return CountBatchIter(tok, func(yield func(string) bool) {
+		for _, input := range inputs {
+			if !yield(input) {
+				return
+			}
+		}
+	}, workers)
+}
+
+// CountBatchIter is like [CountBatch], but takes the inputs from an iterator,
+// in the style of [Tokenizer.VocabIter], so that they don't all have to be in
+// memory at once. The iterator is called on the calling goroutine, and the
+// inputs are counted in parallel while it runs. Counts are in the order the
+// inputs were yielded.
+func CountBatchIter(tok Tokenizer, inputs func(yield func(string) bool), workers int) (BatchCount, error) {
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+
+	type job struct {
+		index int
+		input string
+	}
+	type result struct {
+		index, count int
+		err          error
+	}
+	jobs := make(chan job, workers)
+	results := make(chan result, workers)
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := range jobs {
+				n, err := tok.CountCtx(context.Background(), j.input)
+				results <- result{j.index, n, err}
+			}
+		}()
+	}
+
+	// Collect the results as they arrive, in whatever order they finish
+	var ret BatchCount
+	var errs []error
+	failed := false
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for r := range results {
+			for len(ret.Counts) <= r.index {
+				ret.Counts = append(ret.Counts, 0)
+				errs = append(errs, nil)
+			}
+			if r.err != nil {
+				errs[r.index] = r.err
+				failed = true
+				continue
+			}
+			ret.Counts[r.index] = r.count
+			ret.Total += int64(r.count)
+		}
+	}()
+
+	n := 0
+	var bytes int64
+	inputs(func(input string) bool {
+		jobs <- job{n, input}
+		bytes += int64(len(input))
+		n++
+		return true
+	})
+	close(jobs)
+	wg.Wait()
+	close(results)
+	<-done
+	ret.Bytes = bytes
+
+	if failed {
+		return ret, &BatchError{Errs: errs}
+	}
+	return ret, nil
+}
@@ -0,0 +1,94 @@
+// Copyright 2023 Peter Hebert. Licensed under the MIT license.
+
+package gotoken
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestCountBatch(t *testing.T) {
+	tok, err := GetTokenizer("runes")
+	if err != nil {
+		t.Fatalf("GetTokenizer('runes'): %v", err)
+	}
+
+	inputs := []string{"Hello world", "", "日本語", "a b c", "x"}
+	for _, workers := range []int{0, 1, 2, 100} {
+		got, err := CountBatch(tok, inputs, workers)
+		if err != nil {
+			t.Fatalf("CountBatch(workers=%d): %v", workers, err)
+		}
+		want := BatchCount{Counts: []int{11, 0, 3, 5, 1}, Total: 20, Bytes: 26}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("CountBatch(workers=%d) = %+v, want %+v", workers, got, want)
+		}
+	}
+
+	// Failed inputs are reported per input, and don't count towards Total
+	got, err := CountBatch(tok, []string{"ab", "\xff", "cde"}, 2)
+	var batchErr *BatchError
+	if !errors.As(err, &batchErr) || batchErr.Errs[0] != nil || batchErr.Errs[1] == nil || batchErr.Errs[2] != nil {
+		t.Fatalf("CountBatch(): expected BatchError for input 1, got %v", err)
+	}
+	if want := (BatchCount{Counts: []int{2, 0, 3}, Total: 5, Bytes: 6}); !reflect.DeepEqual(got, want) {
+		t.Errorf("CountBatch() = %+v, want %+v", got, want)
+	}
+
+	got, err = CountBatch(tok, nil, 0)
+	if err != nil || got.Counts != nil || got.Total != 0 {
+		t.Errorf("CountBatch(nil) = %+v, %v", got, err)
+	}
+}
+
+func TestBatchError(t *testing.T) {
+	utf8Err := &InvalidUTF8Error{Offset: 1, Index: 0}
+	err := &BatchError{Errs: []error{nil, fmt.Errorf("input: %w", ErrSpecialToken), utf8Err}}
+
+	// Is and As are called directly too, since errors.Is and errors.As also
+	// use Unwrap on Go 1.20 and later
+	if !err.Is(ErrSpecialToken) || !errors.Is(err, ErrSpecialToken) {
+		t.Errorf("BatchError.Is(ErrSpecialToken) = false, want true")
+	}
+	if err.Is(ErrInvalidToken) || errors.Is(err, ErrInvalidToken) {
+		t.Errorf("BatchError.Is(ErrInvalidToken) = true, want false")
+	}
+	var target *InvalidUTF8Error
+	if !err.As(&target) || target != utf8Err {
+		t.Errorf("BatchError.As(*InvalidUTF8Error) = %v, want %v", target, utf8Err)
+	}
+	if empty := (&BatchError{Errs: []error{nil}}); empty.Is(ErrSpecialToken) || empty.As(&target) {
+		t.Errorf("BatchError with no failed inputs matched")
+	}
+}
+
+func TestCountBatchIter(t *testing.T) {
+	tok, err := GetTokenizer("runes")
+	if err != nil {
+		t.Fatalf("GetTokenizer('runes'): %v", err)
+	}
+
+	// Inputs are generated one at a time, and the counts are in order
+	got, err := CountBatchIter(tok, func(yield func(string) bool) {
+		input := ""
+		for i := 0; i < 100; i++ {
+			input += "a"
+			if !yield(input) {
+				return
+			}
+		}
+	}, 4)
+	if err != nil {
+		t.Fatalf("CountBatchIter(): %v", err)
+	}
+	if len(got.Counts) != 100 || got.Total != 5050 || got.Bytes != 5050 {
+		t.Fatalf("CountBatchIter() = %d counts, total %d, bytes %d", len(got.Counts), got.Total, got.Bytes)
+	}
+	for i, n := range got.Counts {
+		if n != i+1 {
+			t.Fatalf("CountBatchIter().Counts[%d] = %d, want %d", i, n, i+1)
+		}
+	}
+}
@@ -0,0 +1,63 @@
+// Copyright 2023 Peter Hebert. Licensed under the MIT license.
+
+package gotoken
+
+// DecodeStrategy selects how [DecodeLossy] handles invalid tokens.
+type DecodeStrategy int
+
+const (
+	// SkipInvalid leaves invalid tokens out of the decoded text.
+	SkipInvalid DecodeStrategy = iota
+	// ReplaceInvalid decodes each invalid token as U+FFFD, the Unicode
+	// replacement character.
+	ReplaceInvalid
+	// StopAtInvalid decodes the tokens before the first invalid token, and
+	// ignores the rest.
+	StopAtInvalid
+)
+
+// DecodeLossy is like [Tokenizer.Decode], but instead of failing if any of
+// tokens are invalid, it handles them according to strategy. This is for
+// tokens from logs or users, which can contain a few bad IDs among many good
+// ones. It also returns the number of invalid tokens found; with StopAtInvalid,
+// this is 0 or 1.
+//
+// The remaining tokens are decoded with tok.Decode, so an error is still
+// returned if, for example, the output is larger than the limit set with
+// [WithMaxDecodeBytes]. In that case, the token index in the error counts only
+// the tokens that were decoded.
+func DecodeLossy(tok Tokenizer, tokens []int, strategy DecodeStrategy) (string, int, error) {
+	invalid := 0
+	var replacement []int
+	var kept []int
+	for i, token := range tokens {
+		if tok.IsValidToken(token) {
+			if kept != nil {
+				kept = append(kept, token)
+			}
+			continue
+		}
+		invalid++
+		if kept == nil {
+			// Copy only once an invalid token is found
+			kept = append(make([]int, 0, len(tokens)), tokens[:i]...)
+		}
+		if strategy == StopAtInvalid {
+			break
+		}
+		if strategy == ReplaceInvalid {
+			if replacement == nil {
+				var err error
+				if replacement, err = tok.Encode("\uFFFD"); err != nil {
+					return "", invalid, err
+				}
+			}
+			kept = append(kept, replacement...)
+		}
+	}
+	if kept == nil {
+		kept = tokens
+	}
+	ret, err := tok.Decode(kept)
+	return ret, invalid, err
+}
@@ -0,0 +1,45 @@
+// Copyright 2023 Peter Hebert. Licensed under the MIT license.
+
+package gotoken
+
+import "testing"
+
+func TestDecodeLossy(t *testing.T) {
+	tok, err := GetTokenizer("runes")
+	if err != nil {
+		t.Fatalf("GetTokenizer('runes'): %v", err)
+	}
+
+	// The runes tokenizer decodes each token as the rune with that value
+	tokens := []int{'a', -1, 'b', 0xd800, 'c'}
+	tests := []struct {
+		strategy DecodeStrategy
+		want     string
+	}{
+		{SkipInvalid, "abc"},
+		{ReplaceInvalid, "a\uFFFDb\uFFFDc"},
+		{StopAtInvalid, "a"},
+	}
+	for _, tt := range tests {
+		got, invalid, err := DecodeLossy(tok, tokens, tt.strategy)
+		wantInvalid := 2
+		if tt.strategy == StopAtInvalid {
+			wantInvalid = 1
+		}
+		if got != tt.want || invalid != wantInvalid || err != nil {
+			t.Errorf("DecodeLossy(%v, %d) = %q, %d, %v; want %q, %d, nil", tokens, tt.strategy, got, invalid, err, tt.want, wantInvalid)
+		}
+	}
+
+	for _, tokens := range [][]int{nil, {'o', 'k'}, {-1}} {
+		want, _ := tok.Decode(tokens)
+		if len(tokens) == 1 {
+			want = ""
+		}
+		for _, strategy := range []DecodeStrategy{SkipInvalid, StopAtInvalid} {
+			if got, _, err := DecodeLossy(tok, tokens, strategy); got != want || err != nil {
+				t.Errorf("DecodeLossy(%v, %d) = %q, %v; want %q", tokens, strategy, got, err, want)
+			}
+		}
+	}
+}
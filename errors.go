@@ -0,0 +1,157 @@
+// Copyright 2023 Peter Hebert. Licensed under the MIT license.
+
+package gotoken
+
+import (
+	"errors"
+	"fmt"
+)
+
+// These errors can be returned by functions in this library. Errors will be
+// wrapped with fmt.Errorf; use [errors.Is] or [errors.As] to check for the
+// underlying error type.
+var (
+	ErrUnknownEncoding = errors.New("unknown tokenizer encoding")
+	ErrInvalidToken    = errors.New("invalid token")
+	ErrSpecialToken    = errors.New("unexpected special token found")
+	ErrCountOnly       = errors.New("token strings not included in count-only build")
+	ErrRegistered      = errors.New("tokenizer encoding already registered")
+)
+
+// PartialEncodeError is returned by Encode when a Tokenizer created with
+// [WithPartialResults] fails partway through its input. The tokens produced
+// before the failure are returned alongside the error.
+//
+// Offset is the byte offset in the input where encoding stopped; the returned
+// tokens decode to exactly input[:Offset]. Err is the underlying error, which
+// can be checked with [errors.Is], e.g. for [ErrSpecialToken].
+type PartialEncodeError struct {
+	Offset int
+	Err    error
+}
+
+// Error implements the error interface.
+func (e *PartialEncodeError) Error() string {
+	return fmt.Sprintf("encoding stopped at byte %d: %v", e.Offset, e.Err)
+}
+
+// Unwrap returns the underlying error.
+func (e *PartialEncodeError) Unwrap() error {
+	return e.Err
+}
+
+// TooManyTokensError is returned by Encode when a Tokenizer created with
+// [WithMaxTokens] would produce more than the maximum number of tokens. Count
+// is the number of tokens produced when encoding stopped, which is greater
+// than Max, and Offset is the byte offset of the input where the part of the
+// input that exceeded the limit begins.
+type TooManyTokensError struct {
+	Max    int
+	Count  int
+	Offset int
+}
+
+// Error implements the error interface.
+func (e *TooManyTokensError) Error() string {
+	return fmt.Sprintf("too many tokens: %d exceeds limit of %d at byte %d", e.Count, e.Max, e.Offset)
+}
+
+// InputTooLargeError is returned by Encode, Allowed, and other methods of a
+// Tokenizer created with [WithMaxInputBytes] when the input is longer than Max
+// bytes. Size is the length of the rejected input.
+type InputTooLargeError struct {
+	Max  int
+	Size int
+}
+
+// Error implements the error interface.
+func (e *InputTooLargeError) Error() string {
+	return fmt.Sprintf("input too large: %d bytes exceeds limit of %d", e.Size, e.Max)
+}
+
+// DecodeTooLargeError is returned by Decode and related methods of a Tokenizer
+// created with [WithMaxDecodeBytes] when the decoded text would be longer than
+// Max bytes. Index is the position in the input of the token that would have
+// exceeded the limit.
+type DecodeTooLargeError struct {
+	Max   int
+	Index int
+}
+
+// Error implements the error interface.
+func (e *DecodeTooLargeError) Error() string {
+	return fmt.Sprintf("decoded text exceeds limit of %d bytes at token index %d", e.Max, e.Index)
+}
+
+// InvalidUTF8Error is returned by Decode, Decode32, and DecodeTo of a
+// Tokenizer created with [WithStrictUTF8] when the decoded text is not valid
+// UTF-8. Offset is the byte offset in the decoded text of the first invalid
+// byte, and Index is the position in the input of the token that contains it.
+type InvalidUTF8Error struct {
+	Offset int
+	Index  int
+}
+
+// Error implements the error interface.
+func (e *InvalidUTF8Error) Error() string {
+	return fmt.Sprintf("decoded text is not valid UTF-8 at byte %d, in token index %d", e.Offset, e.Index)
+}
+
+// BatchError is returned by EncodeBatch and [CountBatch] when one or more of
+// their inputs fail to encode. Errs has one entry per input, which is nil for
+// inputs that were encoded successfully.
+type BatchError struct {
+	Errs []error
+}
+
+// Error implements the error interface. It describes the first failed input.
+func (e *BatchError) Error() string {
+	failed, first := 0, -1
+	for i, err := range e.Errs {
+		if err != nil {
+			failed++
+			if first < 0 {
+				first = i
+			}
+		}
+	}
+	if first < 0 {
+		return "batch encoding failed"
+	}
+	return fmt.Sprintf("%d of %d inputs failed to encode; input %d: %v", failed, len(e.Errs), first, e.Errs[first])
+}
+
+// Is reports whether the error of any failed input matches target, with
+// [errors.Is]. Go 1.20 and later also find these errors through Unwrap; Is
+// makes errors.Is work with a BatchError on earlier versions too.
+func (e *BatchError) Is(target error) bool {
+	for _, err := range e.Errs {
+		if err != nil && errors.Is(err, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// As finds the first failed input whose error matches target, with
+// [errors.As], and if one is found, sets target to that error value and
+// returns true. Like Is, it is for Go versions before 1.20.
+func (e *BatchError) As(target any) bool {
+	for _, err := range e.Errs {
+		if err != nil && errors.As(err, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// Unwrap returns the errors of the failed inputs.
+func (e *BatchError) Unwrap() []error {
+	var errs []error
+	for _, err := range e.Errs {
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errs
+}
@@ -36,11 +36,9 @@ func main() {
 	fmt.Printf("tokenized:     %#v\n", encoded)
 
 	// Make strings out of every token
-	tokenStr := make([]string, len(encoded))
-	for i, t := range encoded {
-		if tokenStr[i], err = tok.Decode([]int{t}); err != nil {
-			log.Fatal(err)
-		}
+	tokenStr, err := tok.DecodeEach(encoded)
+	if err != nil {
+		log.Fatal(err)
 	}
 
 	fmt.Printf("token values:  %#v\n", tokenStr)
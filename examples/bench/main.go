@@ -3,13 +3,15 @@
 package main
 
 import (
-	"bufio"
-	"bytes"
+	"encoding/csv"
+	"encoding/json"
 	"flag"
 	"fmt"
 	"os"
 	"runtime"
 	"runtime/pprof"
+	"strconv"
+	"sync"
 	"time"
 
 	"github.com/peterheb/gotoken"
@@ -20,14 +22,50 @@ import (
 
 // See: testdata/get_wiki_1gb.py to download the test data file.
 
+// result holds the measurements of one benchmark run. Latencies are the time to
+// encode one line.
+type result struct {
+	Encoding     string  `json:"encoding"`
+	Threads      int     `json:"threads"`
+	Mode         string  `json:"mode"` // "memory" or "stream"
+	Lines        int     `json:"lines"`
+	Bytes        int64   `json:"bytes"`
+	Tokens       int64   `json:"tokens"`
+	Seconds      float64 `json:"seconds"`
+	MiBPerSec    float64 `json:"mib_per_sec"`
+	TokensPerSec float64 `json:"tokens_per_sec"`
+	P50Micros    float64 `json:"p50_us"`
+	P95Micros    float64 `json:"p95_us"`
+	P99Micros    float64 `json:"p99_us"`
+	Speedup      float64 `json:"speedup"`       // throughput relative to threads=1, or 0 if not run
+	AllocsPerOp  float64 `json:"allocs_per_op"` // heap allocations per line
+	BytesPerOp   float64 `json:"bytes_per_op"`  // bytes allocated per line
+	NumGC        uint32  `json:"num_gc"`
+	GCPauseMs    float64 `json:"gc_pause_ms"` // total stop-the-world pause time
+	GoVersion    string  `json:"go_version"`
+	NumCPU       int     `json:"num_cpu"`
+}
+
+// csvHeader names the columns written by result.csvRecord.
+var csvHeader = []string{"encoding", "threads", "mode", "lines", "bytes", "tokens", "seconds",
+	"mib_per_sec", "tokens_per_sec", "p50_us", "p95_us", "p99_us", "speedup",
+	"allocs_per_op", "bytes_per_op", "num_gc", "gc_pause_ms", "go_version", "num_cpu"}
+
 func main() {
 	// Parse flags
 	threads := flag.Int("threads", 0, "Number of threads to use (0 = demo)")
 	doProfile := flag.Bool("pprof", false, "Enable profiling")
 	encoding := flag.String("encoding", "all", "Tokenizer encoding to use, default \"all\" (r50k_base, p50k_base, cl100k_base, all)")
 	src := flag.String("src", "../../testdata/pae-enwiki-2023-04-1gb.txt", "Path to the test data file with one entry per line")
+	format := flag.String("format", "text", "Output format: text, json, or csv")
+	stream := flag.Bool("stream", false, "Read the test data while encoding it, instead of loading it into RAM first")
 	flag.Parse()
 
+	if *format != "text" && *format != "json" && *format != "csv" {
+		fmt.Fprintf(os.Stderr, "unknown -format %q\n", *format)
+		os.Exit(2)
+	}
+
 	// Validate the specified encoding
 	encodings := []string{"r50k_base", "p50k_base", "cl100k_base"}
 	if *encoding != "all" {
@@ -38,7 +76,7 @@ func main() {
 
 	// Validate the provided thread count
 	if *threads > runtime.NumCPU()*4 {
-		fmt.Printf("ignoring '-threads %d' (max=%d)\n", *threads, runtime.NumCPU()*4)
+		fmt.Fprintf(os.Stderr, "ignoring '-threads %d' (max=%d)\n", *threads, runtime.NumCPU()*4)
 		*threads = 0
 	}
 
@@ -46,78 +84,178 @@ func main() {
 	if *doProfile {
 		f, err := os.Create("bench.pprof")
 		onErrFatalf(err, "create bench.pprof")
-		fmt.Println("outputting profiling data to bench.pprof")
+		fmt.Fprintln(os.Stderr, "outputting profiling data to bench.pprof")
 		pprof.StartCPUProfile(f)
 		defer pprof.StopCPUProfile()
 	}
 
+	// Pre-load the file into RAM, unless streaming. This is a synthetic
+	// benchmark focusing on the tokenizer, so by default we want to isolate the
+	// impact I/O has.
+	var data []byte
+	if !*stream {
+		var err error
+		data, err = os.ReadFile(*src)
+		onErrFatalf(err, "read %s", *src)
+	}
+
+	var cw *csv.Writer
+	if *format == "csv" {
+		cw = csv.NewWriter(os.Stdout)
+		cw.Write(csvHeader)
+	}
+
 	// Run the benchmark for the specified encoding(s)
+	var results []*result
 	for _, enc := range encodings {
-		if *threads != 0 {
-			// Run the benchmark with the specified number of threads
-			runBenchmark(*src, enc, *threads)
-		} else {
+		threadCounts := []int{*threads}
+		if *threads == 0 {
 			// Run the benchmark with 1, 2, 4, 8, etc. up to NumCPU
-			th := 1
-			for th <= runtime.NumCPU() {
-				runBenchmark(*src, enc, th)
-				th *= 2
+			threadCounts = nil
+			for th := 1; th <= runtime.NumCPU(); th *= 2 {
+				threadCounts = append(threadCounts, th)
+			}
+		}
+		var single *result
+		for _, th := range threadCounts {
+			var r *result
+			if *stream {
+				f, err := os.Open(*src)
+				onErrFatalf(err, "open %s", *src)
+				r = runBenchmark(newStreamSource(f), enc, th)
+				f.Close()
+			} else {
+				r = runBenchmark(newMemorySource(data), enc, th)
+			}
+			if th == 1 {
+				single = r
+			}
+			if single != nil {
+				r.Speedup = r.MiBPerSec / single.MiBPerSec
+			}
+			results = append(results, r)
+
+			switch *format {
+			case "text":
+				r.print()
+			case "csv":
+				cw.Write(r.csvRecord())
+				cw.Flush()
 			}
 		}
 	}
+	if *format == "json" {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		onErrFatalf(enc.Encode(results), "write results")
+	}
+	if cw != nil {
+		onErrFatalf(cw.Error(), "write results")
+	}
 }
 
-func runBenchmark(src string, encoding string, threads int) {
+// runBenchmark encodes every line from src with a pool of threads workers.
+// Each worker takes a batch of lines at a time, and keeps its own counts, so
+// that the workers only synchronize once per batch.
+func runBenchmark(src batchSource, encoding string, threads int) *result {
 	// Initialize encoder
 	tok, err := gotoken.GetTokenizer(encoding)
 	onErrFatalf(err, "create tokenizer")
 
-	// Pre-load the file into RAM. This is a synthetic benchmark focusing on the
-	// tokenizer, so we want to isolate the impact I/O has.
-	data, err := os.ReadFile(src)
-	onErrFatalf(err, "read %s", src)
+	// Start from a clean heap, so that the memory statistics cover only this
+	// run. Allocations include those of the test harness, like converting each
+	// line to a string, which is the same for every encoding.
+	runtime.GC()
+	var before, after runtime.MemStats
+	runtime.ReadMemStats(&before)
 
 	startTime := time.Now()
-	scanner := bufio.NewScanner(bytes.NewBuffer(data))
-	i := 0
-	if threads > 1 {
-		// Parallel for pattern using goroutines and a semaphore channel
-		sem := make(chan struct{}, threads)
-		for scanner.Scan() {
-			line := scanner.Bytes()
-			i++
-			sem <- struct{}{}
-			go func(line string, i int) {
-				defer func() { <-sem }()
-				_, err := tok.Encode(line)
-				onErrFatalf(err, "encode[line=%d] %s", i, line)
-			}(string(line), i)
-		}
-		// Wait for final goroutines to finish
-		for j := 0; j < cap(sem); j++ {
-			sem <- struct{}{}
-		}
-	} else {
-		// Single-threaded, just use a simpler loop!
-		for scanner.Scan() {
-			line := scanner.Text()
-			i++
-			_, err := tok.Encode(line)
-			onErrFatalf(err, "encode[line=%d] %s", i, line)
+	work := make(chan *batch, threads)
+	workers := make([]worker, threads)
+	var wg sync.WaitGroup
+	for w := range workers {
+		wg.Add(1)
+		go func(w *worker) {
+			defer wg.Done()
+			for b := range work {
+				w.encode(tok, b)
+				src.release(b)
+			}
+		}(&workers[w])
+	}
+	for {
+		b, err := src.next()
+		onErrFatalf(err, "read test data")
+		if b == nil {
+			break
 		}
+		work <- b
 	}
-	onErrFatalf(scanner.Err(), "bufio.Scanner: %s", src)
+	close(work)
+	wg.Wait()
 	dur := time.Since(startTime)
+	runtime.ReadMemStats(&after)
+
+	// Merge the counts of the workers
+	var total worker
+	for i := range workers {
+		total.merge(&workers[i])
+	}
+	ops := float64(total.lines)
+	if ops == 0 {
+		ops = 1
+	}
+	return &result{
+		Encoding:     encoding,
+		Threads:      threads,
+		Mode:         src.mode(),
+		Lines:        total.lines,
+		Bytes:        total.bytes,
+		Tokens:       total.tokens,
+		Seconds:      dur.Seconds(),
+		MiBPerSec:    float64(total.bytes) / dur.Seconds() / 1024 / 1024,
+		TokensPerSec: float64(total.tokens) / dur.Seconds(),
+		P50Micros:    total.latency.percentile(50),
+		P95Micros:    total.latency.percentile(95),
+		P99Micros:    total.latency.percentile(99),
+		AllocsPerOp:  float64(after.Mallocs-before.Mallocs) / ops,
+		BytesPerOp:   float64(after.TotalAlloc-before.TotalAlloc) / ops,
+		NumGC:        after.NumGC - before.NumGC,
+		GCPauseMs:    float64(after.PauseTotalNs-before.PauseTotalNs) / 1e6,
+		GoVersion:    runtime.Version(),
+		NumCPU:       runtime.NumCPU(),
+	}
+}
+
+// print writes r in the human-readable format.
+func (r *result) print() {
+	dur := time.Duration(r.Seconds * float64(time.Second))
 	durStr := fmt.Sprintf("%d:%02d.%02d", int(dur.Minutes()), int(dur.Seconds())%60, int(dur.Milliseconds()%1000)/10)
-	fmt.Printf("%-13q (threads=%2d) elapsed time: %s sec, %.2f MiB/sec\n", encoding,
-		threads, durStr, float64(len(data))/dur.Seconds()/1024/1024)
+	mode := ""
+	if r.Mode == "stream" {
+		mode = ", stream"
+	}
+	fmt.Printf("%-13q (threads=%2d%s) elapsed time: %s sec, %.2f MiB/sec, latency p50/p95/p99: %.1f/%.1f/%.1f µs\n",
+		r.Encoding, r.Threads, mode, durStr, r.MiBPerSec, r.P50Micros, r.P95Micros, r.P99Micros)
+	fmt.Printf("%-13s %.1f allocs/op, %.0f B/op, %d GCs, %.2f ms GC pause\n",
+		"", r.AllocsPerOp, r.BytesPerOp, r.NumGC, r.GCPauseMs)
+}
+
+// csvRecord returns r as a row in the columns of csvHeader.
+func (r *result) csvRecord() []string {
+	f := func(v float64) string { return strconv.FormatFloat(v, 'f', -1, 64) }
+	return []string{r.Encoding, strconv.Itoa(r.Threads), r.Mode, strconv.Itoa(r.Lines),
+		strconv.FormatInt(r.Bytes, 10), strconv.FormatInt(r.Tokens, 10), f(r.Seconds),
+		f(r.MiBPerSec), f(r.TokensPerSec), f(r.P50Micros), f(r.P95Micros), f(r.P99Micros),
+		f(r.Speedup), f(r.AllocsPerOp), f(r.BytesPerOp), strconv.FormatUint(uint64(r.NumGC), 10),
+		f(r.GCPauseMs), r.GoVersion, strconv.Itoa(r.NumCPU)}
 }
 
 // onErrFatalf prints a message and ends the program if err!=nil.
 func onErrFatalf(err error, format string, args ...any) {
 	if err != nil {
-		fmt.Printf(format, args...)
-		fmt.Printf(": %v\n", err)
+		fmt.Fprintf(os.Stderr, format, args...)
+		fmt.Fprintf(os.Stderr, ": %v\n", err)
 		os.Exit(1)
 	}
 }
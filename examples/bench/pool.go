@@ -0,0 +1,187 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"math/bits"
+	"time"
+
+	"github.com/peterheb/gotoken"
+)
+
+// batchBytes is the approximate size of the lines in a batch.
+const batchBytes = 64 * 1024
+
+// batch is a group of lines from the test data, encoded by one worker.
+type batch struct {
+	data   []byte // the lines
+	starts []int  // the start of each line in data
+	ends   []int  // the end of each line in data, before its line break
+}
+
+// batchSource hands out the test data in batches. Batches from next are passed
+// back to release once they are encoded, so their memory can be reused.
+type batchSource interface {
+	next() (*batch, error) // returns nil at the end of the data
+	release(b *batch)
+	mode() string
+}
+
+// memorySource splits test data that is already in RAM into batches, which
+// point into the data without copying it.
+type memorySource struct {
+	data []byte
+}
+
+func newMemorySource(data []byte) *memorySource {
+	return &memorySource{data: data}
+}
+
+func (ms *memorySource) next() (*batch, error) {
+	if len(ms.data) == 0 {
+		return nil, nil
+	}
+	b := &batch{data: ms.data}
+	n := 0
+	for n < len(ms.data) && n < batchBytes {
+		end := bytes.IndexByte(ms.data[n:], '\n')
+		if end < 0 {
+			end = len(ms.data) - n
+		}
+		b.starts = append(b.starts, n)
+		if end > 0 && ms.data[n+end-1] == '\r' {
+			// Drop a CR before the LF, like bufio.ScanLines
+			b.ends = append(b.ends, n+end-1)
+		} else {
+			b.ends = append(b.ends, n+end)
+		}
+		n += end + 1
+	}
+	if n > len(ms.data) {
+		n = len(ms.data)
+	}
+	ms.data = ms.data[n:]
+	return b, nil
+}
+
+func (ms *memorySource) release(b *batch) {}
+
+func (ms *memorySource) mode() string { return "memory" }
+
+// streamSource reads test data into a fixed number of reusable batches, so the
+// memory used doesn't depend on the size of the data. When all batches are in
+// use, reading waits for the workers to release one.
+type streamSource struct {
+	scanner *bufio.Scanner
+	free    chan *batch
+}
+
+// streamBatches is the number of batches a streamSource allocates.
+const streamBatches = 64
+
+func newStreamSource(r io.Reader) *streamSource {
+	ss := &streamSource{
+		scanner: bufio.NewScanner(bufio.NewReaderSize(r, 1<<20)),
+		free:    make(chan *batch, streamBatches),
+	}
+	ss.scanner.Buffer(nil, 16<<20)
+	for i := 0; i < streamBatches; i++ {
+		ss.free <- &batch{data: make([]byte, 0, batchBytes)}
+	}
+	return ss
+}
+
+func (ss *streamSource) next() (*batch, error) {
+	var b *batch
+	for (b == nil || len(b.data) < batchBytes) && ss.scanner.Scan() {
+		if b == nil {
+			b = <-ss.free
+		}
+		b.starts = append(b.starts, len(b.data))
+		b.data = append(b.data, ss.scanner.Bytes()...)
+		b.ends = append(b.ends, len(b.data))
+	}
+	return b, ss.scanner.Err()
+}
+
+func (ss *streamSource) release(b *batch) {
+	b.data, b.starts, b.ends = b.data[:0], b.starts[:0], b.ends[:0]
+	ss.free <- b
+}
+
+func (ss *streamSource) mode() string { return "stream" }
+
+// worker holds the counts of one worker goroutine.
+type worker struct {
+	lines   int
+	bytes   int64
+	tokens  int64
+	latency latencyHistogram
+}
+
+// encode encodes each line in b with tok.
+func (w *worker) encode(tok gotoken.Tokenizer, b *batch) {
+	for i, start := range b.starts {
+		line := string(b.data[start:b.ends[i]])
+		t := time.Now()
+		tokens, err := tok.Encode(line)
+		w.latency.add(time.Since(t))
+		onErrFatalf(err, "encode %q", line)
+		w.tokens += int64(len(tokens))
+		w.lines++
+		w.bytes += int64(len(line)) + 1 // count the line break, like the data file
+	}
+}
+
+// merge adds the counts of other to w.
+func (w *worker) merge(other *worker) {
+	w.lines += other.lines
+	w.bytes += other.bytes
+	w.tokens += other.tokens
+	for i, n := range other.latency.counts {
+		w.latency.counts[i] += n
+	}
+	w.latency.total += other.latency.total
+}
+
+// latencyHistogram counts durations in buckets about 3% wide, so percentiles
+// can be computed without storing every duration. Durations below 64ns have a
+// bucket each; above that, each power of two is split into 32 buckets.
+type latencyHistogram struct {
+	counts [2048]int64
+	total  int64
+}
+
+func (h *latencyHistogram) add(d time.Duration) {
+	ns := uint64(d)
+	i := int(ns)
+	if ns >= 64 {
+		shift := bits.Len64(ns) - 6
+		i = shift*32 + int(ns>>shift)
+	}
+	h.counts[i]++
+	h.total++
+}
+
+// percentile returns the p-th percentile, in microseconds, using the
+// nearest-rank method. The middle of the bucket it falls in is returned.
+func (h *latencyHistogram) percentile(p int) float64 {
+	rank := (h.total*int64(p) + 99) / 100
+	if rank < 1 {
+		rank = 1
+	}
+	var seen int64
+	for i, n := range h.counts {
+		if seen += n; seen < rank {
+			continue
+		}
+		if i < 64 {
+			return float64(i) / 1000
+		}
+		shift := i/32 - 1
+		low := uint64(i%32+32) << shift
+		return (float64(low) + float64(uint64(1)<<shift)/2) / 1000
+	}
+	return 0
+}
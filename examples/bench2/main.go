@@ -1,18 +1,37 @@
+// The bench2 example compares the speed of looking up every token in the
+// serialized trie and in the minimal perfect hash (internal.MPH).
 package main
 
 import (
 	"fmt"
 	"time"
+
+	"github.com/peterheb/gotoken/internal"
 )
 
 func main() {
-	mph := Build(tokenList)
 	start := time.Now()
-	for i := 0; i < 100000; i++ {
-		for _, token := range tokenList {
-			mph.Lookup(token)
+	mph := internal.NewMPH(internal.BuildMPHSeeds(tokenList), internal.NewTokenListFromStrings(tokenList))
+	fmt.Printf("build mph: %v\n", time.Since(start))
+
+	keys := make([][]byte, len(tokenList))
+	for i, token := range tokenList {
+		keys[i] = []byte(token)
+	}
+
+	start = time.Now()
+	for i := 0; i < 1000; i++ {
+		for _, key := range keys {
+			internal.TrieLookup(tokenTrie, key)
+		}
+	}
+	fmt.Printf("trie: %v\n", time.Since(start))
+
+	start = time.Now()
+	for i := 0; i < 1000; i++ {
+		for _, key := range keys {
+			mph.Lookup(key)
 		}
 	}
-	dur := time.Since(start)
-	fmt.Println(dur)
+	fmt.Printf("mph:  %v\n", time.Since(start))
 }
@@ -0,0 +1,131 @@
+// The budget example fits a chat prompt into a model's context window: a
+// system prompt, as much of the conversation history as fits, and the user's
+// new message, while reserving room for the response.
+package main
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/peterheb/gotoken"
+	_ "github.com/peterheb/gotoken/cl100kbase"
+)
+
+const (
+	// contextWindow is the number of tokens the model accepts, for the prompt
+	// and the response together. It is small here to show trimming.
+	contextWindow = 280
+
+	// maxResponse is the max_tokens of the request: the room reserved for the
+	// response.
+	maxResponse = 100
+
+	// maxUserMessage is the most of the budget the new message can use, so a
+	// huge paste doesn't leave no room for the conversation.
+	maxUserMessage = 80
+)
+
+func main() {
+	tok, err := gotoken.GetTokenizer("cl100k_base")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	system := gotoken.ChatMessage{Role: "system", Content: "You are a helpful assistant. Answer briefly."}
+	history := []gotoken.ChatMessage{
+		{Role: "user", Content: "What is a tokenizer?"},
+		{Role: "assistant", Content: "A tokenizer splits text into tokens, the units a language model reads and writes. " +
+			"Common words are usually one token, and rare words are split into several."},
+		{Role: "user", Content: "Why do token counts matter?"},
+		{Role: "assistant", Content: "Models have a context window measured in tokens, and APIs charge per token, " +
+			"so counting tokens tells you whether a prompt fits and what it costs."},
+	}
+	user := gotoken.ChatMessage{Role: "user", Content: "Please summarize this log:\n" +
+		strings.Repeat("2023-06-01 12:00:00 INFO request served in 12ms\n", 20)}
+
+	// The new message is trimmed first, keeping its beginning, which holds the
+	// question
+	fmt.Printf("user message: %d tokens", tok.Count(user.Content))
+	user.Content, err = gotoken.Truncate(tok, user.Content, maxUserMessage)
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf(", trimmed to %d\n", tok.Count(user.Content))
+
+	// The prompt must leave maxResponse tokens for the response. The system
+	// prompt, the new message, and the start of the reply are always sent.
+	budget := contextWindow - maxResponse
+	used := cost(tok, system) + cost(tok, user) + replyCost(tok)
+	fmt.Printf("budget: %d tokens, %d of them for the system prompt, message, and reply\n", budget, used)
+
+	// Keep the most recent history that fits. The oldest message kept may be
+	// trimmed, keeping its end, which is closest to the rest of the conversation.
+	kept := 0
+	for i := len(history) - 1; i >= 0; i-- {
+		c := cost(tok, history[i])
+		if used+c <= budget {
+			used += c
+			kept++
+			continue
+		}
+		overhead := c - tok.Count(history[i].Content)
+		if room := budget - used - overhead; room > 10 {
+			trimmed, err := gotoken.TruncateStart(tok, history[i].Content, room)
+			if err != nil {
+				log.Fatal(err)
+			}
+			history[i].Content = trimmed
+			used += cost(tok, history[i])
+			kept++
+		}
+		break
+	}
+	history = history[len(history)-kept:]
+	fmt.Printf("history: kept %d messages\n\n", kept)
+
+	// Build the prompt, and check the total with the exact tokens
+	chat, err := gotoken.NewChatML(tok)
+	if err != nil {
+		log.Fatal(err)
+	}
+	for _, msg := range append(append([]gotoken.ChatMessage{system}, history...), user) {
+		n, err := chat.Add(msg)
+		if err != nil {
+			log.Fatal(err)
+		}
+		fmt.Printf("%4d tokens  %-9s %.50q\n", n, msg.Role, msg.Content)
+	}
+	if _, err := chat.Reply("assistant"); err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf("\nprompt: %d tokens, leaving %d of %d for the response\n",
+		chat.Count(), contextWindow-chat.Count(), contextWindow)
+}
+
+// cost returns the number of tokens msg takes in a ChatML prompt.
+func cost(tok gotoken.Tokenizer, msg gotoken.ChatMessage) int {
+	chat, err := gotoken.NewChatML(tok)
+	if err != nil {
+		log.Fatal(err)
+	}
+	n, err := chat.Add(msg)
+	if err != nil {
+		log.Fatal(err)
+	}
+	return n
+}
+
+// replyCost returns the number of tokens of the start of the assistant's reply,
+// which ends a ChatML prompt.
+func replyCost(tok gotoken.Tokenizer) int {
+	chat, err := gotoken.NewChatML(tok)
+	if err != nil {
+		log.Fatal(err)
+	}
+	n, err := chat.Reply("assistant")
+	if err != nil {
+		log.Fatal(err)
+	}
+	return n
+}
@@ -0,0 +1,226 @@
+// The explorer example is an interactive terminal viewer for the tokens of a
+// string. The arrow keys move across the tokens, and for the current token it
+// shows the ID, rank, and bytes, the pre-token it was split into by the
+// encoding's splitter, and how many tokens the text has up to that point. It
+// is handy for checking how the splitter treats whitespace, numbers,
+// contractions, and the like:
+//
+//	go run ./examples/explorer -encoding r50k_base "  it's 12345 tokens\n\n"
+//
+// Text is read from the file named by -f, or else from the arguments, where \n
+// and \t are unescaped. Keys are ← and → or h and l to move, Home and End or g
+// and G to jump to the ends, and q to quit. The terminal is put in raw mode
+// with stty; where that isn't available, keys must be followed by Enter.
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/peterheb/gotoken"
+	_ "github.com/peterheb/gotoken/cl100kbase"
+	_ "github.com/peterheb/gotoken/p50kbase"
+	_ "github.com/peterheb/gotoken/r50kbase"
+	"github.com/peterheb/gotoken/viz"
+)
+
+// sample is the text shown when none is given, with some cases that the
+// splitters of the encodings treat differently.
+const sample = "Hello, world! It's 2023, and we'll count 1234567 tokens.\n\n" +
+	"    indented\tcode(x) // 😄 こんにちは\r\n" +
+	"Don'T SHOUT!!!   trailing spaces   \n"
+
+func main() {
+	encoding := flag.String("encoding", "cl100k_base", "Encoding to use")
+	file := flag.String("f", "", "File to read the text from")
+	flag.Parse()
+
+	text := sample
+	if *file != "" {
+		b, err := os.ReadFile(*file)
+		if err != nil {
+			log.Fatal(err)
+		}
+		text = string(b)
+	} else if flag.NArg() > 0 {
+		text = strings.NewReplacer(`\n`, "\n", `\t`, "\t").Replace(strings.Join(flag.Args(), " "))
+	}
+
+	tok, err := gotoken.GetTokenizer(*encoding)
+	if err != nil {
+		log.Fatal(err)
+	}
+	tokens, offsets, err := tok.EncodeWithOffsets(text)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if len(tokens) == 0 {
+		log.Fatal("no tokens to explore")
+	}
+	pieces, err := pretokens(tok, text)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	restore, err := rawMode()
+	if err != nil {
+		fmt.Println("stty failed, so keys must be followed by Enter:", err)
+	} else {
+		defer restore()
+	}
+
+	segments := viz.Segments(text, tokens, offsets)
+	keys := bufio.NewReader(os.Stdin)
+	cur := 0
+	for {
+		draw(tok, segments, pieces, cur)
+		switch readKey(keys) {
+		case "left":
+			if cur > 0 {
+				cur--
+			}
+		case "right":
+			if cur < len(tokens)-1 {
+				cur++
+			}
+		case "home":
+			cur = 0
+		case "end":
+			cur = len(tokens) - 1
+		case "quit":
+			fmt.Print("\r\n")
+			return
+		}
+	}
+}
+
+// draw clears the terminal and shows the text with the tokens highlighted, and
+// the details of the token at index cur. Lines end in \r\n, since the terminal
+// doesn't add carriage returns in raw mode.
+func draw(tok gotoken.Tokenizer, segments []viz.Segment, pieces []string, cur int) {
+	var sb strings.Builder
+	sb.WriteString("\x1b[H\x1b[2J")
+	for _, seg := range segments {
+		color := fmt.Sprintf("\x1b[30;48;5;%dm", viz.Palette[seg.Index%len(viz.Palette)])
+		if seg.Index == cur {
+			color = "\x1b[1;97;48;5;25m"
+		}
+		// Show the whitespace that splitters care about
+		text := strings.NewReplacer("\r", "␍", "\t", "→", "\n", "↵\n").Replace(seg.Text)
+		for i, line := range strings.Split(text, "\n") {
+			if i > 0 {
+				sb.WriteString("\n")
+			}
+			if line != "" {
+				sb.WriteString(color + line + "\x1b[0m")
+			}
+		}
+	}
+	sb.WriteString("\n\n")
+
+	seg := segments[cur]
+	piece, err := tok.DecodeToken(seg.Token)
+	if err != nil {
+		piece = err.Error()
+	}
+	rank := fmt.Sprint(seg.Token)
+	if tok.IsSpecialToken(seg.Token) {
+		rank = "none (special token)"
+	}
+	fmt.Fprintf(&sb, "count    %d of %d tokens, up to this one\n", cur+1, len(segments))
+	fmt.Fprintf(&sb, "id       %d\n", seg.Token)
+	// Tokens are numbered in the order their merges are applied, so the rank
+	// of a token in BPE is its ID
+	fmt.Fprintf(&sb, "rank     %s\n", rank)
+	fmt.Fprintf(&sb, "bytes    %q (% x)\n", piece, piece)
+	if seg.Text == "" {
+		fmt.Fprintf(&sb, "offset   %d, within a character of the previous token\n", seg.Start)
+	} else {
+		fmt.Fprintf(&sb, "offset   %d to %d\n", seg.Start, seg.End)
+	}
+	if cur < len(pieces) {
+		fmt.Fprintf(&sb, "pretoken %q\n", pieces[cur])
+	}
+	sb.WriteString("\n←/→ move, g/G first/last, q quit\n")
+	fmt.Print(strings.ReplaceAll(sb.String(), "\n", "\r\n"))
+}
+
+// pretokens returns the pre-token of text that each of its tokens belongs to,
+// from the pieces reported by Explain.
+func pretokens(tok gotoken.Tokenizer, text string) ([]string, error) {
+	explained, err := tok.Explain(text)
+	if err != nil {
+		return nil, err
+	}
+	var ret []string
+	for _, p := range explained {
+		for range p.Tokens {
+			ret = append(ret, p.Text)
+		}
+	}
+	return ret, nil
+}
+
+// readKey reads a key press from r, and returns its action: "left", "right",
+// "home", "end", or "quit", or "" for other keys. Arrow keys and Home and End
+// arrive as escape sequences like "\x1b[D".
+func readKey(r *bufio.Reader) string {
+	b, err := r.ReadByte()
+	if err != nil {
+		return "quit"
+	}
+	switch b {
+	case 'h', 'p':
+		return "left"
+	case 'l', 'n', ' ':
+		return "right"
+	case 'g':
+		return "home"
+	case 'G':
+		return "end"
+	case 'q', 3, 4: // 3 and 4 are Ctrl-C and Ctrl-D in raw mode
+		return "quit"
+	case 0x1b:
+		if b, _ := r.ReadByte(); b != '[' && b != 'O' {
+			return ""
+		}
+		b, _ := r.ReadByte()
+		switch b {
+		case 'D':
+			return "left"
+		case 'C':
+			return "right"
+		case 'H', '1':
+			return "home"
+		case 'F', '4':
+			return "end"
+		}
+	}
+	return ""
+}
+
+// rawMode puts the terminal in raw mode without echo, so keys are read as
+// they are pressed, and returns a function that restores the previous mode.
+func rawMode() (func(), error) {
+	state, err := stty("-g")
+	if err != nil {
+		return nil, err
+	}
+	if _, err := stty("raw", "-echo"); err != nil {
+		return nil, err
+	}
+	return func() { _, _ = stty(strings.TrimSpace(state)) }, nil
+}
+
+// stty runs the stty command on the terminal and returns its output.
+func stty(args ...string) (string, error) {
+	cmd := exec.Command("stty", args...)
+	cmd.Stdin = os.Stdin
+	out, err := cmd.Output()
+	return string(out), err
+}
@@ -0,0 +1,28 @@
+// The httpserver example runs a tokenization service with every encoding
+// available. Try it with:
+//
+//	curl -d '{"encoding": "cl100k_base", "text": "hello world"}' localhost:8080/encode
+package main
+
+import (
+	"flag"
+	"log"
+	"net/http"
+
+	"github.com/peterheb/gotoken"
+	_ "github.com/peterheb/gotoken/cl100kbase"
+	"github.com/peterheb/gotoken/httpserver"
+	_ "github.com/peterheb/gotoken/p50kbase"
+	_ "github.com/peterheb/gotoken/r50kbase"
+)
+
+func main() {
+	addr := flag.String("addr", "localhost:8080", "address to listen on")
+	flag.Parse()
+
+	// Special tokens in the input are encoded as text, so that any text sent by
+	// a client can be tokenized.
+	srv := httpserver.New(gotoken.WithSpecialTokensAsText())
+	log.Printf("listening on %s, encodings: %v", *addr, gotoken.ListTokenizers())
+	log.Fatal(http.ListenAndServe(*addr, srv))
+}
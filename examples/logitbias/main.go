@@ -0,0 +1,97 @@
+// The logitbias example builds a logit_bias map that keeps a model from using
+// the words on a ban-list, for the logit_bias parameter of the OpenAI API.
+//
+// Logit bias applies to tokens, not words, so a word is banned by banning its
+// token. gotoken.LogitBias does this for each word with and without a leading
+// space, and reports the variants that take more than one token, since banning
+// those tokens would also ban the fragments in other words, like "del" in
+// "delete". Tokens that spell a banned word in other cases, like " Delve", are
+// found by searching the vocabulary.
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/peterheb/gotoken"
+	_ "github.com/peterheb/gotoken/cl100kbase"
+)
+
+// banned is the list of words the model should not use.
+var banned = []string{"delve", "tapestry", "synergy"}
+
+func main() {
+	tok, err := gotoken.GetTokenizer("cl100k_base")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	// LogitBias encodes each word with and without a leading space, and
+	// skips the variants that take more than one token
+	bias, skipped, err := gotoken.LogitBias(tok, banned, -100)
+	if err != nil {
+		log.Fatal(err)
+	}
+	for _, s := range skipped {
+		fmt.Println("skipped:", s)
+	}
+
+	// Search the vocabulary for single tokens that spell a banned word in any
+	// case, with or without a leading space, like " Delve" at the start of a
+	// sentence. VocabIter visits every token with its bytes.
+	words := make(map[string]bool)
+	for _, word := range banned {
+		words[strings.ToLower(word)] = true
+	}
+	tok.VocabIter()(func(token int, b []byte) bool {
+		if words[strings.ToLower(string(bytes.TrimLeft(b, " ")))] {
+			bias[token] = -100
+		}
+		return true
+	})
+
+	// Other forms of a banned word, like "synergies", are not covered by the
+	// ban. TokensWithPrefix finds tokens that start like the word, for review.
+	for _, word := range banned {
+		var longer []string
+		for _, token := range tok.TokensWithPrefix(" " + word[:len(word)-1]) {
+			piece, _ := tok.DecodeToken(token)
+			if piece != " "+word && isWord(piece) {
+				longer = append(longer, fmt.Sprintf("%q", piece))
+			}
+		}
+		if len(longer) > 0 {
+			fmt.Printf("not banned, but like %q: %s\n", word, strings.Join(longer, ", "))
+		}
+	}
+
+	// Print the map, and the JSON object the API expects, with token IDs as keys
+	fmt.Println()
+	keys := make([]int, 0, len(bias))
+	for token := range bias {
+		keys = append(keys, token)
+	}
+	sort.Ints(keys)
+	obj := make(map[string]float64, len(bias))
+	for _, token := range keys {
+		piece, _ := tok.DecodeToken(token)
+		fmt.Printf("%7d %-12q %v\n", token, piece, bias[token])
+		obj[fmt.Sprint(token)] = bias[token]
+	}
+	b, err := json.Marshal(map[string]any{"logit_bias": obj})
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf("\n%s\n", b)
+}
+
+// isWord reports whether s is letters, after an optional leading space.
+func isWord(s string) bool {
+	s = strings.TrimPrefix(s, " ")
+	return s != "" && strings.IndexFunc(s, func(r rune) bool { return !unicode.IsLetter(r) }) < 0
+}
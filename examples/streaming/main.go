@@ -0,0 +1,81 @@
+// The streaming example decodes tokens that arrive in small chunks, like the
+// deltas of a streaming completion API, and prints the text as it arrives.
+//
+// Tokens can end in the middle of a UTF-8 character. Decoding each chunk on its
+// own prints broken characters where that happens, while a StreamDecoder holds
+// back incomplete characters until the rest of them arrives.
+package main
+
+import (
+	"fmt"
+	"log"
+	"math/rand"
+	"strings"
+	"time"
+
+	"github.com/peterheb/gotoken"
+	_ "github.com/peterheb/gotoken/cl100kbase"
+)
+
+// completion stands in for the text generated by a model.
+const completion = "Sure! In Japanese, \"hello\" is こんにちは (konnichiwa). 😄👋\n"
+
+func main() {
+	tok, err := gotoken.GetTokenizer("cl100k_base")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	fmt.Println("Decoding each chunk on its own:")
+	for chunk := range simulateStream(tok) {
+		text, err := tok.Decode(chunk)
+		if err != nil {
+			log.Fatal(err)
+		}
+		show(text)
+	}
+
+	fmt.Println("\nDecoding with a StreamDecoder:")
+	sd := gotoken.NewStreamDecoder(tok)
+	for chunk := range simulateStream(tok) {
+		text, err := sd.Decode(chunk)
+		if err != nil {
+			log.Fatal(err)
+		}
+		show(text)
+	}
+	// At the end of the stream, print anything still held back
+	show(sd.Flush())
+}
+
+// show prints text the way a chat UI would receive it, with each chunk sent
+// separately. Like encoding/json and most UI toolkits, it replaces invalid
+// UTF-8 with U+FFFD, which is where broken characters come from.
+func show(text string) {
+	fmt.Print(strings.ToValidUTF8(text, "\uFFFD"))
+}
+
+// simulateStream returns a channel that receives the tokens of completion in
+// chunks of one to three tokens, with a short delay between them, like the
+// server-sent events of a completion API. The channel is closed at the end.
+func simulateStream(tok gotoken.Tokenizer) <-chan []int {
+	tokens, err := tok.Encode(completion)
+	if err != nil {
+		log.Fatal(err)
+	}
+	ch := make(chan []int)
+	go func() {
+		defer close(ch)
+		rng := rand.New(rand.NewSource(1))
+		for len(tokens) > 0 {
+			n := 1 + rng.Intn(3)
+			if n > len(tokens) {
+				n = len(tokens)
+			}
+			ch <- tokens[:n]
+			tokens = tokens[n:]
+			time.Sleep(20 * time.Millisecond)
+		}
+	}()
+	return ch
+}
@@ -0,0 +1,116 @@
+//go:build js && wasm
+
+// The wasm example exports gotoken to JavaScript, for counting tokens in a web
+// browser. See README.md for how to build and run it.
+//
+// It defines a global gotoken object with three functions. Each takes an
+// optional encoding name, which defaults to "cl100k_base", and returns an
+// object like the JSON responses of the httpserver package:
+//
+//	gotoken.encode(text, encoding)   => {tokens: [...]} or {error: "..."}
+//	gotoken.decode(tokens, encoding) => {text: "..."} or {error: "..."}
+//	gotoken.count(text, encoding)    => {count: n} or {error: "..."}
+package main
+
+import (
+	"syscall/js"
+
+	"github.com/peterheb/gotoken"
+	_ "github.com/peterheb/gotoken/cl100kbase"
+	_ "github.com/peterheb/gotoken/p50kbase"
+	_ "github.com/peterheb/gotoken/r50kbase"
+)
+
+// tokenizers caches one tokenizer per encoding. JavaScript calls into Go one at
+// a time, so no locking is needed.
+var tokenizers = map[string]gotoken.Tokenizer{}
+
+func main() {
+	js.Global().Set("gotoken", js.ValueOf(map[string]any{
+		"encode": js.FuncOf(encode),
+		"decode": js.FuncOf(decode),
+		"count":  js.FuncOf(count),
+	}))
+
+	// Keep running so the exported functions remain callable.
+	select {}
+}
+
+func encode(this js.Value, args []js.Value) any {
+	tok, err := tokenizer(args, 1)
+	if err != nil {
+		return errorResult(err)
+	}
+	tokens, err := tok.Encode(stringArg(args, 0))
+	if err != nil {
+		return errorResult(err)
+	}
+	result := make([]any, len(tokens))
+	for i, t := range tokens {
+		result[i] = t
+	}
+	return map[string]any{"tokens": result}
+}
+
+func decode(this js.Value, args []js.Value) any {
+	tok, err := tokenizer(args, 1)
+	if err != nil {
+		return errorResult(err)
+	}
+	var tokens []int
+	if len(args) > 0 && args[0].Truthy() {
+		tokens = make([]int, args[0].Length())
+		for i := range tokens {
+			tokens[i] = args[0].Index(i).Int()
+		}
+	}
+	text, err := tok.Decode(tokens)
+	if err != nil {
+		return errorResult(err)
+	}
+	return map[string]any{"text": text}
+}
+
+func count(this js.Value, args []js.Value) any {
+	tok, err := tokenizer(args, 1)
+	if err != nil {
+		return errorResult(err)
+	}
+	// Count returns 0 on error, so Encode is used to report the error instead
+	tokens, err := tok.Encode(stringArg(args, 0))
+	if err != nil {
+		return errorResult(err)
+	}
+	return map[string]any{"count": len(tokens)}
+}
+
+// tokenizer returns the tokenizer for the encoding named by args[i], or
+// cl100k_base if there is no such argument.
+func tokenizer(args []js.Value, i int) (gotoken.Tokenizer, error) {
+	encoding := stringArg(args, i)
+	if encoding == "" {
+		encoding = "cl100k_base"
+	}
+	if tok, ok := tokenizers[encoding]; ok {
+		return tok, nil
+	}
+	tok, err := gotoken.GetTokenizer(encoding, gotoken.WithSpecialTokensAsText())
+	if err != nil {
+		return nil, err
+	}
+	tokenizers[encoding] = tok
+	return tok, nil
+}
+
+// stringArg returns args[i] as a string, or "" if it is missing or not a
+// string.
+func stringArg(args []js.Value, i int) string {
+	if i >= len(args) || args[i].Type() != js.TypeString {
+		return ""
+	}
+	return args[i].String()
+}
+
+func errorResult(err error) any {
+	return map[string]any{"error": err.Error()}
+}
@@ -0,0 +1,138 @@
+// Copyright 2023 Peter Hebert. Licensed under the MIT license.
+
+package gotoken
+
+import (
+	"fmt"
+	"unicode/utf8"
+)
+
+// The special tokens of fill-in-the-middle prompts, in encodings that have
+// them, like p50k_edit and cl100k_base.
+const (
+	fimPrefix = "<|fim_prefix|>"
+	fimMiddle = "<|fim_middle|>"
+	fimSuffix = "<|fim_suffix|>"
+)
+
+// FIMPrompt is the result of [BuildFIMPrompt].
+type FIMPrompt struct {
+	Tokens []int  // the encoded prompt
+	Prefix string // the part of the prefix in the prompt
+	Suffix string // the part of the suffix in the prompt
+}
+
+// BuildFIMPrompt encodes a fill-in-the-middle prompt, which asks a model for
+// the text between prefix and suffix, like the code at a cursor. The prompt is
+// in prefix-suffix-middle order:
+//
+//	<|fim_prefix|>prefix<|fim_suffix|>suffix<|fim_middle|>
+//
+// The encoding of tok must define the three special tokens. They are added as
+// tokens, regardless of the options of tok; special tokens in prefix and
+// suffix are handled as they are by [Tokenizer.Encode].
+//
+// If the prompt would be longer than maxTokens, the start of the prefix and
+// the end of the suffix, which are furthest from the middle, are cut off. The
+// remaining tokens are split evenly between them, and either side can use what
+// the other doesn't need. Cuts are made at line breaks where that loses little
+// text, and otherwise where a token begins a new character, so the kept text
+// is valid UTF-8 if the input was. The kept text is returned in Prefix and
+// Suffix.
+func BuildFIMPrompt(tok Tokenizer, prefix, suffix string, maxTokens int) (FIMPrompt, error) {
+	var special [3]int
+	for i, name := range []string{fimPrefix, fimSuffix, fimMiddle} {
+		token, ok := tok.SpecialTokenID(name)
+		if !ok {
+			return FIMPrompt{}, fmt.Errorf("encoding %s has no %s token", tok.Name(), name)
+		}
+		special[i] = token
+	}
+	if maxTokens < len(special) {
+		return FIMPrompt{}, fmt.Errorf("a FIM prompt needs at least %d tokens, but maxTokens is %d", len(special), maxTokens)
+	}
+	prefixTokens, prefixOffsets, err := tok.EncodeWithOffsets(prefix)
+	if err != nil {
+		return FIMPrompt{}, fmt.Errorf("encoding prefix: %w", err)
+	}
+	suffixTokens, suffixOffsets, err := tok.EncodeWithOffsets(suffix)
+	if err != nil {
+		return FIMPrompt{}, fmt.Errorf("encoding suffix: %w", err)
+	}
+
+	// Divide the budget, giving any odd token to the prefix
+	avail := maxTokens - len(special)
+	nPrefix, nSuffix := len(prefixTokens), len(suffixTokens)
+	if nPrefix+nSuffix > avail {
+		half := avail / 2
+		switch {
+		case nPrefix <= avail-half:
+			nSuffix = avail - nPrefix
+		case nSuffix <= half:
+			nPrefix = avail - nSuffix
+		default:
+			nPrefix, nSuffix = avail-half, half
+		}
+	}
+
+	start := fimPrefixCut(prefix, prefixOffsets, len(prefixTokens)-nPrefix)
+	end := fimSuffixCut(suffix, suffixOffsets, nSuffix)
+	ret := FIMPrompt{Prefix: prefix, Suffix: suffix}
+	if start < len(prefixTokens) {
+		ret.Prefix = prefix[prefixOffsets[start]:]
+	} else {
+		ret.Prefix = ""
+	}
+	if end < len(suffixTokens) {
+		ret.Suffix = suffix[:suffixOffsets[end]]
+	}
+
+	ret.Tokens = make([]int, 0, len(special)+len(prefixTokens)-start+end)
+	ret.Tokens = append(ret.Tokens, special[0])
+	ret.Tokens = append(ret.Tokens, prefixTokens[start:]...)
+	ret.Tokens = append(ret.Tokens, special[1])
+	ret.Tokens = append(ret.Tokens, suffixTokens[:end]...)
+	ret.Tokens = append(ret.Tokens, special[2])
+	return ret, nil
+}
+
+// fimPrefixCut returns the index of the first token of the prefix to keep,
+// which is at least min: the first token after a line break if that drops at
+// most a quarter of the tokens that would fit, or else the first token that
+// starts a character.
+func fimPrefixCut(prefix string, offsets []int, min int) int {
+	if min <= 0 {
+		return 0
+	}
+	limit := min + (len(offsets)-min)/4
+	for i := min; i <= limit && i < len(offsets); i++ {
+		if prefix[offsets[i]-1] == '\n' {
+			return i
+		}
+	}
+	i := min
+	for i < len(offsets) && !utf8.RuneStart(prefix[offsets[i]]) {
+		i++
+	}
+	return i
+}
+
+// fimSuffixCut returns the number of tokens of the suffix to keep, which is at
+// most max: up to the last line break if that drops at most a quarter of them,
+// or else up to a token that starts a character.
+func fimSuffixCut(suffix string, offsets []int, max int) int {
+	if max >= len(offsets) {
+		return len(offsets)
+	}
+	limit := max - max/4
+	for i := max; i >= limit && i > 0; i-- {
+		if suffix[offsets[i]-1] == '\n' {
+			return i
+		}
+	}
+	i := max
+	for i > 0 && !utf8.RuneStart(suffix[offsets[i]]) {
+		i--
+	}
+	return i
+}
@@ -0,0 +1,19 @@
+// Copyright 2023 Peter Hebert. Licensed under the MIT license.
+
+package gotoken
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestBuildFIMPrompt(t *testing.T) {
+	tok, err := GetTokenizer("runes")
+	if err != nil {
+		t.Fatalf("GetTokenizer('runes'): %v", err)
+	}
+	_, err = BuildFIMPrompt(tok, "a", "b", 10)
+	if err == nil || !strings.Contains(err.Error(), "no <|fim_prefix|> token") {
+		t.Errorf("BuildFIMPrompt() with no FIM tokens: err = %v", err)
+	}
+}
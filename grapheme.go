@@ -0,0 +1,148 @@
+// Copyright 2023 Peter Hebert. Licensed under the MIT license.
+
+package gotoken
+
+import "unicode"
+
+// graphemeProp is the Grapheme_Cluster_Break property of a rune, as defined by
+// Unicode Standard Annex #29, for the properties that graphemeBoundaries uses.
+type graphemeProp uint8
+
+const (
+	gbOther graphemeProp = iota
+	gbCR
+	gbLF
+	gbControl
+	gbExtend
+	gbZWJ
+	gbSpacingMark
+	gbRegionalIndicator
+	gbPictographic // Extended_Pictographic, which is an emoji property
+	gbL            // Hangul leading consonant
+	gbV            // Hangul vowel
+	gbT            // Hangul trailing consonant
+	gbLV           // Hangul syllable without a trailing consonant
+	gbLVT          // Hangul syllable with a trailing consonant
+)
+
+// graphemeBoundaries returns the byte offsets in text where extended grapheme
+// clusters begin, which are the characters as a user perceives them, followed
+// by len(text). The rules are those of Unicode Standard Annex #29, except that
+// the rare Prepend characters and Indic conjuncts are not joined to the
+// character that follows them, and Extended_Pictographic is approximated by
+// the blocks that contain emoji. Invalid UTF-8 bytes are clusters of their own.
+func graphemeBoundaries(text string) []int {
+	ret := []int{0}
+	prev := gbControl // nothing joins the start of text
+	riCount := 0      // number of regional indicators in a row before this rune
+	pict := false     // the last rune other than Extend was pictographic
+	pictZWJ := false  // the previous rune was a ZWJ after a pictographic rune
+	for i, r := range text {
+		p := graphemeProperty(r)
+		if i > 0 && graphemeBreak(prev, p, riCount%2 == 1, pictZWJ) {
+			ret = append(ret, i)
+		}
+		if p == gbRegionalIndicator {
+			riCount++
+		} else {
+			riCount = 0
+		}
+		pictZWJ = pict && p == gbZWJ
+		if p != gbExtend {
+			pict = p == gbPictographic
+		}
+		prev = p
+	}
+	if len(text) > 0 {
+		ret = append(ret, len(text))
+	}
+	return ret
+}
+
+// graphemeBreak reports whether there is a grapheme cluster boundary between
+// runes with the properties prev and next. riOdd is whether an odd number of
+// regional indicators precede next, and pictZWJ whether prev is a ZWJ that
+// follows a pictographic rune.
+func graphemeBreak(prev, next graphemeProp, riOdd, pictZWJ bool) bool {
+	switch {
+	case prev == gbCR && next == gbLF:
+		return false
+	case prev == gbCR || prev == gbLF || prev == gbControl:
+		return true
+	case next == gbCR || next == gbLF || next == gbControl:
+		return true
+	case prev == gbL && (next == gbL || next == gbV || next == gbLV || next == gbLVT):
+		return false
+	case (prev == gbLV || prev == gbV) && (next == gbV || next == gbT):
+		return false
+	case (prev == gbLVT || prev == gbT) && next == gbT:
+		return false
+	case next == gbExtend || next == gbZWJ || next == gbSpacingMark:
+		return false
+	case pictZWJ && next == gbPictographic:
+		return false
+	case prev == gbRegionalIndicator && next == gbRegionalIndicator:
+		return !riOdd
+	}
+	return true
+}
+
+// graphemeProperty returns the Grapheme_Cluster_Break property of r.
+func graphemeProperty(r rune) graphemeProp {
+	switch {
+	case r == '\r':
+		return gbCR
+	case r == '\n':
+		return gbLF
+	case r == 0x200d:
+		return gbZWJ
+	case r == 0x200c, r >= 0x1f3fb && r <= 0x1f3ff, r >= 0xe0020 && r <= 0xe007f:
+		// Zero width non-joiner, emoji skin tone modifiers, and emoji tags
+		return gbExtend
+	case r >= 0x1f1e6 && r <= 0x1f1ff:
+		return gbRegionalIndicator
+	case r < 0x80:
+		if r < 0x20 || r == 0x7f {
+			return gbControl
+		}
+		return gbOther
+	case r >= 0x1100 && r <= 0x115f, r >= 0xa960 && r <= 0xa97c:
+		return gbL
+	case r >= 0x1160 && r <= 0x11a7, r >= 0xd7b0 && r <= 0xd7c6:
+		return gbV
+	case r >= 0x11a8 && r <= 0x11ff, r >= 0xd7cb && r <= 0xd7fb:
+		return gbT
+	case r >= 0xac00 && r <= 0xd7a3:
+		if (r-0xac00)%28 == 0 {
+			return gbLV
+		}
+		return gbLVT
+	case unicode.In(r, unicode.Mn, unicode.Me):
+		return gbExtend
+	case unicode.Is(unicode.Mc, r):
+		return gbSpacingMark
+	case unicode.In(r, unicode.Cc, unicode.Cf, unicode.Zl, unicode.Zp):
+		return gbControl
+	case isPictographic(r):
+		return gbPictographic
+	}
+	return gbOther
+}
+
+// isPictographic approximates the Extended_Pictographic property of r with the
+// blocks and characters that emoji are drawn from.
+func isPictographic(r rune) bool {
+	switch {
+	case r == 0xa9, r == 0xae, r == 0x203c, r == 0x2049, r == 0x2122, r == 0x2139:
+		return true
+	case r >= 0x2190 && r <= 0x21ff, r >= 0x2300 && r <= 0x23ff:
+		return true // arrows and technical symbols, like ⌚ and ⏩
+	case r >= 0x25a0 && r <= 0x27bf, r >= 0x2b00 && r <= 0x2bff:
+		return true // shapes, dingbats, and miscellaneous symbols, like ☀ and ✂
+	case r == 0x3030, r == 0x303d, r == 0x3297, r == 0x3299:
+		return true
+	case r >= 0x1f000 && r <= 0x1fffd:
+		return true // the emoji blocks, not counting those handled above
+	}
+	return false
+}
@@ -0,0 +1,41 @@
+// Copyright 2023 Peter Hebert. Licensed under the MIT license.
+
+package gotoken
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestGraphemeBoundaries(t *testing.T) {
+	tests := []struct {
+		name     string
+		clusters []string
+	}{
+		{"empty", nil},
+		{"ascii", []string{"a", "b", " ", "c"}},
+		{"crlf", []string{"a", "\r\n", "\n", "\r", "b"}},
+		{"combining marks", []string{"e\u0301\u0323", "x"}},
+		{"spacing mark", []string{"\u0915\u093f", "a"}},
+		{"family", []string{"\U0001F468\u200D\U0001F469\u200D\U0001F467", "!"}},
+		{"skin tone", []string{"\U0001F44D\U0001F3FD", "\U0001F44D"}},
+		{"keycap", []string{"1\uFE0F\u20E3", "2"}},
+		{"flags", []string{"\U0001F1FA\U0001F1F8", "\U0001F1EB\U0001F1F7", "\U0001F1EF"}},
+		{"subdivision flag", []string{"\U0001F3F4\U000E0067\U000E0062\U000E0073\U000E0063\U000E0074\U000E007F", "a"}},
+		{"zwj after text", []string{"a\u200D", "\U0001F469"}},
+		{"hangul jamo", []string{"\u1100\u1161\u11A8", "\uAC00\u11A8", "\uAC01"}},
+		{"invalid utf-8", []string{"a", "\xff", "\xe2", "b"}},
+		{"control", []string{"\t", "\u0301", "\u200B", "a"}},
+	}
+	for _, tt := range tests {
+		text := ""
+		want := []int{0}
+		for _, c := range tt.clusters {
+			text += c
+			want = append(want, len(text))
+		}
+		if got := graphemeBoundaries(text); !reflect.DeepEqual(got, want) {
+			t.Errorf("%s: graphemeBoundaries(%q) = %v, want %v", tt.name, text, got, want)
+		}
+	}
+}
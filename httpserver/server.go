@@ -0,0 +1,180 @@
+// Copyright 2023 Peter Hebert. Licensed under the MIT license.
+
+// Package httpserver provides an HTTP service for tokenizing text, so programs
+// written in other languages can share one gotoken instance, for example as a
+// sidecar. It serves three endpoints, each accepting a POST with a JSON body:
+//
+//	POST /encode  {"encoding": "cl100k_base", "text": "hello world"}
+//	           => {"tokens": [15339, 1917]}
+//	POST /decode  {"encoding": "cl100k_base", "tokens": [15339, 1917]}
+//	           => {"text": "hello world"}
+//	POST /count   {"encoding": "cl100k_base", "text": "hello world"}
+//	           => {"count": 2}
+//
+// The encoding may be any encoding registered with gotoken, and defaults to
+// [DefaultEncoding] if omitted. Encoding packages must be imported by the
+// program for their encodings to be available. On failure, the response has a
+// 4xx status and a body like {"error": "..."}.
+//
+// Example of serving on port 8080:
+//
+//	log.Fatal(http.ListenAndServe(":8080", httpserver.New()))
+package httpserver
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+
+	"github.com/peterheb/gotoken"
+)
+
+// DefaultEncoding is used for requests that do not specify an encoding.
+const DefaultEncoding = "cl100k_base"
+
+// MaxRequestBytes is the largest request body the server will accept.
+const MaxRequestBytes = 32 << 20
+
+// Request is the JSON body of a request to any endpoint. Text is used by
+// /encode and /count, and Tokens by /decode.
+type Request struct {
+	Encoding string `json:"encoding,omitempty"`
+	Text     string `json:"text,omitempty"`
+	Tokens   []int  `json:"tokens,omitempty"`
+}
+
+// EncodeResponse is the JSON body of a successful response from /encode.
+type EncodeResponse struct {
+	Tokens []int `json:"tokens"`
+}
+
+// DecodeResponse is the JSON body of a successful response from /decode.
+type DecodeResponse struct {
+	Text string `json:"text"`
+}
+
+// CountResponse is the JSON body of a successful response from /count.
+type CountResponse struct {
+	Count int `json:"count"`
+}
+
+// ErrorResponse is the JSON body of a response to a request that failed.
+type ErrorResponse struct {
+	Error string `json:"error"`
+}
+
+// Server is an http.Handler that serves the tokenization endpoints. Tokenizers
+// are created on first use of each encoding and shared between requests.
+type Server struct {
+	opts []gotoken.Option
+	mux  *http.ServeMux
+
+	mu         sync.Mutex
+	tokenizers map[string]gotoken.Tokenizer
+}
+
+// New returns a Server. The options are used to create every tokenizer; for
+// example, pass [gotoken.WithSpecialTokensAsText] to accept any input text.
+func New(opts ...gotoken.Option) *Server {
+	s := &Server{
+		opts:       opts,
+		mux:        http.NewServeMux(),
+		tokenizers: make(map[string]gotoken.Tokenizer),
+	}
+	s.mux.HandleFunc("/encode", s.handle(s.encode))
+	s.mux.HandleFunc("/decode", s.handle(s.decode))
+	s.mux.HandleFunc("/count", s.handle(s.count))
+	return s
+}
+
+// ServeHTTP implements http.Handler.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mux.ServeHTTP(w, r)
+}
+
+func (s *Server) encode(ctx context.Context, tok gotoken.Tokenizer, req *Request) (any, error) {
+	tokens, err := tok.EncodeCtx(ctx, req.Text)
+	if err != nil {
+		return nil, err
+	}
+	if tokens == nil {
+		tokens = []int{}
+	}
+	return &EncodeResponse{Tokens: tokens}, nil
+}
+
+func (s *Server) decode(ctx context.Context, tok gotoken.Tokenizer, req *Request) (any, error) {
+	text, err := tok.Decode(req.Tokens)
+	if err != nil {
+		return nil, err
+	}
+	return &DecodeResponse{Text: text}, nil
+}
+
+func (s *Server) count(ctx context.Context, tok gotoken.Tokenizer, req *Request) (any, error) {
+	count, err := tok.CountCtx(ctx, req.Text)
+	if err != nil {
+		return nil, err
+	}
+	return &CountResponse{Count: count}, nil
+}
+
+// handle wraps an endpoint with request parsing, tokenizer lookup, and
+// response writing. Encoding stops early if the client goes away.
+func (s *Server) handle(fn func(context.Context, gotoken.Tokenizer, *Request) (any, error)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			w.Header().Set("Allow", http.MethodPost)
+			writeJSON(w, http.StatusMethodNotAllowed, &ErrorResponse{Error: "method not allowed"})
+			return
+		}
+
+		var req Request
+		if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, MaxRequestBytes)).Decode(&req); err != nil {
+			writeJSON(w, http.StatusBadRequest, &ErrorResponse{Error: "invalid request: " + err.Error()})
+			return
+		}
+
+		tok, err := s.tokenizer(req.Encoding)
+		if err != nil {
+			writeJSON(w, http.StatusBadRequest, &ErrorResponse{Error: err.Error()})
+			return
+		}
+
+		resp, err := fn(r.Context(), tok, &req)
+		if err != nil {
+			writeJSON(w, http.StatusUnprocessableEntity, &ErrorResponse{Error: err.Error()})
+			return
+		}
+		writeJSON(w, http.StatusOK, resp)
+	}
+}
+
+// tokenizer returns the shared tokenizer for an encoding, creating it if
+// needed.
+func (s *Server) tokenizer(encoding string) (gotoken.Tokenizer, error) {
+	if encoding == "" {
+		encoding = DefaultEncoding
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if tok, ok := s.tokenizers[encoding]; ok {
+		return tok, nil
+	}
+	tok, err := gotoken.GetTokenizer(encoding, s.opts...)
+	if err != nil {
+		return nil, err
+	}
+	s.tokenizers[encoding] = tok
+	return tok, nil
+}
+
+func writeJSON(w http.ResponseWriter, status int, resp any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	enc := json.NewEncoder(w)
+	enc.SetEscapeHTML(false) // keep special tokens like "<|endoftext|>" readable
+	enc.Encode(resp)
+}
@@ -0,0 +1,60 @@
+// Copyright 2023 Peter Hebert. Licensed under the MIT license.
+
+package httpserver_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	_ "github.com/peterheb/gotoken/cl100kbase"
+	"github.com/peterheb/gotoken/httpserver"
+	_ "github.com/peterheb/gotoken/r50kbase"
+)
+
+func TestServer(t *testing.T) {
+	ts := httptest.NewServer(httpserver.New())
+	defer ts.Close()
+
+	tests := []struct {
+		path   string
+		body   string
+		status int
+		want   string
+	}{
+		{"/encode", `{"text": "hello world"}`, http.StatusOK, `{"tokens":[15339,1917]}`},
+		{"/encode", `{"encoding": "r50k_base", "text": "hello world"}`, http.StatusOK, `{"tokens":[31373,995]}`},
+		{"/encode", `{"text": ""}`, http.StatusOK, `{"tokens":[]}`},
+		{"/decode", `{"tokens": [15339, 1917]}`, http.StatusOK, `{"text":"hello world"}`},
+		{"/count", `{"encoding": "cl100k_base", "text": "hello world"}`, http.StatusOK, `{"count":2}`},
+		{"/encode", `{"encoding": "nope", "text": "hello"}`, http.StatusBadRequest, `{"error":"unknown tokenizer encoding: nope"}`},
+		{"/count", `{"text": "<|endoftext|>"}`, http.StatusUnprocessableEntity, `{"error":"unexpected special token found: \"<|endoftext|>\""}`},
+		{"/decode", `{"tokens": [-1]}`, http.StatusUnprocessableEntity, `{"error":"invalid token: -1"}`},
+		{"/count", `{"text": `, http.StatusBadRequest, `{"error":"invalid request: unexpected EOF"}`},
+	}
+	for _, tc := range tests {
+		resp, err := http.Post(ts.URL+tc.path, "application/json", strings.NewReader(tc.body))
+		if err != nil {
+			t.Fatalf("POST %s: %v", tc.path, err)
+		}
+		got, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			t.Fatalf("POST %s %s: reading response: %v", tc.path, tc.body, err)
+		}
+		if resp.StatusCode != tc.status || strings.TrimSpace(string(got)) != tc.want {
+			t.Errorf("POST %s %s got %d %s; expected %d %s", tc.path, tc.body, resp.StatusCode, got, tc.status, tc.want)
+		}
+	}
+
+	resp, err := http.Get(ts.URL + "/encode")
+	if err != nil {
+		t.Fatalf("GET /encode: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusMethodNotAllowed {
+		t.Errorf("GET /encode got %d; expected %d", resp.StatusCode, http.StatusMethodNotAllowed)
+	}
+}
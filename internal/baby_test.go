@@ -16,21 +16,12 @@ const (
 // GetBabyTokenizerParams returns the *BPEParams structure used to create the
 // "baby" tokenizer.
 func getBabyTokenizerParams() *BPEParams {
-	// inflate bytePairLookup into pairsToToken
-	pairsToToken := make([]int, 65536)
-	for i := 0; i < 65536; i++ {
-		pairsToToken[i] = -1
-	}
-	for _, pair := range bytePairLookup {
-		pairsToToken[pair>>20] = int(pair) & 0xfffff
-	}
-
 	return &BPEParams{
 		Name:           "baby",
-		Splitter:       GPT2Splitter,
+		Splitter:       GPT2SpanSplitter,
 		ByteEncoder:    byteToToken,
-		BytePairLookup: pairsToToken,
-		DecoderMap:     tokenList,
+		BytePairLookup: NewBytePairTable(bytePairLookup),
+		DecoderMap:     NewTokenListFromStrings(tokenList),
 		EncoderTrie:    tokenTrie,
 		SpecialTokens:  map[string]int{babyEndOfTextString: babyEndOfTextToken},
 	}
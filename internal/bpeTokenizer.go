@@ -4,9 +4,18 @@
 package internal
 
 import (
+	"context"
+	"errors"
 	"fmt"
-	"regexp"
+	"io"
+	"runtime"
+	"sort"
 	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+	"unicode"
+	"unicode/utf8"
 
 	"github.com/peterheb/gotoken"
 )
@@ -52,55 +61,160 @@ import (
 // by OpenAI's APIs.
 type BPETokenizer struct {
 	params                *BPEParams
-	disallowSpecialTokens bool           // if true, special tokens return an error
-	allowedSpecialTokens  map[string]int // map of allowed special tokens for encoding
-	decodeSpecialTokens   map[int]string // map of all special tokens, for decoding
-	specialTokenRegex     *regexp.Regexp // regular expression that matches ALL special tokens
+	byteDecoder           *[256]int16     // inverse of params.ByteEncoder, or -1 if a token is not a byte
+	disallowSpecialTokens bool            // if true, special tokens return an error
+	allowedSpecialTokens  map[string]int  // map of allowed special tokens for encoding
+	disallowedSpecial     map[string]bool // if not nil, the only special tokens that return an error
+	decodeSpecialTokens   map[int]string  // map of all special tokens, for decoding; read-only
+	specialTokens         *specialMatcher // matches ALL special tokens, nil if there are none
+	spaceInSpecial        bool            // a special token contains a space, so input can't be split at spaces
+	partialResults        bool            // if true, Encode returns partial results on error
+	bytesPerToken         int             // expected input bytes per token, for pre-sizing output
+	maxTokens             int             // if >0, the maximum number of tokens Encode may produce
+	maxInputBytes         int             // if >0, the maximum length of input that will be encoded
+	maxDecodeBytes        int             // if >0, the maximum length of output Decode may produce
+	strictUTF8            bool            // if true, Decode returns an error for invalid UTF-8
+	parallelThreshold     int             // if >0, inputs of at least this many bytes are encoded in parallel
+	maxPieceBytes         int             // if >0, pieces longer than this are encoded in slices
+	cache                 *pieceCache     // if not nil, caches the results of applyBPE
+	timingCallback        func(gotoken.EncodeTiming)
+	metricsHook           func(encoding, op string, tokens int, dur time.Duration)
+	traceHook             func(context.Context, gotoken.TraceSpan)
 }
 
+// defaultBytesPerToken is the expected number of input bytes per token, used to
+// pre-size the output of Encode when no preset has been selected.
+const defaultBytesPerToken = 4
+
+// defaultCacheSize is the number of pieces cached by a tokenizer when no cache
+// size has been set.
+const defaultCacheSize = 8192
+
+// defaultParallelThreshold is the input size in bytes at which Encode switches to
+// encoding chunks of the input in parallel, when no threshold has been set.
+const defaultParallelThreshold = 1 << 20
+
+// defaultMaxPieceBytes is the length in bytes above which a piece is encoded in
+// slices, when no limit has been set.
+const defaultMaxPieceBytes = 64 << 10
+
+// parallelChunkSize is the approximate size in bytes of the chunks an input is
+// split into when it is encoded in parallel.
+const parallelChunkSize = 64 << 10
+
+// endOfText is the special token that marks the end of a document in every
+// encoding that defines one.
+const endOfText = "<|endoftext|>"
+
 // higherThanAnyToken is a placeholder value that is higher than any token in
 // any of our supported encodings.
 const higherThanAnyToken = 0x7fffffff
 
+// Span is the byte range [Start, End) of one part of a splitter's input.
+type Span struct {
+	Start, End int
+}
+
 // BPEParams contains the parameters defining the encoding used by a
-// BPETokenizer. These are the data structures generated by gen.go.
+// BPETokenizer. These are the data structures generated by gotoken-gen.
 type BPEParams struct {
 	Name           string
-	Splitter       func([]byte) [][]byte
-	ByteEncoder    []byte         // token values for each byte 0-255
-	EncoderTrie    serializedTrie // pseudo-map[string]int for strings->tokens
-	DecoderMap     []string       // strings for each token int
-	SpecialTokens  map[string]int // map of all defined special tokens
-	BytePairLookup []int          // lookup table for byte pairs, 256*256 entries
+	Splitter       func(dst []Span, input []byte) []Span // appends the spans of the parts of input to dst
+	ByteEncoder    []byte                                // token values for each byte 0-255
+	EncoderTrie    serializedTrie                        // pseudo-map[string]int for strings->tokens
+	EncoderMPH     *MPH                                  // optional, faster lookups of whole pieces
+	DecoderMap     TokenList                             // strings for each token int
+	SpecialTokens  map[string]int                        // map of all defined special tokens
+	BytePairLookup *BytePairTable                        // lookup table for two-byte tokens
+	DataInfo       gotoken.DataInfo                      // where the data came from, if known
+
+	derived *derivedTables // built once by ParamsCache; nil means build per tokenizer
+}
+
+// derivedTables are the read-only tables that a BPETokenizer derives from its
+// BPEParams. Tokenizers created from the same *BPEParams in a ParamsCache share
+// one derivedTables, rather than each building their own.
+type derivedTables struct {
+	byteDecoder         [256]int16
+	decodeSpecialTokens map[int]string
+	specialTokens       *specialMatcher
+	spaceInSpecial      bool // a special token contains a space
+}
+
+// newDerivedTables builds the derivedTables for params.
+func newDerivedTables(params *BPEParams) *derivedTables {
+	d := &derivedTables{decodeSpecialTokens: make(map[int]string, len(params.SpecialTokens))}
+	for i := range d.byteDecoder {
+		d.byteDecoder[i] = -1
+	}
+	for b, token := range params.ByteEncoder {
+		d.byteDecoder[token] = int16(b)
+	}
+	parts := make([]string, 0, len(params.SpecialTokens))
+	for k, token := range params.SpecialTokens {
+		parts = append(parts, k)
+		d.decodeSpecialTokens[token] = k
+		if strings.Contains(k, " ") {
+			d.spaceInSpecial = true
+		}
+	}
+	d.specialTokens = newSpecialMatcher(parts)
+	return d
 }
 
 // NewBPETokenizer creates a new BPETokenizer from the given BPEParams and using
-// the specified special token encoding settings.
-func NewBPETokenizer(params *BPEParams, allowSpecialAsText bool, allowedSpecialTokens []string) (*BPETokenizer, error) {
+// the specified tokenizer options.
+func NewBPETokenizer(params *BPEParams, opts gotoken.TokenizerOptions) (*BPETokenizer, error) {
 	ret := BPETokenizer{
 		params:                params,
-		disallowSpecialTokens: !allowSpecialAsText,
-		allowedSpecialTokens:  make(map[string]int),
-		decodeSpecialTokens:   make(map[int]string),
+		disallowSpecialTokens: !opts.AllowSpecialAsText,
+		partialResults:        opts.PartialResults,
+		bytesPerToken:         opts.BytesPerToken,
+		maxTokens:             opts.MaxTokens,
+		maxInputBytes:         opts.MaxInputBytes,
+		maxDecodeBytes:        opts.MaxDecodeBytes,
+		strictUTF8:            opts.StrictUTF8,
+		parallelThreshold:     opts.ParallelThreshold,
+		maxPieceBytes:         opts.MaxPieceBytes,
+		timingCallback:        opts.TimingCallback,
+		metricsHook:           opts.MetricsHook,
+		traceHook:             opts.TraceHook,
+	}
+	if ret.bytesPerToken <= 0 {
+		ret.bytesPerToken = defaultBytesPerToken
+	}
+	if ret.parallelThreshold == 0 {
+		ret.parallelThreshold = defaultParallelThreshold
+	}
+	if ret.maxPieceBytes == 0 {
+		ret.maxPieceBytes = defaultMaxPieceBytes
+	}
+	switch {
+	case opts.CacheSize == 0:
+		ret.cache = newPieceCache(defaultCacheSize)
+	case opts.CacheSize > 0:
+		ret.cache = newPieceCache(opts.CacheSize)
 	}
 
-	// Initialization for special tokens (specialTokenRegex, decodeSpecialTokens)
-	var parts []string
-	for k := range params.SpecialTokens {
-		parts = append(parts, regexp.QuoteMeta(k))
-		ret.decodeSpecialTokens[params.SpecialTokens[k]] = k
+	derived := params.derived
+	if derived == nil {
+		derived = newDerivedTables(params)
+	}
+	ret.byteDecoder = &derived.byteDecoder
+	ret.decodeSpecialTokens = derived.decodeSpecialTokens
+	ret.specialTokens = derived.specialTokens
+	if derived.spaceInSpecial {
+		// chunks for parallel encoding are split at spaces, which would break
+		// up a special token
+		ret.parallelThreshold = -1
+		ret.spaceInSpecial = true
 	}
-	ret.specialTokenRegex = regexp.MustCompile("(" + strings.Join(parts, "|") + ")")
 
-	// Fill allowedSpecialTokens if appropriate
-	if len(allowedSpecialTokens) > 0 {
-		for _, k := range allowedSpecialTokens {
-			if tok, ok := params.SpecialTokens[k]; ok {
-				ret.allowedSpecialTokens[k] = tok
-			} else {
-				return nil, fmt.Errorf("special token %q not found in tokenizer %q", k, params.Name)
-			}
-		}
+	// The allowed special tokens are a subset of params.SpecialTokens, which
+	// is shared if they are all allowed
+	var err error
+	if ret.allowedSpecialTokens, err = ret.specialSet(opts.AllowedSpecialTokens); err != nil {
+		return nil, err
 	}
 
 	return &ret, nil
@@ -109,72 +223,886 @@ func NewBPETokenizer(params *BPEParams, allowSpecialAsText bool, allowedSpecialT
 // Encode converts a string into a slice of ints (tokens). A wrapped
 // [gotoken.ErrSpecialToken] error will be returned if a special token appears
 // in the input without being explicitly allowed.
+//
+// If the tokenizer was created with [gotoken.WithMaxTokens], a
+// [*gotoken.TooManyTokensError] is returned if the input encodes to more than
+// the maximum number of tokens.
+//
+// If the tokenizer was created with [gotoken.WithPartialResults], the tokens
+// preceding the point of failure are returned along with a
+// [*gotoken.PartialEncodeError].
 func (tt *BPETokenizer) Encode(s string) ([]int, error) {
+	start := tt.callStart()
+	tokens, err := tt.encodeTimed(nil, s)
+	tt.reportCall(nil, "encode", len(s), len(tokens), start, err)
+	return tokens, err
+}
+
+// callStart returns the start time of a call to report with reportCall, or the
+// zero time if no metrics or trace hook is set, to avoid the cost of reading
+// the clock.
+func (tt *BPETokenizer) callStart() time.Time {
+	if tt.metricsHook == nil && tt.traceHook == nil {
+		return time.Time{}
+	}
+	return time.Now()
+}
+
+// reportCall calls the metrics and trace hooks, if they are set, for a call
+// that started at start, on bytes of text and the given number of tokens. ctx
+// is the context passed to the call, or nil if it had none.
+func (tt *BPETokenizer) reportCall(ctx context.Context, op string, bytes, tokens int, start time.Time, err error) {
+	if tt.metricsHook == nil && tt.traceHook == nil {
+		return
+	}
+	end := time.Now()
+	if tt.metricsHook != nil {
+		tt.metricsHook(tt.params.Name, op, tokens, end.Sub(start))
+	}
+	if tt.traceHook != nil {
+		if ctx == nil {
+			ctx = context.Background()
+		}
+		tt.traceHook(ctx, gotoken.TraceSpan{
+			Encoding: tt.params.Name,
+			Op:       op,
+			Start:    start,
+			End:      end,
+			Bytes:    bytes,
+			Tokens:   tokens,
+			Err:      err,
+		})
+	}
+}
+
+// Encode32 is like Encode, but returns the tokens as a []uint32, which takes
+// half the memory of an []int on 64-bit platforms. Every token ID in the
+// supported encodings fits in a uint32. Errors are the same as for Encode.
+func (tt *BPETokenizer) Encode32(s string) ([]uint32, error) {
+	start := tt.callStart()
+	tokens, err := tt.encodeTimed(nil, s)
+	tt.reportCall(nil, "encode", len(s), len(tokens), start, err)
+	if tokens == nil {
+		return nil, err
+	}
+	ret := make([]uint32, len(tokens))
+	for i, token := range tokens {
+		ret[i] = uint32(token)
+	}
+	return ret, err
+}
+
+// EncodeOrdinary is like Encode, but encodes special tokens in the input as
+// text, regardless of the options of the tokenizer. It matches encode_ordinary
+// in tiktoken.
+func (tt *BPETokenizer) EncodeOrdinary(s string) ([]int, error) {
+	ordinary := *tt
+	ordinary.disallowSpecialTokens = false
+	ordinary.allowedSpecialTokens = nil
+	return ordinary.Encode(s)
+}
+
+// EncodeSpecial is like Encode, but takes the handling of special tokens from
+// its arguments instead of the options of the tokenizer, like encode in
+// tiktoken. Special tokens in allowed are encoded as special tokens. If the
+// input contains any in disallowed, an error that wraps
+// [gotoken.ErrSpecialToken] is returned. Others are encoded as text. A set that
+// contains [gotoken.AllSpecial] stands for every special token, and allowed
+// takes precedence over it in disallowed. An error is returned for a name that
+// is not a special token of this encoding.
+func (tt *BPETokenizer) EncodeSpecial(s string, allowed, disallowed []string) ([]int, error) {
+	special := *tt
+	var err error
+	if special.allowedSpecialTokens, err = tt.specialSet(allowed); err != nil {
+		return nil, err
+	}
+	allDisallowed, err := tt.specialSet(disallowed)
+	if err != nil {
+		return nil, err
+	}
+	special.disallowSpecialTokens = len(allDisallowed) > 0
+	special.disallowedSpecial = make(map[string]bool, len(allDisallowed))
+	for name := range allDisallowed {
+		special.disallowedSpecial[name] = true
+	}
+	return special.Encode(s)
+}
+
+// specialSet returns the special tokens named in names, for the allowed
+// special tokens of NewBPETokenizer and EncodeSpecial. If names include
+// gotoken.AllSpecial, it returns params.SpecialTokens itself, and if names is
+// empty, it returns nil; the result must not be modified.
+func (tt *BPETokenizer) specialSet(names []string) (map[string]int, error) {
+	all := false
+	for _, name := range names {
+		if name == gotoken.AllSpecial {
+			all = true
+		} else if _, ok := tt.params.SpecialTokens[name]; !ok {
+			return nil, fmt.Errorf("special token %q not found in tokenizer %q", name, tt.params.Name)
+		}
+	}
+	if all {
+		return tt.params.SpecialTokens, nil
+	}
+	if len(names) == 0 {
+		return nil, nil
+	}
+	ret := make(map[string]int, len(names))
+	for _, name := range names {
+		ret[name] = tt.params.SpecialTokens[name]
+	}
+	return ret, nil
+}
+
+// EncodeCtx converts a string into a slice of tokens like Encode, but stops
+// early if ctx is cancelled or its deadline passes. In that case, ctx.Err() is
+// returned (wrapped in a [*gotoken.PartialEncodeError] if the tokenizer was
+// created with [gotoken.WithPartialResults]).
+func (tt *BPETokenizer) EncodeCtx(ctx context.Context, s string) ([]int, error) {
+	start := tt.callStart()
+	tokens, err := tt.encodeCtx(ctx, s)
+	tt.reportCall(ctx, "encode", len(s), len(tokens), start, err)
+	return tokens, err
+}
+
+// encodeCtx is the implementation of EncodeCtx and CountCtx.
+func (tt *BPETokenizer) encodeCtx(ctx context.Context, s string) ([]int, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return tt.encodeTimed(ctx, s)
+}
+
+// encodeTimed is the implementation of Encode and EncodeCtx, where ctx may be
+// nil. It reports the timing of the call if a timing callback is set.
+func (tt *BPETokenizer) encodeTimed(ctx context.Context, s string) ([]int, error) {
+	if tt.timingCallback == nil {
+		if ctx == nil {
+			return tt.encodeChecked(s, nil)
+		}
+		return tt.encodeChecked(s, &encodeState{ctx: ctx})
+	}
+
+	// Timing is enabled, so measure this call and report it
+	timing := &gotoken.EncodeTiming{Bytes: len(s)}
+	start := time.Now()
+	tokens, err := tt.encodeChecked(s, &encodeState{ctx: ctx, timing: timing})
+	timing.Total = time.Since(start)
+	timing.Tokens = len(tokens)
+	tt.timingCallback(*timing)
+	return tokens, err
+}
+
+// encodeChecked performs the special token check on s and then encodes it. This
+// is the implementation of Encode; st is passed through to encode.
+func (tt *BPETokenizer) encodeChecked(s string, st *encodeState) ([]int, error) {
+	if err := tt.checkInputSize(s); err != nil {
+		return nil, err
+	}
+
 	// Special token disallow check
-	if err := tt.Allowed(s); err != nil {
+	var start time.Time
+	if st != nil && st.timing != nil {
+		start = time.Now()
+	}
+	var specialErr error
+	ofs, match := tt.findDisallowed(s)
+	if st != nil && st.timing != nil {
+		st.timing.Special += time.Since(start)
+	}
+	if ofs != -1 {
+		specialErr = fmt.Errorf("%w: %q", gotoken.ErrSpecialToken, match)
+		if !tt.partialResults {
+			return nil, specialErr
+		}
+		// encode what we can, up to the special token
+		s = s[:ofs]
+	}
+
+	if st == nil && tt.maxTokens <= 0 && tt.parallelThreshold > 0 && len(s) >= tt.parallelThreshold {
+		// Large input with no limits or instrumentation, so encode in parallel.
+		// Without a token limit or context, encode cannot fail.
+		tokens := tt.encodeParallel(s)
+		if specialErr != nil {
+			return tt.encodeFailed(tokens, len(s), specialErr)
+		}
+		return tokens, nil
+	}
+
+	tokens, ofs, err := tt.encode(s, st)
+	if err != nil {
+		return tt.encodeFailed(tokens, ofs, err)
+	}
+	if specialErr != nil {
+		return tt.encodeFailed(tokens, len(s), specialErr)
+	}
+	return tokens, nil
+}
+
+// encodeParallel encodes s by splitting it into chunks that are encoded in
+// parallel, then concatenating the results. The chunks are split where the
+// pre-token splitter always breaks, so the result is identical to encoding s
+// in one piece.
+func (tt *BPETokenizer) encodeParallel(s string) []int {
+	chunks := splitChunks(s, parallelChunkSize)
+	results := make([][]int, len(chunks))
+	workers := runtime.GOMAXPROCS(0)
+	if workers > len(chunks) {
+		workers = len(chunks)
+	}
+	next := int64(-1)
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				i := int(atomic.AddInt64(&next, 1))
+				if i >= len(chunks) {
+					return
+				}
+				results[i], _, _ = tt.encode(chunks[i], nil)
+			}
+		}()
+	}
+	wg.Wait()
+
+	total := 0
+	for _, r := range results {
+		total += len(r)
+	}
+	encoded := make([]int, 0, total)
+	for _, r := range results {
+		encoded = append(encoded, r...)
+	}
+	return encoded
+}
+
+// splitChunks splits s into chunks of about size bytes each. Chunks only end
+// before a space that follows a non-space rune, which the pre-token splitters
+// of all encodings treat as a boundary regardless of the surrounding text.
+// Chunks may be larger than size if there is no such space nearby.
+func splitChunks(s string, size int) []string {
+	var chunks []string
+	for len(s) > size {
+		end := size
+		for end < len(s) {
+			if s[end] == ' ' {
+				if r, _ := utf8.DecodeLastRuneInString(s[:end]); !unicode.IsSpace(r) {
+					break
+				}
+			}
+			end++
+		}
+		chunks = append(chunks, s[:end])
+		s = s[end:]
+	}
+	return append(chunks, s)
+}
+
+// readChunkSize is the size in bytes of the buffer that EncodeReader reads
+// its input into.
+const readChunkSize = 64 << 10
+
+// EncodeReader tokenizes the text read from r, calling emit with the tokens of
+// each chunk of it in order, so that input of any size can be encoded in
+// bounded memory. The result is the same as encoding all of the text at once
+// with Encode. emit may keep the slices it is passed.
+//
+// Chunks end before a space that follows a non-space rune, which the
+// pre-token splitters of all encodings treat as a boundary, and the text
+// after the last such space in the buffer is carried over to the next chunk.
+// Text without any such space, like one very long word, is buffered until
+// one is found.
+//
+// The limits of the tokenizer, like [gotoken.WithMaxTokens], apply to the
+// whole stream. An error from r or from emit is returned as is. When an error
+// is returned, the tokens of the chunks before the one that failed have
+// already been emitted; with [gotoken.WithPartialResults], so have the tokens
+// of the failed chunk up to the error, and the Offset of the
+// [*gotoken.PartialEncodeError] is from the start of the stream.
+func (tt *BPETokenizer) EncodeReader(r io.Reader, emit func([]int) error) error {
+	start := tt.callStart()
+	bytes, tokens, err := tt.encodeReader(r, emit, readChunkSize)
+	tt.reportCall(nil, "encode", bytes, tokens, start, err)
+	return err
+}
+
+// encodeReader is the implementation of EncodeReader, reading r into a buffer
+// of size bytes. It returns the number of bytes read and tokens emitted.
+func (tt *BPETokenizer) encodeReader(r io.Reader, emit func([]int) error, size int) (bytes, tokens int, err error) {
+	// Chunks are encoded with the limits on tokens and input size adjusted
+	// for what came before them
+	enc := *tt
+	buf := make([]byte, 0, size)
+	eof := false
+	scanned := 0 // buf[:scanned] has been searched for a place to split
+	for {
+		if !eof {
+			if len(buf) == cap(buf) {
+				// No place to split in the whole buffer, so read more
+				grown := make([]byte, len(buf), 2*cap(buf))
+				copy(grown, buf)
+				buf = grown
+			}
+			n, err := r.Read(buf[len(buf):cap(buf)])
+			buf = buf[:len(buf)+n]
+			bytes += n
+			if err == io.EOF {
+				eof = true
+			} else if err != nil {
+				return bytes, tokens, err
+			}
+			if tt.maxInputBytes > 0 && bytes > tt.maxInputBytes {
+				return bytes, tokens, &gotoken.InputTooLargeError{Max: tt.maxInputBytes, Size: bytes}
+			}
+			if !eof && len(buf) < cap(buf) {
+				continue
+			}
+		}
+
+		end := len(buf)
+		if !eof {
+			if end = tt.chunkEnd(buf, scanned); end == 0 {
+				scanned = len(buf)
+				continue
+			}
+		}
+		base := bytes - len(buf) // offset of the chunk in the stream
+		if tt.maxTokens > 0 {
+			enc.maxTokens = tt.maxTokens - tokens
+		}
+		encoded, err := enc.encodeChecked(string(buf[:end]), nil)
+		if err == nil && tt.maxTokens > 0 && enc.maxTokens == 0 && len(encoded) > 0 {
+			// The limit was reached by the chunks before, and a limit of 0
+			// is no limit to encodeChecked, so any tokens at all exceed it
+			tooMany := &gotoken.TooManyTokensError{Max: tt.maxTokens, Count: len(encoded)}
+			encoded, err = tt.encodeFailed(nil, 0, tooMany)
+		}
+		if err != nil {
+			err = tt.streamError(err, base, tokens)
+		}
+		if len(encoded) > 0 {
+			if emitErr := emit(encoded); emitErr != nil {
+				return bytes, tokens, emitErr
+			}
+			tokens += len(encoded)
+		}
+		if err != nil {
+			return bytes, tokens, err
+		}
+		if eof {
+			return bytes, tokens, nil
+		}
+		buf = buf[:copy(buf, buf[end:])]
+		scanned = 0
+	}
+}
+
+// chunkEnd returns the offset of the last place at or after from in buf where
+// it can be split, so that encoding the parts separately gives the same tokens
+// as encoding all of buf, or 0 if there is none. Like splitChunks, it splits
+// before a space that follows a non-space rune, and it also avoids splitting
+// a special token that contains a space.
+func (tt *BPETokenizer) chunkEnd(buf []byte, from int) int {
+	last := len(buf) - 1
+	if tt.spaceInSpecial {
+		// a special token at the end of buf may not have been read in full
+		last = len(buf) - tt.specialTokens.maxLen
+	}
+	if from < 1 {
+		from = 1
+	}
+	for end := last; end >= from; end-- {
+		if buf[end] != ' ' {
+			continue
+		}
+		if r, _ := utf8.DecodeLastRune(buf[:end]); unicode.IsSpace(r) {
+			continue
+		}
+		if tt.spaceInSpecial && tt.insideSpecial(buf, end) {
+			continue
+		}
+		return end
+	}
+	return 0
+}
+
+// insideSpecial returns true if a special token in buf spans offset ofs.
+func (tt *BPETokenizer) insideSpecial(buf []byte, ofs int) bool {
+	lo, hi := ofs-tt.specialTokens.maxLen+1, ofs+tt.specialTokens.maxLen
+	if lo < 0 {
+		lo = 0
+	}
+	if hi > len(buf) {
+		hi = len(buf)
+	}
+	s := string(buf[lo:hi])
+	for i := 0; i < len(s); {
+		start, end := tt.specialTokens.index(s[i:])
+		if start < 0 || lo+i+start >= ofs {
+			return false
+		}
+		if lo+i+end > ofs {
+			return true
+		}
+		i += end
+	}
+	return false
+}
+
+// streamError returns err, from encoding a chunk of a stream that began at
+// byte offset base after count tokens, with its offsets and counts made
+// relative to the whole stream.
+func (tt *BPETokenizer) streamError(err error, base, count int) error {
+	var partial *gotoken.PartialEncodeError
+	if errors.As(err, &partial) {
+		partial.Offset += base
+	}
+	var tooMany *gotoken.TooManyTokensError
+	if errors.As(err, &tooMany) {
+		tooMany.Max = tt.maxTokens
+		tooMany.Count += count
+		tooMany.Offset += base
+	}
+	return err
+}
+
+// EncodeFunc encodes s like Encode, but instead of returning the tokens, it
+// calls fn with the tokens of each piece of s in order, as they are produced.
+// The slice passed to fn is reused, and is only valid until fn returns. This
+// lets a caller count, filter, or write out the tokens of a large input in one
+// pass, without a slice to hold them all.
+//
+// If fn returns an error, encoding stops, and the error is returned (wrapped
+// in a [*gotoken.PartialEncodeError] if the tokenizer was created with
+// [gotoken.WithPartialResults]). Errors are otherwise the same as for Encode,
+// except that the tokens before an error from encoding, like a
+// [*gotoken.TooManyTokensError], have already been passed to fn. Disallowed
+// special tokens are found before any tokens are produced.
+func (tt *BPETokenizer) EncodeFunc(s string, fn func([]int) error) error {
+	start := tt.callStart()
+	st := &encodeState{emit: fn}
+	var timingStart time.Time
+	if tt.timingCallback != nil {
+		st.timing = &gotoken.EncodeTiming{Bytes: len(s)}
+		timingStart = time.Now()
+	}
+	_, err := tt.encodeChecked(s, st)
+	if st.timing != nil {
+		st.timing.Total = time.Since(timingStart)
+		st.timing.Tokens = st.emitted
+		tt.timingCallback(*st.timing)
+	}
+	tt.reportCall(nil, "encode", len(s), st.emitted, start, err)
+	return err
+}
+
+// EncodeWithOffsets converts a string into a slice of tokens like Encode, and
+// also returns the byte offset in s where each token begins. Token i covers
+// s[offsets[i]:offsets[i+1]], with the last token extending to the end of the
+// input. Because tokens may contain partial UTF-8 sequences, offsets do not
+// always fall on rune boundaries.
+func (tt *BPETokenizer) EncodeWithOffsets(s string) ([]int, []int, error) {
+	if err := tt.needTokenList(); err != nil {
+		return nil, nil, err
+	}
+	tokens, err := tt.Encode(s)
+	if tokens == nil {
+		return nil, nil, err
+	}
+	offsets := make([]int, len(tokens))
+	ofs := 0
+	for i, token := range tokens {
+		offsets[i] = ofs
+		ofs += tt.tokenLen(token)
+	}
+	return tokens, offsets, err
+}
+
+// EncodeBatch encodes each of inputs, using a pool of workers goroutines. If
+// workers <= 0, runtime.GOMAXPROCS(0) workers are used. The results are in the
+// same order as inputs.
+//
+// If any input fails to encode, a [*gotoken.BatchError] is returned with the
+// error for each input. The results of the other inputs are still returned;
+// the result for a failed input is what Encode returned for it.
+func (tt *BPETokenizer) EncodeBatch(inputs []string, workers int) ([][]int, error) {
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	if workers > len(inputs) {
+		workers = len(inputs)
+	}
+
+	results := make([][]int, len(inputs))
+	errs := make([]error, len(inputs))
+	failed := int32(0)
+	next := int64(-1)
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				i := int(atomic.AddInt64(&next, 1))
+				if i >= len(inputs) {
+					return
+				}
+				if results[i], errs[i] = tt.Encode(inputs[i]); errs[i] != nil {
+					atomic.StoreInt32(&failed, 1)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	if failed != 0 {
+		return results, &gotoken.BatchError{Errs: errs}
+	}
+	return results, nil
+}
+
+// EncodeSuffix returns the tokens for the text of tokens followed by suffix,
+// as if the combined text had been encoded with Encode. Text at the end of the
+// prompt may be tokenized differently once more text follows it, e.g. "Hello
+// wor" + "ld" encodes " world" as a single token, so the end of the prompt is
+// re-encoded along with the suffix. The rest of the prompt is not re-encoded.
+//
+// Like append, the result may share its underlying array with tokens. A
+// wrapped [gotoken.ErrInvalidToken] is returned if tokens contains a token that
+// is not valid in this encoding.
+func (tt *BPETokenizer) EncodeSuffix(tokens []int, suffix string) ([]int, error) {
+	if err := tt.needTokenList(); err != nil {
+		return nil, err
+	}
+
+	// Walk back through tokens to the last point where the pre-token splitter is
+	// guaranteed to break regardless of what follows: a space that follows a
+	// non-space character, or the end of a special token. The tokens before
+	// that point are unaffected by the suffix.
+	k := len(tokens)
+	tail := ""
+	for k > 0 {
+		token := tokens[k-1]
+		if token < 0 || token >= tt.params.DecoderMap.Len() || tt.params.DecoderMap.Get(token) == "" {
+			if _, ok := tt.decodeSpecialTokens[token]; !ok {
+				return nil, fmt.Errorf("%w: %d", gotoken.ErrInvalidToken, token)
+			}
+			break
+		}
+		k--
+		tail = tt.params.DecoderMap.Get(token) + tail
+		if tail[0] == ' ' && k > 0 && tt.endsWithNonSpace(tokens[:k]) {
+			break
+		}
+	}
+
+	encoded, err := tt.Encode(tail + suffix)
+	if err != nil {
+		return nil, err
+	}
+	return append(tokens[:k], encoded...), nil
+}
+
+// endsWithNonSpace returns true if the text of tokens ends with a rune that is
+// not white space. Special tokens, and tokens not valid in this encoding, count
+// as non-space.
+func (tt *BPETokenizer) endsWithNonSpace(tokens []int) bool {
+	// collect enough bytes from the end of tokens to decode the last rune
+	var last string
+	for i := len(tokens) - 1; i >= 0 && len(last) < utf8.UTFMax; i-- {
+		token := tokens[i]
+		if token < 0 || token >= tt.params.DecoderMap.Len() || tt.params.DecoderMap.Get(token) == "" {
+			break
+		}
+		last = tt.params.DecoderMap.Get(token) + last
+	}
+	r, _ := utf8.DecodeLastRuneInString(last)
+	return !unicode.IsSpace(r)
+}
+
+// needTokenList returns an error wrapping [gotoken.ErrCountOnly] if this
+// tokenizer has no token strings, because it is from a count-only build.
+func (tt *BPETokenizer) needTokenList() error {
+	if tt.params.DecoderMap.Len() == 0 {
+		return fmt.Errorf("%w: %s", gotoken.ErrCountOnly, tt.params.Name)
+	}
+	return nil
+}
+
+// tokenLen returns the length in bytes of a valid token.
+func (tt *BPETokenizer) tokenLen(token int) int {
+	if token >= 0 && token < tt.params.DecoderMap.Len() {
+		if n := len(tt.params.DecoderMap.Get(token)); n > 0 {
+			return n
+		}
+	}
+	return len(tt.decodeSpecialTokens[token])
+}
+
+// encodeFailed returns the result of Encode when encoding stopped at byte
+// offset ofs because of err, with tokens holding the tokens produced so far.
+func (tt *BPETokenizer) encodeFailed(tokens []int, ofs int, err error) ([]int, error) {
+	if !tt.partialResults {
+		return nil, err
+	}
+	return tokens, &gotoken.PartialEncodeError{Offset: ofs, Err: err}
+}
+
+// Explain encodes input like Encode, but instead of returning the tokens, it
+// returns a step-by-step account of how they were produced: how the input was
+// split into pieces, the byte-level tokens each piece started as, and the BPE
+// merges that were applied to it. This is intended for debugging and
+// visualization. A wrapped [gotoken.ErrSpecialToken] is returned if a
+// disallowed special token appears in the input.
+func (tt *BPETokenizer) Explain(input string) ([]gotoken.ExplainedPiece, error) {
+	if err := tt.checkInputSize(input); err != nil {
+		return nil, err
+	}
+	if ofs, match := tt.findDisallowed(input); ofs != -1 {
+		return nil, fmt.Errorf("%w: %q", gotoken.ErrSpecialToken, match)
+	}
+	ex := &explainer{}
+	if _, _, err := tt.encode(input, &encodeState{ex: ex}); err != nil {
 		return nil, err
 	}
+	return ex.pieces, nil
+}
+
+// encodeState holds optional instrumentation for a call to encode. A nil
+// *encodeState, or nil fields, disable it.
+type encodeState struct {
+	ex      *explainer            // records the steps of encoding, for Explain
+	timing  *gotoken.EncodeTiming // accumulates the time spent in each phase
+	ctx     context.Context       // checked periodically for cancellation
+	emit    func([]int) error     // if set, receives the tokens of each piece, for EncodeFunc
+	emitted int                   // number of tokens passed to emit
+}
+
+// emitBufferSize is the initial capacity of the buffer that encode collects
+// the tokens of a piece in, when they are passed to an emit function.
+const emitBufferSize = 64
+
+// flush passes the tokens of a piece to st.emit, unless there are none.
+func (st *encodeState) flush(tokens []int) error {
+	if len(tokens) == 0 {
+		return nil
+	}
+	st.emitted += len(tokens)
+	return st.emit(tokens)
+}
 
-	// Return value (preallocate len/4 tokens as a heuristic)
-	encoded := make([]int, 0, len(s)/4+1)
-	input := []byte(s)
+// cancelCheckInterval is the number of pieces, or of merges within a piece,
+// between checks for cancellation of an encodeState's context.
+const cancelCheckInterval = 256
+
+// cancelled returns true if st has a context that is done.
+func (st *encodeState) cancelled() bool {
+	return st != nil && st.ctx != nil && st.ctx.Err() != nil
+}
+
+// explainer collects the steps performed by encode, for Explain.
+type explainer struct {
+	pieces []gotoken.ExplainedPiece
+	merges []gotoken.BPEMerge // merges for the piece currently being encoded
+}
+
+// addPiece records that part was encoded to tokens, along with any merges
+// collected since the last call.
+func (ex *explainer) addPiece(tt *BPETokenizer, part []byte, special bool, tokens []int) {
+	piece := gotoken.ExplainedPiece{
+		Text:    string(part),
+		Special: special,
+		Merges:  ex.merges,
+		Tokens:  append([]int(nil), tokens...),
+	}
+	if !special {
+		piece.ByteTokens = make([]int, len(part))
+		for i, b := range part {
+			piece.ByteTokens[i] = int(tt.params.ByteEncoder[b])
+		}
+	}
+	ex.pieces = append(ex.pieces, piece)
+	ex.merges = nil
+}
+
+// encodeScratch holds buffers that are reused between calls to encode, so that
+// steady-state encoding allocates little besides its result.
+type encodeScratch struct {
+	input []byte // copy of the input string, which the splitter needs as []byte
+	spans []Span // output of the splitter
+}
+
+// maxPooledScratch is the input size in bytes above which an encodeScratch is
+// not returned to the pool, so that one huge input doesn't pin its memory.
+const maxPooledScratch = 1 << 20
+
+var scratchPool = sync.Pool{New: func() any { return new(encodeScratch) }}
+
+// bpeScratchPool holds *bpeScratch buffers for applyBPE.
+var bpeScratchPool = sync.Pool{New: func() any { return new(bpeScratch) }}
+
+// encode converts s into a slice of tokens, without checking for disallowed
+// special tokens. This is the implementation of Encode. If st is not nil, it
+// is updated with the requested instrumentation.
+//
+// If encoding stops early because of an error, the tokens for the input before
+// byte offset ofs are returned along with the error. If st has an emit
+// function, the tokens are passed to it instead, and none are returned.
+func (tt *BPETokenizer) encode(s string, st *encodeState) (encoded []int, ofs int, err error) {
+	var ex *explainer
+	var timing *gotoken.EncodeTiming
+	var emit func([]int) error
+	var start time.Time
+	if st != nil {
+		ex, timing, emit = st.ex, st.timing, st.emit
+	}
+	flushed := 0 // tokens passed to emit, which are no longer in encoded
+
+	// Borrow scratch buffers for the input and the splitter output. The spans
+	// are just offsets, so nothing outside sc is kept alive by the pool.
+	sc := scratchPool.Get().(*encodeScratch)
+	defer func() {
+		if cap(sc.input) <= maxPooledScratch {
+			scratchPool.Put(sc)
+		}
+	}()
+	sc.input = append(sc.input[:0], s...)
+	input := sc.input
+
+	// Return value (preallocate tokens based on bytesPerToken as a heuristic),
+	// or the buffer for the tokens of each piece passed to emit
+	if emit == nil {
+		encoded = make([]int, 0, len(input)/tt.bytesPerToken+1)
+	} else {
+		encoded = make([]int, 0, emitBufferSize)
+	}
 
 	// Loop until we've consumed all of the input
 	for len(input) > 0 {
 		// segment contains what to encode-- by default it's all of input
 		segment := input
-		var specialMatch []int
+		specialStart, specialEnd := -1, -1
 
-		if tt.specialTokenRegex != nil {
+		if tt.specialTokens != nil {
 			// If a special token is found, limit segment to just up until the
 			// special token. We'll BPE that []byte, encode the special token,
 			// and loop.
-			specialMatch = tt.specialTokenRegex.FindIndex(input)
-			if specialMatch != nil {
-				segment = input[:specialMatch[0]]
+			if timing != nil {
+				start = time.Now()
+			}
+			// input is the unconsumed tail of s, so search that
+			specialStart, specialEnd = tt.specialTokens.index(s[len(s)-len(input):])
+			if specialStart >= 0 {
+				segment = input[:specialStart]
+			}
+			if timing != nil {
+				timing.Special += time.Since(start)
 			}
 		}
 
 		// Split the segment into parts, and encode each part
-		parts := tt.params.Splitter(segment)
-		for _, part := range parts {
-			if len(part) <= 2 {
-				// handle one and two byte tokens using lookup tables
-				if len(part) == 1 {
-					// encode one byte directly to its token
-					encoded = append(encoded, int(tt.params.ByteEncoder[part[0]]))
-				} else if twoTok := tt.params.BytePairLookup[int(part[0])<<8|int(part[1])]; twoTok != -1 {
-					// len(part)==2: try to encode the byte pair using BytePairLookup
+		if st.cancelled() {
+			return encoded, ofs, st.ctx.Err()
+		}
+		if timing != nil {
+			start = time.Now()
+		}
+		spans := tt.params.Splitter(sc.spans[:0], segment)
+		if tt.maxPieceBytes > 0 {
+			spans = sliceLongSpans(spans, segment, tt.maxPieceBytes)
+		}
+		sc.spans = spans
+		if timing != nil {
+			timing.Split += time.Since(start)
+			start = time.Now()
+		}
+		var merging time.Duration
+		for i, span := range spans {
+			part := segment[span.Start:span.End]
+			n := len(encoded)
+			if i%cancelCheckInterval == cancelCheckInterval-1 && st.cancelled() {
+				return encoded, ofs, st.ctx.Err()
+			}
+			if len(part) == 1 {
+				// encode one byte directly to its token
+				encoded = append(encoded, int(tt.params.ByteEncoder[part[0]]))
+			} else if len(part) == 2 {
+				if twoTok := tt.params.BytePairLookup.Lookup(part[0], part[1]); twoTok != -1 {
+					// try to encode the byte pair as one token
 					encoded = append(encoded, twoTok)
 				} else {
-					// len(part)==2 && twoTok==-1: encode the individual bytes as tokens
+					// twoTok==-1: encode the individual bytes as tokens
 					encoded = append(encoded, int(tt.params.ByteEncoder[part[0]]), int(tt.params.ByteEncoder[part[1]]))
 				}
-				continue
-			}
-
-			// If the whole part is a token, just encode it directly.
-			if wholeTok := tt.params.EncoderTrie.Lookup(part); wholeTok != -1 {
+			} else if wholeTok := tt.lookup(part); wholeTok != -1 {
+				// If the whole part is a token, just encode it directly.
 				encoded = append(encoded, wholeTok)
-				continue
+			} else if timing == nil {
+				// Slower path: perform BPE on part and output returned tokens
+				encoded = tt.applyBPECached(encoded, part, st)
+			} else {
+				// Same, but with the time spent merging tracked separately
+				mergeStart := time.Now()
+				encoded = tt.applyBPECached(encoded, part, st)
+				merging += time.Since(mergeStart)
+			}
+			if len(encoded) == n {
+				// applyBPE gave up because the context was cancelled
+				return encoded, ofs, st.ctx.Err()
+			}
+			if ex != nil {
+				ex.addPiece(tt, part, false, encoded[n:])
+			}
+			if tt.maxTokens > 0 && flushed+len(encoded) > tt.maxTokens {
+				return encoded[:n], ofs, tt.tooManyTokens(flushed+len(encoded), ofs)
+			}
+			ofs += len(part)
+			if emit != nil {
+				if err := st.flush(encoded); err != nil {
+					return nil, ofs, err
+				}
+				flushed += len(encoded)
+				encoded = encoded[:0]
 			}
-
-			// Slower path: perform BPE on part and output returned tokens
-			encoded = append(encoded, tt.applyBPE(part)...)
+		}
+		if timing != nil {
+			timing.Merge += merging
+			timing.Lookup += time.Since(start) - merging
 		}
 
-		if specialMatch != nil {
+		if specialStart >= 0 {
 			// Was there a special token? If so, encode it and continue
-			foundToken := input[specialMatch[0]:specialMatch[1]]
-			if tokenNum, ok := tt.allowedSpecialTokens[string(foundToken)]; ok {
+			foundToken := input[specialStart:specialEnd]
+			n := len(encoded)
+			tokenNum, ok := tt.allowedSpecialTokens[string(foundToken)]
+			if ok {
 				// if approved, emit as special
 				encoded = append(encoded, tokenNum)
 			} else {
 				// otherwise, emit as text
-				encoded = append(encoded, tt.applyBPE(foundToken)...)
+				encoded = tt.applyBPE(encoded, foundToken, st)
+				if len(encoded) == n {
+					return encoded, ofs, st.ctx.Err()
+				}
+			}
+			if ex != nil {
+				ex.addPiece(tt, foundToken, ok, encoded[n:])
+			}
+			if tt.maxTokens > 0 && flushed+len(encoded) > tt.maxTokens {
+				return encoded[:n], ofs, tt.tooManyTokens(flushed+len(encoded), ofs)
+			}
+			ofs += len(foundToken)
+			if emit != nil {
+				if err := st.flush(encoded); err != nil {
+					return nil, ofs, err
+				}
+				flushed += len(encoded)
+				encoded = encoded[:0]
 			}
 			// Consume the segment we processed plus the special token, and loop
-			input = input[specialMatch[1]:]
+			input = input[specialEnd:]
 			continue
 		} else {
 			// If no special token, all input has now been consumed, so break
@@ -182,7 +1110,48 @@ func (tt *BPETokenizer) Encode(s string) ([]int, error) {
 		}
 	}
 
-	return encoded, nil
+	return encoded, ofs, nil
+}
+
+// sliceLongSpans returns spans with each span that is longer than max bytes
+// replaced by consecutive spans of at most max bytes, cut at the start of a
+// UTF-8 character where possible. Merging the bytes of a huge piece, like a
+// long run of punctuation, takes time and memory in proportion to its length,
+// so this bounds the cost of encoding one piece. spans is returned as is if
+// none are too long.
+func sliceLongSpans(spans []Span, input []byte, max int) []Span {
+	i := 0
+	for i < len(spans) && spans[i].End-spans[i].Start <= max {
+		i++
+	}
+	if i == len(spans) {
+		return spans
+	}
+	ret := append(make([]Span, 0, len(spans)+len(input)/max), spans[:i]...)
+	for _, span := range spans[i:] {
+		for span.End-span.Start > max {
+			cut := span.Start + max
+			if !utf8.RuneStart(input[cut]) {
+				// Back up to the start of the character, if there is one
+				for back := 1; back < utf8.UTFMax && cut-back > span.Start; back++ {
+					if utf8.RuneStart(input[cut-back]) {
+						cut -= back
+						break
+					}
+				}
+			}
+			ret = append(ret, Span{span.Start, cut})
+			span.Start = cut
+		}
+		ret = append(ret, span)
+	}
+	return ret
+}
+
+// tooManyTokens returns the error for an input that exceeded maxTokens, after
+// count tokens were produced by encoding up to byte offset ofs.
+func (tt *BPETokenizer) tooManyTokens(count, ofs int) error {
+	return &gotoken.TooManyTokensError{Max: tt.maxTokens, Count: count, Offset: ofs}
 }
 
 // Allowed performs the special token safety check on an input string according
@@ -191,101 +1160,728 @@ func (tt *BPETokenizer) Encode(s string) ([]int, error) {
 // that has not been not explicitly allowed.
 //
 // If a Tokenizer instance was created with the [gotoken.AllowSpecialAsText]
-// option, this method will always return no error (nil).
+// option, this method returns no error (nil), unless the input is larger than
+// allowed by [gotoken.WithMaxInputBytes], which is checked first.
 func (tt *BPETokenizer) Allowed(input string) error {
-	if tt.disallowSpecialTokens && tt.specialTokenRegex != nil {
-		matches := tt.specialTokenRegex.FindAllString(input, -1)
-		for _, match := range matches {
-			if _, ok := tt.allowedSpecialTokens[match]; !ok {
-				return fmt.Errorf("%w: %q", gotoken.ErrSpecialToken, match)
+	if err := tt.checkInputSize(input); err != nil {
+		return err
+	}
+	if _, match := tt.findDisallowed(input); match != "" {
+		return fmt.Errorf("%w: %q", gotoken.ErrSpecialToken, match)
+	}
+	return nil
+}
+
+// checkInputSize returns a [*gotoken.InputTooLargeError] if input is larger
+// than the limit set with [gotoken.WithMaxInputBytes].
+func (tt *BPETokenizer) checkInputSize(input string) error {
+	if tt.maxInputBytes > 0 && len(input) > tt.maxInputBytes {
+		return &gotoken.InputTooLargeError{Max: tt.maxInputBytes, Size: len(input)}
+	}
+	return nil
+}
+
+// findDisallowed returns the byte offset and text of the first special token in
+// input that is not allowed by this tokenizer's configuration, or (-1, "") if
+// there is none.
+func (tt *BPETokenizer) findDisallowed(input string) (int, string) {
+	if tt.disallowSpecialTokens && tt.specialTokens != nil {
+		for ofs := 0; ofs < len(input); {
+			start, end := tt.specialTokens.index(input[ofs:])
+			if start < 0 {
+				break
+			}
+			found := input[ofs+start : ofs+end]
+			_, ok := tt.allowedSpecialTokens[found]
+			if !ok && (tt.disallowedSpecial == nil || tt.disallowedSpecial[found]) {
+				return ofs + start, found
 			}
+			ofs += end
 		}
 	}
-	return nil
+	return -1, ""
 }
 
 // Decode converts a slice of ints (tokens) into a string. It may return an
 // error that wraps [gotoken.ErrInvalidToken] if any of the provided tokens are
-// not valid in this encoding.
+// not valid in this encoding. If the tokenizer was created with
+// [gotoken.WithMaxDecodeBytes], a [*gotoken.DecodeTooLargeError] is returned
+// as soon as the output would exceed the limit. If it was created with
+// [gotoken.WithStrictUTF8], a [*gotoken.InvalidUTF8Error] is returned if the
+// output is not valid UTF-8.
+//
+// Decode, Decode32, AppendDecode, EncodeWithOffsets, EncodeSuffix, and
+// HealPrompt need the strings of the tokens, so in count-only builds they
+// return an error that wraps [gotoken.ErrCountOnly].
 func (tt *BPETokenizer) Decode(tokens []int) (string, error) {
+	start := tt.callStart()
+	ret, err := tt.decode(tokens)
+	if err != nil {
+		tt.reportCall(nil, "decode", 0, 0, start, err)
+		return "", err
+	}
+	tt.reportCall(nil, "decode", len(ret), len(tokens), start, nil)
+	return ret, nil
+}
+
+// DecodeToken is like Decode for a single token, but returns the token's
+// string from the vocabulary itself, which is shared rather than allocated
+// anew on each call. This is for callers that decode every token separately,
+// like visualizers. Errors are the same as for Decode.
+func (tt *BPETokenizer) DecodeToken(token int) (string, error) {
+	start := tt.callStart()
+	ret, err := tt.decodeToken(token)
+	if err != nil {
+		tt.reportCall(nil, "decode", 0, 0, start, err)
+		return "", err
+	}
+	tt.reportCall(nil, "decode", len(ret), 1, start, nil)
+	return ret, nil
+}
+
+// decodeToken is the implementation of DecodeToken, and of Decode and Decode32
+// for a single token, which need no buffer.
+func (tt *BPETokenizer) decodeToken(token int) (string, error) {
+	if err := tt.needTokenList(); err != nil {
+		return "", err
+	}
+	str, err := tt.tokenString(token)
+	if err != nil {
+		return "", err
+	}
+	if err := tt.checkDecodeSize(len(str), 0); err != nil {
+		return "", err
+	}
+	if err := tt.checkUTF8(str, func(int) int { return token }); err != nil {
+		return "", err
+	}
+	return str, nil
+}
+
+// DecodeEach returns the string for each of tokens, like calling DecodeToken
+// for each, but in one call. The strings are shared with the vocabulary. A
+// token can be part of a multi-byte character, so its string is not always
+// valid UTF-8 on its own, even with [gotoken.WithStrictUTF8]; the limits of the
+// tokenizer apply to the text of all the tokens together, as for Decode.
+func (tt *BPETokenizer) DecodeEach(tokens []int) ([]string, error) {
+	start := tt.callStart()
+	ret, size, err := tt.decodeEach(tokens)
+	if err != nil {
+		tt.reportCall(nil, "decode", 0, 0, start, err)
+		return nil, err
+	}
+	tt.reportCall(nil, "decode", size, len(tokens), start, nil)
+	return ret, nil
+}
+
+// decodeEach is the implementation of DecodeEach. It also returns the total
+// length of the strings.
+func (tt *BPETokenizer) decodeEach(tokens []int) ([]string, int, error) {
+	if err := tt.needTokenList(); err != nil {
+		return nil, 0, err
+	}
+	ret := make([]string, len(tokens))
+	size := 0
+	for i, token := range tokens {
+		str, err := tt.tokenString(token)
+		if err != nil {
+			return nil, 0, err
+		}
+		size += len(str)
+		if err := tt.checkDecodeSize(size, i); err != nil {
+			return nil, 0, err
+		}
+		ret[i] = str
+	}
+	if tt.strictUTF8 {
+		if err := tt.checkUTF8(strings.Join(ret, ""), func(i int) int { return tokens[i] }); err != nil {
+			return nil, 0, err
+		}
+	}
+	return ret, size, nil
+}
+
+// decode is the implementation of Decode and Decode32.
+func (tt *BPETokenizer) decode(tokens []int) (string, error) {
+	if len(tokens) == 1 {
+		return tt.decodeToken(tokens[0])
+	}
+	if err := tt.needTokenList(); err != nil {
+		return "", err
+	}
 	var ret strings.Builder
+	for i, token := range tokens {
+		str, err := tt.tokenString(token)
+		if err != nil {
+			return "", err
+		}
+		if err := tt.checkDecodeSize(ret.Len()+len(str), i); err != nil {
+			return "", err
+		}
+		ret.WriteString(str)
+	}
+	if err := tt.checkUTF8(ret.String(), func(i int) int { return tokens[i] }); err != nil {
+		return "", err
+	}
+	return ret.String(), nil
+}
+
+// Decode32 is like Decode, but for tokens returned by Encode32.
+func (tt *BPETokenizer) Decode32(tokens []uint32) (string, error) {
+	start := tt.callStart()
+	ret, err := tt.decode32(tokens)
+	if err != nil {
+		tt.reportCall(nil, "decode", 0, 0, start, err)
+		return "", err
+	}
+	tt.reportCall(nil, "decode", len(ret), len(tokens), start, nil)
+	return ret, nil
+}
+
+// decode32 is the implementation of Decode32. It widens the tokens to ints
+// for decode, which costs an allocation, but keeps Decode's loop free of an
+// indirect call per token.
+func (tt *BPETokenizer) decode32(tokens []uint32) (string, error) {
+	if len(tokens) == 1 {
+		return tt.decodeToken(int(tokens[0]))
+	}
+	ints := make([]int, len(tokens))
+	for i, token := range tokens {
+		ints[i] = int(token)
+	}
+	return tt.decode(ints)
+}
+
+// AppendDecode is like Decode, but appends the decoded bytes to dst and returns
+// the extended buffer, so that a caller decoding in a loop can reuse one
+// buffer. On error, dst is returned unchanged.
+func (tt *BPETokenizer) AppendDecode(dst []byte, tokens []int) ([]byte, error) {
+	start := tt.callStart()
+	ret, err := tt.appendDecode(dst, tokens)
+	if err != nil {
+		tt.reportCall(nil, "decode", 0, 0, start, err)
+		return dst, err
+	}
+	tt.reportCall(nil, "decode", len(ret)-len(dst), len(tokens), start, nil)
+	return ret, nil
+}
+
+// appendDecode is the implementation of AppendDecode.
+func (tt *BPETokenizer) appendDecode(dst []byte, tokens []int) ([]byte, error) {
+	if err := tt.needTokenList(); err != nil {
+		return dst, err
+	}
+	ret := dst
+	for i, token := range tokens {
+		str, err := tt.tokenString(token)
+		if err != nil {
+			return dst, err
+		}
+		if err := tt.checkDecodeSize(len(ret)-len(dst)+len(str), i); err != nil {
+			return dst, err
+		}
+		ret = append(ret, str...)
+	}
+	return ret, nil
+}
+
+// decodeBufferSize is the size in bytes of the buffer that DecodeTo fills
+// before each write.
+const decodeBufferSize = 32 << 10
+
+// DecodeTo is like Decode, but writes the decoded text to w as it goes,
+// instead of returning it, so that the text of any number of tokens can be
+// decoded in bounded memory. The text is written in pieces of about 32 KB.
+//
+// Every token is checked, and so is the limit of [gotoken.WithMaxDecodeBytes],
+// before anything is written, so if they fail, nothing is. With
+// [gotoken.WithStrictUTF8], the text is checked as it is written, and the text
+// before the invalid bytes has already been written when the error is
+// returned. An error from w is returned as is.
+func (tt *BPETokenizer) DecodeTo(w io.Writer, tokens []int) error {
+	start := tt.callStart()
+	size, err := tt.decodeTo(w, tokens, decodeBufferSize)
+	if err != nil {
+		tt.reportCall(nil, "decode", 0, 0, start, err)
+		return err
+	}
+	tt.reportCall(nil, "decode", size, len(tokens), start, nil)
+	return nil
+}
 
-	maxToken := len(tt.params.DecoderMap) - 1
-	for _, token := range tokens {
-		if token < 0 || token > maxToken {
-			spc, ok := tt.decodeSpecialTokens[token]
-			if !ok {
-				return "", fmt.Errorf("%w: %d", gotoken.ErrInvalidToken, token)
+// decodeTo is the implementation of DecodeTo, with a buffer of bufSize bytes.
+// It returns the number of bytes written.
+func (tt *BPETokenizer) decodeTo(w io.Writer, tokens []int, bufSize int) (int, error) {
+	if err := tt.needTokenList(); err != nil {
+		return 0, err
+	}
+	size := 0
+	for i, token := range tokens {
+		str, err := tt.tokenString(token)
+		if err != nil {
+			return 0, err
+		}
+		size += len(str)
+		if err := tt.checkDecodeSize(size, i); err != nil {
+			return 0, err
+		}
+	}
+
+	buf := make([]byte, 0, bufSize)
+	written := 0
+	for i, token := range tokens {
+		str, _ := tt.tokenString(token)
+		buf = append(buf, str...)
+		if len(buf) < bufSize && i < len(tokens)-1 {
+			continue
+		}
+		n := len(buf)
+		if tt.strictUTF8 {
+			if i < len(tokens)-1 {
+				// Hold back a character that the next token may complete
+				n = completeRunes(buf)
+			}
+			if !utf8.Valid(buf[:n]) {
+				return written, tt.utf8Error(string(buf[:n]), written, func(i int) int { return tokens[i] })
 			}
-			ret.WriteString(spc)
+		}
+		if n == 0 {
 			continue
 		}
-		ret.WriteString(tt.params.DecoderMap[token])
+		if _, err := w.Write(buf[:n]); err != nil {
+			return written, err
+		}
+		written += n
+		buf = buf[:copy(buf, buf[n:])]
 	}
-	return ret.String(), nil
+	return written, nil
+}
+
+// completeRunes returns the length of the longest prefix of b that does not
+// end in an incomplete UTF-8 character.
+func completeRunes(b []byte) int {
+	for i := len(b) - 1; i >= 0 && i >= len(b)-utf8.UTFMax; i-- {
+		if utf8.RuneStart(b[i]) {
+			if !utf8.FullRune(b[i:]) {
+				return i
+			}
+			break
+		}
+	}
+	return len(b)
+}
+
+// checkUTF8 returns a [*gotoken.InvalidUTF8Error] if the tokenizer was created
+// with [gotoken.WithStrictUTF8] and s, the output of a decode, is not valid
+// UTF-8. token returns the i'th token of the input, which are all valid.
+func (tt *BPETokenizer) checkUTF8(s string, token func(i int) int) error {
+	if !tt.strictUTF8 || utf8.ValidString(s) {
+		return nil
+	}
+	return tt.utf8Error(s, 0, token)
+}
+
+// utf8Error returns the [*gotoken.InvalidUTF8Error] for s, which is not valid
+// UTF-8, and is the part of the output of a decode that starts at byte base.
+// token returns the i'th token of the input, which are all valid.
+func (tt *BPETokenizer) utf8Error(s string, base int, token func(i int) int) error {
+	i := 0
+	for {
+		r, size := utf8.DecodeRuneInString(s[i:])
+		if r == utf8.RuneError && size == 1 {
+			break
+		}
+		i += size
+	}
+	offset := base + i
+	// Find the token that contains the invalid byte
+	index := 0
+	for end := 0; ; index++ {
+		str, _ := tt.tokenString(token(index))
+		if end += len(str); end > offset {
+			break
+		}
+	}
+	return &gotoken.InvalidUTF8Error{Offset: offset, Index: index}
+}
+
+// checkDecodeSize returns a [*gotoken.DecodeTooLargeError] if size, the
+// length of the output of a decode including tokens[index], is larger than the
+// limit set with [gotoken.WithMaxDecodeBytes].
+func (tt *BPETokenizer) checkDecodeSize(size, index int) error {
+	if tt.maxDecodeBytes > 0 && size > tt.maxDecodeBytes {
+		return &gotoken.DecodeTooLargeError{Max: tt.maxDecodeBytes, Index: index}
+	}
+	return nil
+}
+
+// tokenString returns the string for token, or an error that wraps
+// [gotoken.ErrInvalidToken].
+func (tt *BPETokenizer) tokenString(token int) (string, error) {
+	if token >= 0 && token < tt.params.DecoderMap.Len() {
+		if str := tt.params.DecoderMap.Get(token); str != "" {
+			return str, nil
+		}
+	}
+	if spc, ok := tt.decodeSpecialTokens[token]; ok {
+		return spc, nil
+	}
+	return "", fmt.Errorf("%w: %d", gotoken.ErrInvalidToken, token)
 }
 
 // Count returns the number of tokens in an input string, without returning the
 // actual tokens. It returns 0 if the input string is empty, or if the input
 // cannot be encoded.
 func (tt *BPETokenizer) Count(input string) int {
-	tokens, err := tt.Encode(input)
+	start := tt.callStart()
+	tokens, err := tt.encodeTimed(nil, input)
 	if err != nil {
+		tt.reportCall(nil, "count", len(input), 0, start, err)
 		return 0
 	}
+	tt.reportCall(nil, "count", len(input), len(tokens), start, nil)
 	return len(tokens)
 }
 
-// tokenInfo tracks information about a token in the BPE algorithm.
+// CountCtx returns the number of tokens in an input string, stopping early if
+// ctx is cancelled or its deadline passes. Unlike Count, it returns the error
+// if the input cannot be encoded.
+func (tt *BPETokenizer) CountCtx(ctx context.Context, input string) (int, error) {
+	start := tt.callStart()
+	tokens, err := tt.encodeCtx(ctx, input)
+	if err != nil {
+		tt.reportCall(ctx, "count", len(input), 0, start, err)
+		return 0, err
+	}
+	tt.reportCall(ctx, "count", len(input), len(tokens), start, nil)
+	return len(tokens), nil
+}
+
+// CountReader returns the number of tokens in the text read from r, like
+// Count, but reads the text in chunks like EncodeReader, so that a large
+// upload can be counted without holding all of it in memory. Unlike Count, it
+// returns an error if the text cannot be encoded, or if reading r fails; the
+// count is then 0.
+func (tt *BPETokenizer) CountReader(r io.Reader) (int64, error) {
+	start := tt.callStart()
+	var count int64
+	bytes, _, err := tt.encodeReader(r, func(tokens []int) error {
+		count += int64(len(tokens))
+		return nil
+	}, readChunkSize)
+	if err != nil {
+		tt.reportCall(nil, "count", bytes, 0, start, err)
+		return 0, err
+	}
+	tt.reportCall(nil, "count", bytes, int(count), start, nil)
+	return count, nil
+}
+
+// TokensWithPrefix returns the tokens in this encoding's vocabulary whose byte
+// representation starts with prefix, in ascending order. This is useful for
+// building logit bias maps or for constrained decoding, e.g. to find every token
+// that begins with " yes". Special tokens are not included. An empty prefix
+// returns the entire vocabulary.
+func (tt *BPETokenizer) TokensWithPrefix(prefix string) []int {
+	return tt.params.EncoderTrie.TokensWithPrefix([]byte(prefix))
+}
+
+// PrefixTokens returns the tokens in this encoding's vocabulary whose byte
+// representation is a prefix of input, from shortest to longest. This is the
+// reverse of TokensWithPrefix: for "https", it returns the tokens for "h",
+// "http", and "https", if they exist. Special tokens are not included.
+func (tt *BPETokenizer) PrefixTokens(input string) []int {
+	return tt.params.EncoderTrie.Prefixes([]byte(input))
+}
+
+// LongestPrefixToken returns the longest token in this encoding's vocabulary
+// whose byte representation is a prefix of input, and its length in bytes. It
+// returns -1, 0 if there is none, which can only happen for an empty input.
+// Special tokens are not included.
+func (tt *BPETokenizer) LongestPrefixToken(input string) (int, int) {
+	return tt.params.EncoderTrie.LongestPrefix([]byte(input))
+}
+
+// NewTrieWalker returns a walker at the root of this encoding's vocabulary
+// trie. See [gotoken.TrieWalker].
+func (tt *BPETokenizer) NewTrieWalker() gotoken.TrieWalker {
+	return newTrieWalker(tt.params.EncoderTrie)
+}
+
+// VocabIter returns an iterator over every token in this encoding's
+// vocabulary, yielding each token ID and its bytes in rank order. Special tokens
+// are included after the regular vocabulary. The returned function has the
+// same type as iter.Seq2[int, []byte], and can be used with range-over-func in
+// Go 1.23 and later.
+//
+// To avoid allocating, the []byte passed to yield is reused between tokens and
+// is only valid until yield returns. In count-only builds, only the special
+// tokens are yielded.
+func (tt *BPETokenizer) VocabIter() func(yield func(int, []byte) bool) {
+	return func(yield func(int, []byte) bool) {
+		var buf []byte
+		for token := 0; token < tt.params.DecoderMap.Len(); token++ {
+			str := tt.params.DecoderMap.Get(token)
+			if str == "" {
+				continue // unused rank
+			}
+			buf = append(buf[:0], str...)
+			if !yield(token, buf) {
+				return
+			}
+		}
+
+		specials := make([]int, 0, len(tt.decodeSpecialTokens))
+		for token := range tt.decodeSpecialTokens {
+			specials = append(specials, token)
+		}
+		sort.Ints(specials)
+		for _, token := range specials {
+			buf = append(buf[:0], tt.decodeSpecialTokens[token]...)
+			if !yield(token, buf) {
+				return
+			}
+		}
+	}
+}
+
+// Name returns the name of this tokenizer's encoding, like "cl100k_base".
+func (tt *BPETokenizer) Name() string {
+	return tt.params.Name
+}
+
+// DataInfo returns where this encoding's data came from, or the zero DataInfo
+// if that is unknown.
+func (tt *BPETokenizer) DataInfo() gotoken.DataInfo {
+	return tt.params.DataInfo
+}
+
+// VocabSize returns one more than the highest token ID in this encoding,
+// including special tokens. Some ranks below that may be unused.
+func (tt *BPETokenizer) VocabSize() int {
+	size := tt.params.DecoderMap.Len()
+	for _, token := range tt.params.SpecialTokens {
+		if token >= size {
+			size = token + 1
+		}
+	}
+	return size
+}
+
+// MaxToken returns the highest token ID in this encoding, including special
+// tokens. Not every ID up to MaxToken is valid; see IsValidToken.
+func (tt *BPETokenizer) MaxToken() int {
+	return tt.VocabSize() - 1
+}
+
+// IsValidToken reports whether token is a token ID in this encoding, either in
+// the regular vocabulary or a special token. Unused IDs, like cl100k_base's
+// 100261-100263, are not valid. Every token in a slice for which IsValidToken
+// returns true can be decoded. In count-only builds, only special tokens are
+// reported as valid, since there is no vocabulary to decode the others with.
+func (tt *BPETokenizer) IsValidToken(token int) bool {
+	// A special token can fill a gap in the vocabulary, like p50k_base's
+	// <|endoftext|>
+	if token >= 0 && token < tt.params.DecoderMap.Len() && tt.params.DecoderMap.Get(token) != "" {
+		return true
+	}
+	return tt.IsSpecialToken(token)
+}
+
+// ByteToken returns the token that encodes the single byte b. Every byte has
+// one, and together they are the starting point for byte-pair encoding.
+func (tt *BPETokenizer) ByteToken(b byte) int {
+	return int(tt.params.ByteEncoder[b])
+}
+
+// TokenByte is the inverse of ByteToken: it returns the byte encoded by token,
+// and whether token is one of the 256 single-byte tokens.
+func (tt *BPETokenizer) TokenByte(token int) (byte, bool) {
+	if token < 0 || token >= len(tt.byteDecoder) || tt.byteDecoder[token] < 0 {
+		return 0, false
+	}
+	return byte(tt.byteDecoder[token]), true
+}
+
+// IsSpecialToken reports whether token is one of the special tokens defined by
+// this encoding, like "<|endoftext|>", whether or not it is allowed in the
+// input to Encode.
+func (tt *BPETokenizer) IsSpecialToken(token int) bool {
+	_, ok := tt.decodeSpecialTokens[token]
+	return ok
+}
+
+// SpecialTokenID returns the token ID of the special token with the given text,
+// like "<|im_start|>", and whether this encoding defines it. The ID is returned
+// whether or not the token is allowed in the input to Encode.
+func (tt *BPETokenizer) SpecialTokenID(name string) (int, bool) {
+	token, ok := tt.params.SpecialTokens[name]
+	return token, ok
+}
+
+// EOTToken returns the token ID of the "<|endoftext|>" special token, and
+// whether this encoding defines one. The token is returned whether or not it is
+// allowed in the input to Encode, since it is commonly appended to already
+// encoded text, e.g. to separate documents in training data.
+func (tt *BPETokenizer) EOTToken() (int, bool) {
+	return tt.SpecialTokenID(endOfText)
+}
+
+// HealPrompt encodes prompt and removes its final token, returning the removed
+// text and the tokens that begin with it. If the prompt is empty or ends with a
+// special token, nothing is removed. See [gotoken.HealedPrompt].
+func (tt *BPETokenizer) HealPrompt(prompt string) (gotoken.HealedPrompt, error) {
+	if err := tt.needTokenList(); err != nil {
+		return gotoken.HealedPrompt{}, err
+	}
+	tokens, err := tt.Encode(prompt)
+	if err != nil {
+		return gotoken.HealedPrompt{}, err
+	}
+	if len(tokens) == 0 {
+		return gotoken.HealedPrompt{Tokens: tokens}, nil
+	}
+	last := tokens[len(tokens)-1]
+	if tt.IsSpecialToken(last) {
+		// special tokens are never partial; their IDs can also fall in a gap
+		// in the decoder list, as p50k_base's <|endoftext|> does
+		return gotoken.HealedPrompt{Tokens: tokens}, nil
+	}
+
+	removed := tt.params.DecoderMap.Get(last)
+	return gotoken.HealedPrompt{
+		Tokens:     tokens[:len(tokens)-1],
+		Removed:    removed,
+		Candidates: tt.TokensWithPrefix(removed),
+	}, nil
+}
+
+// tokenInfo tracks information about a token in the BPE algorithm. Its fields
+// are int32s, which halves the scratch memory for a piece, because every token
+// ID fits, and pieces are sliced far below 2 GiB by default.
 type tokenInfo struct {
-	token    int
-	start    int
-	length   int
-	prevIdx  int
-	nextIdx  int
-	thisPair int
+	token    int32
+	start    int32
+	length   int32
+	prevIdx  int32
+	nextIdx  int32
+	thisPair int32
+}
+
+// lookup returns the token for a whole piece of input, or -1 if it is not a
+// token. This uses the MPH if the encoding has one, since it is faster than the
+// trie for strings that are usually found. The trie remains faster for the
+// short, usually missing pairs looked up during merges.
+func (tt *BPETokenizer) lookup(part []byte) int {
+	if tt.params.EncoderMPH != nil {
+		return tt.params.EncoderMPH.Lookup(part)
+	}
+	return tt.params.EncoderTrie.Lookup(part)
+}
+
+// applyBPECached is applyBPE, using the piece cache if it is enabled. The
+// cache is bypassed for Explain, which needs the individual merges.
+func (tt *BPETokenizer) applyBPECached(dst []int, input []byte, st *encodeState) []int {
+	if tt.cache == nil || len(input) > maxCachedPieceLen || st != nil && st.ex != nil {
+		return tt.applyBPE(dst, input, st)
+	}
+	if dst, ok := tt.cache.get(dst, input); ok {
+		return dst
+	}
+	n := len(dst)
+	dst = tt.applyBPE(dst, input, st)
+	if len(dst) > n {
+		tt.cache.add(input, dst[n:])
+	}
+	return dst
 }
 
 // applyBPE applies the BPE algorithm to the given input string, and returns the
 // resulting []int. This is intended to be run on a substring that has already
 // been split out of the input string. This method is not exposed via the
-// Tokenizer interface and is for internal use by gotoken.
-func (tt *BPETokenizer) applyBPE(input []byte) []int {
+// Tokenizer interface and is for internal use by gotoken. The tokens are
+// appended to dst, and the extended slice is returned. If st has an explainer,
+// each merge performed is recorded in it. If st has a context that is
+// cancelled, applyBPE gives up and returns dst unchanged.
+//
+// The input starts as a doubly linked list of one token per byte. Repeatedly,
+// the adjacent pair with the lowest merged rank is merged (the leftmost one, if
+// there is a tie), and only the pairs on either side of the merge are looked up
+// again. Short inputs find the lowest-ranked pair by scanning the list, which
+// is fastest in practice. Longer inputs keep the pairs in a min-heap instead,
+// so that pathological inputs, like long runs of digits or punctuation, take
+// O(n log n) time instead of O(n^2).
+func (tt *BPETokenizer) applyBPE(dst []int, input []byte, st *encodeState) []int {
 	// early exit when encoding empty input
 	count := len(input)
 	if count == 0 {
-		return nil
+		return dst
 	}
 
-	// set up a doubly linked list of tokens
-	tokens := make([]tokenInfo, count)
+	// set up a doubly linked list of tokens, in a pooled buffer
+	buf := bpeScratchPool.Get().(*bpeScratch)
+	defer bpeScratchPool.Put(buf)
+	if cap(buf.tokens) < count {
+		buf.tokens = make([]tokenInfo, count)
+	}
+	tokens := buf.tokens[:count]
 	for i, b := range input {
 		tokens[i] = tokenInfo{
-			token:   int(tt.params.ByteEncoder[b]),
-			start:   i,
+			token:   int32(tt.params.ByteEncoder[b]),
+			start:   int32(i),
 			length:  1,
-			prevIdx: i - 1,
-			nextIdx: i + 1,
+			prevIdx: int32(i - 1),
+			nextIdx: int32(i + 1),
 		}
 	}
 	tokens[count-1].nextIdx = -1
 
 	// and populate the initial token pairings
-	pairTokens := make([]int, len(input)-1)
-	for i := 0; i < len(pairTokens); i++ {
-		tokens[i].thisPair = tt.params.BytePairLookup[int(input[i])<<8|int(input[i+1])]
+	for i := 0; i < count-1; i++ {
+		tokens[i].thisPair = int32(tt.params.BytePairLookup.Lookup(input[i], input[i+1]))
 	}
 	tokens[count-1].thisPair = -1
 
-	// main tokenization loop
-	var mergeIdx int
-	trie := tt.params.EncoderTrie
-	for {
-		minTokenRank := higherThanAnyToken
-		mergeIdx = -1
+	var ok bool
+	if count < heapMergeThreshold {
+		ok = tt.mergeByScan(tokens, input, st)
+	} else {
+		ok = tt.mergeByHeap(tokens, input, st, &buf.heap)
+	}
+	if !ok {
+		return dst
+	}
+
+	for idx := int32(0); idx != -1; idx = tokens[idx].nextIdx {
+		dst = append(dst, int(tokens[idx].token))
+	}
+	return dst
+}
+
+// heapMergeThreshold is the input length in bytes at which applyBPE switches
+// from scanning for the lowest-ranked pair to keeping the pairs in a heap. It
+// is a variable so tests can compare both methods.
+var heapMergeThreshold = 128
 
-		// find the lowest-ranked pair in pairTokens
-		i := 0
+// bpeScratch holds buffers for applyBPE, reused through bpeScratchPool.
+type bpeScratch struct {
+	tokens []tokenInfo
+	heap   pairHeap
+}
+
+// mergeByScan performs all of the merges for applyBPE, finding each
+// lowest-ranked pair with a linear scan of the list. It returns false if st's
+// context was cancelled.
+func (tt *BPETokenizer) mergeByScan(tokens []tokenInfo, input []byte, st *encodeState) bool {
+	for iter := 1; ; iter++ {
+		if iter%cancelCheckInterval == 0 && st.cancelled() {
+			return false
+		}
+		minTokenRank := int32(higherThanAnyToken)
+		mergeIdx := int32(-1)
+
+		// find the lowest-ranked pair
+		i := int32(0)
 		for {
 			nextIdx := tokens[i].nextIdx
 			if nextIdx == -1 {
@@ -301,39 +1897,138 @@ func (tt *BPETokenizer) applyBPE(input []byte) []int {
 
 		// no pairs left to merge, exit loop
 		if mergeIdx == -1 {
-			break
+			return true
 		}
+		tt.merge(tokens, input, mergeIdx, st)
+	}
+}
 
-		// perform the merge and update our data structures
-		nextIdx := tokens[mergeIdx].nextIdx
-		tokens[mergeIdx].token = minTokenRank
-		tokens[mergeIdx].length += tokens[nextIdx].length
+// mergeByHeap performs all of the merges for applyBPE, like mergeByScan, but
+// finds each lowest-ranked pair with a min-heap ordered by rank and then by
+// position. Entries are not removed from the heap when a merge changes or
+// removes their pair; instead, stale entries are skipped when they are popped.
+func (tt *BPETokenizer) mergeByHeap(tokens []tokenInfo, input []byte, st *encodeState, h *pairHeap) bool {
+	*h = (*h)[:0]
+	for i := range tokens {
+		if tokens[i].thisPair != -1 {
+			h.push(pairEntry{rank: tokens[i].thisPair, idx: int32(i)})
+		}
+	}
 
-		// remove the deleted token from the linked list
-		tokens[mergeIdx].nextIdx = tokens[nextIdx].nextIdx
-		nextIdx = tokens[mergeIdx].nextIdx
-		if nextIdx != -1 {
-			tokens[nextIdx].prevIdx = mergeIdx
+	for iter := 1; len(*h) > 0; iter++ {
+		if iter%cancelCheckInterval == 0 && st.cancelled() {
+			return false
+		}
+		e := h.pop()
+		if tokens[e.idx].thisPair != e.rank {
+			// stale: the pair starting at idx has changed since this entry
+			// was pushed, or the token at idx was merged into its neighbor
+			continue
 		}
-		count--
 
-		// update thisPair values reflect the new possible pairs created by the merge
-		prevIdx := tokens[mergeIdx].prevIdx
-		if prevIdx != -1 {
-			tokens[prevIdx].thisPair = trie.Lookup(input[tokens[prevIdx].start : tokens[prevIdx].start+tokens[prevIdx].length+tokens[mergeIdx].length])
+		prevIdx, mergeIdx := tt.merge(tokens, input, e.idx, st)
+		if prevIdx != -1 && tokens[prevIdx].thisPair != -1 {
+			h.push(pairEntry{rank: tokens[prevIdx].thisPair, idx: prevIdx})
 		}
-		if nextIdx != -1 {
-			tokens[mergeIdx].thisPair = trie.Lookup(input[tokens[mergeIdx].start : tokens[mergeIdx].start+tokens[mergeIdx].length+tokens[nextIdx].length])
+		if tokens[mergeIdx].thisPair != -1 {
+			h.push(pairEntry{rank: tokens[mergeIdx].thisPair, idx: mergeIdx})
 		}
 	}
+	return true
+}
 
-	ret := make([]int, count)
-	dest := 0
-	idx := 0
-	for idx != -1 {
-		ret[dest] = tokens[idx].token
-		idx = tokens[idx].nextIdx
-		dest++
+// merge combines the token at mergeIdx with the token following it, which is
+// removed from the list, and then looks up the pairs on either side of the
+// merged token. It returns the indexes of the tokens whose pairs changed.
+func (tt *BPETokenizer) merge(tokens []tokenInfo, input []byte, mergeIdx int32, st *encodeState) (int32, int32) {
+	nextIdx := tokens[mergeIdx].nextIdx
+	rank := tokens[mergeIdx].thisPair
+	if st != nil && st.ex != nil {
+		st.ex.merges = append(st.ex.merges, gotoken.BPEMerge{
+			Offset: int(tokens[mergeIdx].start),
+			Left:   int(tokens[mergeIdx].token),
+			Right:  int(tokens[nextIdx].token),
+			Result: int(rank),
+		})
 	}
-	return ret
+	tokens[mergeIdx].token = rank
+	tokens[mergeIdx].length += tokens[nextIdx].length
+
+	// remove the deleted token from the linked list
+	tokens[nextIdx].thisPair = -1
+	tokens[mergeIdx].nextIdx = tokens[nextIdx].nextIdx
+	nextIdx = tokens[mergeIdx].nextIdx
+	if nextIdx != -1 {
+		tokens[nextIdx].prevIdx = mergeIdx
+	}
+
+	// update thisPair values to reflect the new possible pairs created by the
+	// merge
+	trie := tt.params.EncoderTrie
+	prevIdx := tokens[mergeIdx].prevIdx
+	if prevIdx != -1 {
+		tokens[prevIdx].thisPair = int32(trie.Lookup(input[tokens[prevIdx].start : tokens[prevIdx].start+tokens[prevIdx].length+tokens[mergeIdx].length]))
+	}
+	if nextIdx != -1 {
+		tokens[mergeIdx].thisPair = int32(trie.Lookup(input[tokens[mergeIdx].start : tokens[mergeIdx].start+tokens[mergeIdx].length+tokens[nextIdx].length]))
+	} else {
+		tokens[mergeIdx].thisPair = -1
+	}
+	return prevIdx, mergeIdx
+}
+
+// pairEntry is an entry in a pairHeap: the pair starting at tokens[idx], which
+// merges to rank.
+type pairEntry struct {
+	rank int32
+	idx  int32
+}
+
+// pairHeap is a binary min-heap of pairEntry, ordered by rank and then by idx.
+// Ordering by idx makes the leftmost pair win ties, since token indexes
+// increase from left to right.
+type pairHeap []pairEntry
+
+func (e pairEntry) less(o pairEntry) bool {
+	return e.rank < o.rank || e.rank == o.rank && e.idx < o.idx
+}
+
+func (h *pairHeap) push(e pairEntry) {
+	*h = append(*h, e)
+	s := *h
+	i := len(s) - 1
+	for i > 0 {
+		parent := (i - 1) / 2
+		if !s[i].less(s[parent]) {
+			break
+		}
+		s[i], s[parent] = s[parent], s[i]
+		i = parent
+	}
+}
+
+func (h *pairHeap) pop() pairEntry {
+	s := *h
+	top := s[0]
+	last := len(s) - 1
+	s[0] = s[last]
+	s = s[:last]
+	i := 0
+	for {
+		left := 2*i + 1
+		if left >= len(s) {
+			break
+		}
+		least := left
+		if right := left + 1; right < len(s) && s[right].less(s[left]) {
+			least = right
+		}
+		if !s[least].less(s[i]) {
+			break
+		}
+		s[i], s[least] = s[least], s[i]
+		i = least
+	}
+	*h = s
+	return top
 }
@@ -3,9 +3,19 @@
 package internal
 
 import (
+	"bytes"
+	"context"
+	"errors"
 	"fmt"
+	"io"
+	"os"
 	"reflect"
+	"strings"
 	"testing"
+	"testing/iotest"
+	"time"
+
+	"github.com/peterheb/gotoken"
 )
 
 type bpeTest struct {
@@ -23,7 +33,10 @@ func must(t *testing.T, cond bool, msg string, params ...any) {
 // instantiate the "baby" tokenizer (r50k_base, truncated to 512 tokens) with
 // special tokens enabled
 func getBabyBPETokenizer(allowSpecialAsText bool, allowedSpecial []string) (*BPETokenizer, error) {
-	return NewBPETokenizer(getBabyTokenizerParams(), allowSpecialAsText, allowedSpecial)
+	return NewBPETokenizer(getBabyTokenizerParams(), gotoken.TokenizerOptions{
+		AllowSpecialAsText:   allowSpecialAsText,
+		AllowedSpecialTokens: allowedSpecial,
+	})
 }
 
 func TestNewBPETokenizer(t *testing.T) {
@@ -34,23 +47,52 @@ func TestNewBPETokenizer(t *testing.T) {
 	must(t, len(bpe.allowedSpecialTokens) == 0, "len(bpe.allowedSpecialTokens) > 0")
 	must(t, bpe.decodeSpecialTokens[babyEndOfTextToken] == babyEndOfTextString, "bpe.decodeSpecialTokens[] not initialized correctly")
 	must(t, bpe.params.Name == "baby", "bpe.params not correct")
-	must(t, bpe.specialTokenRegex != nil, "bpe.specialTokenRegex not set")
+	must(t, bpe.specialTokens != nil, "bpe.specialTokens not set")
 
 	// Make sure NewBPETokenizer rejects invalid special tokens on the allow
 	// list
-	_, err = NewBPETokenizer(getBabyTokenizerParams(), false, []string{"<|not_special|>"})
+	_, err = getBabyBPETokenizer(false, []string{"<|not_special|>"})
 	must(t, err != nil, "NewBPETokenizer: did not reject bad special token in allow list")
 
-	// Test the bpe.specialTokenRegex created by NewBPETokenizer
+	// The allowed special tokens are nil if there are none, and the shared
+	// map of all special tokens for gotoken.AllSpecial
+	bpe2, err := getBabyBPETokenizer(false, nil)
+	must(t, err == nil, "NewBPETokenizer: %v", err)
+	must(t, bpe2.allowedSpecialTokens == nil, "bpe.allowedSpecialTokens != nil with none allowed")
+	bpe2, err = getBabyBPETokenizer(false, []string{gotoken.AllSpecial})
+	must(t, err == nil, "NewBPETokenizer: %v", err)
+	must(t, reflect.ValueOf(bpe2.allowedSpecialTokens).Pointer() == reflect.ValueOf(bpe2.params.SpecialTokens).Pointer(),
+		"bpe.allowedSpecialTokens is a copy with AllSpecial allowed")
+	tokens, err := bpe2.Encode(babyEndOfTextString)
+	must(t, err == nil && len(tokens) == 1 && tokens[0] == babyEndOfTextToken, "Encode() with AllSpecial = %v, %v", tokens, err)
+
+	// Test the bpe.specialTokens created by NewBPETokenizer
 	matches := []string{babyEndOfTextString, "foo " + babyEndOfTextString, babyEndOfTextString + " bar", "foo " + babyEndOfTextString + " bar", "foo " + babyEndOfTextString + " bar " + babyEndOfTextString}
 	for _, match := range matches {
-		match1 := bpe.specialTokenRegex.FindStringSubmatch(match)
-		must(t, len(match1) == 2, "invalid bpe.specialTokenRegex match(%q) not found", match)
-		must(t, match1[1] == babyEndOfTextString, "invalid bpe.specialTokenRegex match(%q) not correct", match)
+		start, end := bpe.specialTokens.index(match)
+		must(t, start >= 0, "invalid bpe.specialTokens match(%q) not found", match)
+		must(t, match[start:end] == babyEndOfTextString, "invalid bpe.specialTokens match(%q) not correct", match)
 	}
 	nonMatches := []string{"", "foo", "<br/> ", "<|not_special|>"}
 	for _, nonMatch := range nonMatches {
-		must(t, bpe.specialTokenRegex.FindStringSubmatch(nonMatch) == nil, "invalid bpe.specialTokenRegex match(%q) WAS found", nonMatch)
+		start, _ := bpe.specialTokens.index(nonMatch)
+		must(t, start < 0, "invalid bpe.specialTokens match(%q) WAS found", nonMatch)
+	}
+}
+
+func TestBPETokenizer_ByteToken(t *testing.T) {
+	bpe, err := getBabyBPETokenizer(true, []string{})
+	must(t, err == nil, "NewBPETokenizer: %v", err)
+	for i := 0; i < 256; i++ {
+		token := bpe.ByteToken(byte(i))
+		text, err := bpe.Decode([]int{token})
+		must(t, err == nil && text == string([]byte{byte(i)}), "Decode(ByteToken(%d)) = %q, %v", i, text, err)
+		b, ok := bpe.TokenByte(token)
+		must(t, ok && b == byte(i), "TokenByte(%d) = %d, %v; want %d, true", token, b, ok, i)
+	}
+	for _, token := range []int{-1, 256, babyEndOfTextToken} {
+		_, ok := bpe.TokenByte(token)
+		must(t, !ok, "TokenByte(%d) reported a byte token", token)
 	}
 }
 
@@ -108,6 +150,875 @@ func TestBPETokenizer_EncodeDecode(t *testing.T) {
 	if err == nil {
 		t.Errorf("BPETokenizer.Decode() did not error on invalid token")
 	}
+	buf, err := bpe3.AppendDecode([]byte("abc"), []int{0, higherThanAnyToken})
+	if !errors.Is(err, gotoken.ErrInvalidToken) || string(buf) != "abc" {
+		t.Errorf("BPETokenizer.AppendDecode() = %q, %v; want \"abc\" and ErrInvalidToken", buf, err)
+	}
+}
+
+func TestBPETokenizer_EncodeOrdinary(t *testing.T) {
+	// The options of the tokenizer don't matter
+	bpe, err := getBabyBPETokenizer(false, []string{babyEndOfTextString})
+	must(t, err == nil, "init bpe: %v", err)
+	bpe2, err := getBabyBPETokenizer(true, nil)
+	must(t, err == nil, "init bpe: %v", err)
+
+	input := "a" + babyEndOfTextString
+	want, err := bpe2.Encode(input)
+	must(t, err == nil, "Encode(%q) as text: %v", input, err)
+	got, err := bpe.EncodeOrdinary(input)
+	must(t, err == nil && reflect.DeepEqual(got, want), "EncodeOrdinary(%q) = %v, %v; want %v", input, got, err, want)
+
+	// EncodeOrdinary didn't change bpe
+	got, err = bpe.Encode(input)
+	must(t, err == nil && got[len(got)-1] == babyEndOfTextToken, "Encode(%q) after EncodeOrdinary = %v, %v", input, got, err)
+}
+
+func TestBPETokenizer_EncodeSpecial(t *testing.T) {
+	params := getBabyTokenizerParams()
+	params.SpecialTokens = map[string]int{babyEndOfTextString: babyEndOfTextToken, "<|x|>": 100000}
+	bpe, err := NewBPETokenizer(params, gotoken.TokenizerOptions{})
+	must(t, err == nil, "init bpe: %v", err)
+
+	input := "a" + babyEndOfTextString + "<|x|>"
+	text, err := bpe.EncodeOrdinary(input)
+	must(t, err == nil, "EncodeOrdinary(%q): %v", input, err)
+	eot, _ := bpe.EncodeOrdinary(babyEndOfTextString)
+	x, _ := bpe.EncodeOrdinary("<|x|>")
+	a := text[:len(text)-len(eot)-len(x)]
+	cat := func(parts ...[]int) []int {
+		var ret []int
+		for _, part := range parts {
+			ret = append(ret, part...)
+		}
+		return ret
+	}
+	special := []int{babyEndOfTextToken, 100000}
+	all := []string{gotoken.AllSpecial}
+	tests := []struct {
+		allowed, disallowed []string
+		want                []int // nil for an error
+	}{
+		{nil, nil, text},
+		{all, nil, cat(a, special)},
+		{[]string{"<|x|>"}, nil, cat(a, eot, special[1:])},
+		{nil, all, nil},
+		{[]string{"<|x|>"}, all, nil},
+		{[]string{babyEndOfTextString, "<|x|>"}, all, cat(a, special)},
+		{nil, []string{"<|y|>"}, nil},
+	}
+	for _, test := range tests {
+		got, err := bpe.EncodeSpecial(input, test.allowed, test.disallowed)
+		if test.want == nil {
+			must(t, err != nil, "EncodeSpecial(%q, %q, %q) = %v, want an error", input, test.allowed, test.disallowed, got)
+			continue
+		}
+		must(t, err == nil && reflect.DeepEqual(got, test.want), "EncodeSpecial(%q, %q, %q) = %v, %v; want %v",
+			input, test.allowed, test.disallowed, got, err, test.want)
+	}
+	_, err = bpe.EncodeSpecial("a", nil, all)
+	must(t, err == nil, "EncodeSpecial without special tokens: %v", err)
+	_, err = bpe.EncodeSpecial(input, nil, []string{"<|x|>"})
+	must(t, errors.Is(err, gotoken.ErrSpecialToken), "EncodeSpecial with <|x|> disallowed: %v, want ErrSpecialToken", err)
+}
+
+func TestBPETokenizer_PartialResults(t *testing.T) {
+	params := getBabyTokenizerParams()
+	bpe, err := NewBPETokenizer(params, gotoken.TokenizerOptions{PartialResults: true})
+	must(t, err == nil, "init bpe: %v", err)
+
+	input := "For your information" + babyEndOfTextString + " more text"
+	tokens, err := bpe.Encode(input)
+	var partialErr *gotoken.PartialEncodeError
+	must(t, errors.As(err, &partialErr), "Encode(%q): expected *PartialEncodeError, got %v", input, err)
+	must(t, errors.Is(err, gotoken.ErrSpecialToken), "Encode(%q): error does not wrap ErrSpecialToken", input)
+	must(t, partialErr.Offset == 20, "PartialEncodeError.Offset = %d, want 20", partialErr.Offset)
+	want := []int{37, 273, 345, 81, 287, 69, 273, 76, 341}
+	must(t, reflect.DeepEqual(tokens, want), "Encode(%q) = %#v, want %#v", input, tokens, want)
+
+	// without the option, no tokens are returned
+	bpe2, err := getBabyBPETokenizer(false, nil)
+	must(t, err == nil, "init bpe: %v", err)
+	tokens, err = bpe2.Encode(input)
+	must(t, tokens == nil && err != nil, "Encode(%q) = (%#v, %v), want (nil, error)", input, tokens, err)
+	must(t, !errors.As(err, &partialErr), "Encode(%q): unexpected *PartialEncodeError", input)
+}
+
+func TestBPETokenizer_EncodeWithOffsets(t *testing.T) {
+	bpe, err := getBabyBPETokenizer(false, []string{babyEndOfTextString})
+	must(t, err == nil, "init bpe: %v", err)
+
+	inputs := []string{"", "a a", "• Bulleted \n• List\n", "For your information" + babyEndOfTextString + "!"}
+	for _, input := range inputs {
+		tokens, offsets, err := bpe.EncodeWithOffsets(input)
+		must(t, err == nil, "EncodeWithOffsets(%q): %v", input, err)
+		want, _ := bpe.Encode(input)
+		must(t, reflect.DeepEqual(tokens, want), "EncodeWithOffsets(%q) = %#v, want %#v", input, tokens, want)
+		must(t, len(offsets) == len(tokens), "EncodeWithOffsets(%q): %d offsets for %d tokens", input, len(offsets), len(tokens))
+
+		// each token must decode to the input between its offsets
+		for i, token := range tokens {
+			end := len(input)
+			if i+1 < len(offsets) {
+				end = offsets[i+1]
+			}
+			text, err := bpe.Decode([]int{token})
+			must(t, err == nil, "Decode: %v", err)
+			must(t, text == input[offsets[i]:end], "EncodeWithOffsets(%q): token %d is %q, offsets give %q", input, i, text, input[offsets[i]:end])
+		}
+	}
+
+	bpe2, err := getBabyBPETokenizer(false, nil)
+	must(t, err == nil, "init bpe: %v", err)
+	tokens, offsets, err := bpe2.EncodeWithOffsets(babyEndOfTextString)
+	must(t, tokens == nil && offsets == nil && err != nil, "EncodeWithOffsets(): expected error")
+}
+
+func TestBPETokenizer_MaxTokens(t *testing.T) {
+	// "Write 3 knock-knock jokes." encodes to 19 tokens; "Write" is 4 tokens and
+	// " 3" is 2 more, which exceeds the limit.
+	input := "Write 3 knock-knock jokes."
+	bpe, err := NewBPETokenizer(getBabyTokenizerParams(), gotoken.TokenizerOptions{MaxTokens: 5})
+	must(t, err == nil, "init bpe: %v", err)
+	tokens, err := bpe.Encode(input)
+	var tooMany *gotoken.TooManyTokensError
+	must(t, errors.As(err, &tooMany), "Encode(%q): expected *TooManyTokensError, got %v", input, err)
+	must(t, tokens == nil, "Encode(%q) = %#v, want nil", input, tokens)
+	must(t, *tooMany == gotoken.TooManyTokensError{Max: 5, Count: 6, Offset: 5}, "Encode(%q): got %+v", input, *tooMany)
+
+	// with partial results, the tokens before the limit was exceeded are returned
+	bpe2, err := NewBPETokenizer(getBabyTokenizerParams(), gotoken.TokenizerOptions{MaxTokens: 5, PartialResults: true})
+	must(t, err == nil, "init bpe: %v", err)
+	tokens, err = bpe2.Encode(input)
+	var partialErr *gotoken.PartialEncodeError
+	must(t, errors.As(err, &partialErr) && errors.As(err, &tooMany), "Encode(%q): expected partial *TooManyTokensError, got %v", input, err)
+	must(t, partialErr.Offset == 5, "PartialEncodeError.Offset = %d, want 5", partialErr.Offset)
+	must(t, reflect.DeepEqual(tokens, []int{54, 81, 270, 68}), "Encode(%q) = %#v", input, tokens)
+
+	// inputs within the limit are not affected
+	bpe3, err := NewBPETokenizer(getBabyTokenizerParams(), gotoken.TokenizerOptions{MaxTokens: 19})
+	must(t, err == nil, "init bpe: %v", err)
+	tokens, err = bpe3.Encode(input)
+	must(t, err == nil && len(tokens) == 19, "Encode(%q) = (%#v, %v)", input, tokens, err)
+}
+
+func TestBPETokenizer_MaxInputBytes(t *testing.T) {
+	input := "Write 3 knock-knock jokes."
+	bpe, err := NewBPETokenizer(getBabyTokenizerParams(), gotoken.TokenizerOptions{MaxInputBytes: 10, PartialResults: true})
+	must(t, err == nil, "init bpe: %v", err)
+
+	tokens, err := bpe.Encode(input)
+	var tooLarge *gotoken.InputTooLargeError
+	must(t, errors.As(err, &tooLarge), "Encode(%q): expected *InputTooLargeError, got %v", input, err)
+	must(t, *tooLarge == gotoken.InputTooLargeError{Max: 10, Size: len(input)}, "Encode(%q): got %+v", input, *tooLarge)
+	must(t, tokens == nil, "Encode(%q) = %#v, want nil", input, tokens)
+	must(t, bpe.Count(input) == 0, "Count(%q) = %d, want 0", input, bpe.Count(input))
+	err = bpe.Allowed(input)
+	must(t, errors.As(err, &tooLarge), "Allowed(%q): expected *InputTooLargeError, got %v", input, err)
+
+	// inputs within the limit are not affected
+	tokens, err = bpe.Encode(input[:10])
+	must(t, err == nil && len(tokens) > 0, "Encode(%q) = (%#v, %v)", input[:10], tokens, err)
+	must(t, bpe.Allowed(input[:10]) == nil, "Allowed(%q) != nil", input[:10])
+}
+
+func TestBPETokenizer_MaxPieceBytes(t *testing.T) {
+	bpe, err := NewBPETokenizer(getBabyTokenizerParams(), gotoken.TokenizerOptions{MaxPieceBytes: 8})
+	must(t, err == nil, "init bpe: %v", err)
+	bpe2, err := getBabyBPETokenizer(false, nil)
+	must(t, err == nil, "init bpe: %v", err)
+
+	// Each long piece is encoded in slices, as if they were separate pieces
+	input := "short ininininin words"
+	var want []int
+	for _, slice := range []string{"short", " ininini", "nin", " words"} {
+		tokens, err := bpe2.Encode(slice)
+		must(t, err == nil, "Encode(%q): %v", slice, err)
+		want = append(want, tokens...)
+	}
+	tokens, err := bpe.Encode(input)
+	must(t, err == nil && reflect.DeepEqual(tokens, want), "Encode(%q) = %v, %v; want %v", input, tokens, err, want)
+	text, err := bpe.Decode(tokens)
+	must(t, err == nil && text == input, "Decode(Encode(%q)) = %q, %v", input, text, err)
+	unsliced, _ := bpe2.Encode(input)
+	must(t, !reflect.DeepEqual(tokens, unsliced), "Encode(%q) with MaxPieceBytes is the same as without", input)
+}
+
+// TestBPETokenizer_Preset checks that the settings of each preset reach the
+// tokenizer, and that a piece over a preset's limit is sliced.
+func TestBPETokenizer_Preset(t *testing.T) {
+	for _, preset := range []gotoken.Preset{gotoken.PresetChat, gotoken.PresetCode, gotoken.PresetLogLines} {
+		var opts gotoken.TokenizerOptions
+		gotoken.WithPreset(preset)(&opts)
+		bpe, err := NewBPETokenizer(getBabyTokenizerParams(), opts)
+		must(t, err == nil, "init bpe: %v", err)
+		must(t, bpe.maxPieceBytes == opts.MaxPieceBytes && bpe.parallelThreshold == opts.ParallelThreshold,
+			"%v: maxPieceBytes = %d, parallelThreshold = %d; want %d, %d",
+			preset, bpe.maxPieceBytes, bpe.parallelThreshold, opts.MaxPieceBytes, opts.ParallelThreshold)
+	}
+
+	var opts gotoken.TokenizerOptions
+	gotoken.WithPreset(gotoken.PresetLogLines)(&opts)
+	bpe, err := NewBPETokenizer(getBabyTokenizerParams(), opts)
+	must(t, err == nil, "init bpe: %v", err)
+	bpe2, err := getBabyBPETokenizer(false, nil)
+	must(t, err == nil, "init bpe: %v", err)
+	input := " " + strings.Repeat("in", 5<<10)
+	var want []int
+	for _, slice := range []string{input[:opts.MaxPieceBytes], input[opts.MaxPieceBytes:]} {
+		tokens, err := bpe2.Encode(slice)
+		must(t, err == nil, "Encode: %v", err)
+		want = append(want, tokens...)
+	}
+	tokens, err := bpe.Encode(input)
+	must(t, err == nil && reflect.DeepEqual(tokens, want), "Encode with PresetLogLines did not slice a %d-byte piece: %v", len(input), err)
+	unsliced, _ := bpe2.Encode(input)
+	must(t, !reflect.DeepEqual(tokens, unsliced), "Encode with PresetLogLines is the same as the default")
+}
+
+func TestSliceLongSpans(t *testing.T) {
+	tests := []struct {
+		input string
+		spans []Span
+		want  []Span
+	}{
+		{"abc def", []Span{{0, 3}, {3, 7}}, []Span{{0, 3}, {3, 7}}},
+		{"abcdefghij", []Span{{0, 10}}, []Span{{0, 4}, {4, 8}, {8, 10}}},
+		{"a€€€", []Span{{0, 1}, {1, 10}}, []Span{{0, 1}, {1, 4}, {4, 7}, {7, 10}}},
+		{"\x80\x80\x80\x80\x80\x80", []Span{{0, 6}}, []Span{{0, 4}, {4, 6}}},
+	}
+	for _, test := range tests {
+		got := sliceLongSpans(test.spans, []byte(test.input), 4)
+		must(t, reflect.DeepEqual(got, test.want), "sliceLongSpans(%v, %q, 4) = %v, want %v", test.spans, test.input, got, test.want)
+	}
+}
+
+func TestBPETokenizer_MaxDecodeBytes(t *testing.T) {
+	input := "Write 3 knock-knock jokes."
+	bpe, err := NewBPETokenizer(getBabyTokenizerParams(), gotoken.TokenizerOptions{MaxDecodeBytes: 10})
+	must(t, err == nil, "init bpe: %v", err)
+	tokens, err := bpe.Encode(input)
+	must(t, err == nil, "Encode(%q): %v", input, err)
+
+	text, err := bpe.Decode(tokens)
+	var tooLarge *gotoken.DecodeTooLargeError
+	must(t, errors.As(err, &tooLarge), "Decode: expected *DecodeTooLargeError, got %v", err)
+	must(t, text == "", "Decode = %q, want \"\"", text)
+	prefix, _ := bpe.Decode(tokens[:tooLarge.Index])
+	must(t, len(prefix) <= 10, "Decode(tokens[:%d]) = %q, longer than the limit", tooLarge.Index, prefix)
+	_, err = bpe.Decode32([]uint32{uint32(tokens[0])})
+	must(t, err == nil, "Decode32 of one token: %v", err)
+
+	// only the appended bytes count for AppendDecode
+	buf, err := bpe.AppendDecode([]byte("a long prefix"), tokens[:2])
+	must(t, err == nil, "AppendDecode: %v", err)
+	_, err = bpe.AppendDecode(buf, tokens)
+	must(t, errors.As(err, &tooLarge), "AppendDecode: expected *DecodeTooLargeError, got %v", err)
+}
+
+func TestBPETokenizer_StrictUTF8(t *testing.T) {
+	bpe, err := NewBPETokenizer(getBabyTokenizerParams(), gotoken.TokenizerOptions{StrictUTF8: true})
+	must(t, err == nil, "init bpe: %v", err)
+	input := "ab • cd"
+	tokens, err := bpe.Encode(input)
+	must(t, err == nil, "Encode(%q): %v", input, err)
+	text, err := bpe.Decode(tokens)
+	must(t, err == nil && text == input, "Decode = %q, %v; want %q", text, err, input)
+
+	// "•" is three bytes, so cut it after the first
+	e2 := bpe.ByteToken(0xe2)
+	broken := []int{tokens[0], tokens[1], e2, tokens[len(tokens)-1]}
+	want := gotoken.InvalidUTF8Error{Offset: len("ab "), Index: 2}
+	text, err = bpe.Decode(broken)
+	var invalid *gotoken.InvalidUTF8Error
+	must(t, errors.As(err, &invalid) && *invalid == want, "Decode(%v) error = %v, want %+v", broken, err, want)
+	must(t, text == "", "Decode = %q, want \"\"", text)
+	_, err = bpe.Decode32([]uint32{uint32(e2)})
+	must(t, errors.As(err, &invalid) && invalid.Offset == 0 && invalid.Index == 0, "Decode32 error = %v, want offset 0", err)
+
+	// AppendDecode is not checked
+	buf, err := bpe.AppendDecode(nil, broken)
+	must(t, err == nil && strings.HasPrefix(string(buf), "ab \xe2"), "AppendDecode = %q, %v", buf, err)
+
+	// Without the option, the invalid bytes are returned
+	bpe2, err := getBabyBPETokenizer(false, nil)
+	must(t, err == nil, "init bpe: %v", err)
+	_, err = bpe2.Decode(broken)
+	must(t, err == nil, "Decode without WithStrictUTF8: %v", err)
+}
+
+// shortWriter is an io.Writer that fails once more than max bytes have been
+// written to it.
+type shortWriter struct {
+	bytes.Buffer
+	max int
+}
+
+func (w *shortWriter) Write(p []byte) (int, error) {
+	if w.Len()+len(p) > w.max {
+		return 0, io.ErrShortWrite
+	}
+	return w.Buffer.Write(p)
+}
+
+func TestBPETokenizer_DecodeTo(t *testing.T) {
+	samples, err := os.ReadFile("../testdata/samples.txt")
+	must(t, err == nil, "reading samples: %v", err)
+	bpe, err := NewBPETokenizer(getBabyTokenizerParams(), gotoken.TokenizerOptions{StrictUTF8: true})
+	must(t, err == nil, "init bpe: %v", err)
+
+	// Any buffer size gives the same text as Decode, and characters split
+	// between buffers are not invalid
+	for _, input := range []string{"", "Hello world", "ab • cd 😄", string(samples)} {
+		tokens, err := bpe.Encode(input)
+		must(t, err == nil, "Encode(%.20q): %v", input, err)
+		for _, size := range []int{1, 2, 5, decodeBufferSize} {
+			var buf bytes.Buffer
+			n, err := bpe.decodeTo(&buf, tokens, size)
+			must(t, err == nil && buf.String() == input && n == len(input), "decodeTo(%.20q, %d) = %.20q, %d, %v", input, size, buf.String(), n, err)
+		}
+	}
+
+	// Invalid tokens and the size limit are checked before writing
+	tokens, _ := bpe.Encode("Write 3 knock-knock jokes.")
+	var buf bytes.Buffer
+	err = bpe.DecodeTo(&buf, append(tokens, -1))
+	must(t, errors.Is(err, gotoken.ErrInvalidToken) && buf.Len() == 0, "DecodeTo() = %q, %v, want ErrInvalidToken", buf.String(), err)
+	bpe2, err := NewBPETokenizer(getBabyTokenizerParams(), gotoken.TokenizerOptions{MaxDecodeBytes: 10})
+	must(t, err == nil, "init bpe: %v", err)
+	var tooLarge *gotoken.DecodeTooLargeError
+	err = bpe2.DecodeTo(&buf, tokens)
+	must(t, errors.As(err, &tooLarge) && buf.Len() == 0, "DecodeTo() = %q, %v, want DecodeTooLargeError", buf.String(), err)
+
+	// Invalid UTF-8 is reported like Decode does, after the text before it
+	e2 := bpe.ByteToken(0xe2)
+	broken := append(append(append([]int(nil), tokens...), e2), tokens...)
+	_, wantErr := bpe.Decode(broken)
+	for _, size := range []int{1, 4, decodeBufferSize} {
+		buf.Reset()
+		_, err = bpe.decodeTo(&buf, broken, size)
+		var invalid *gotoken.InvalidUTF8Error
+		must(t, errors.As(err, &invalid) && err.Error() == wantErr.Error(), "decodeTo(%d) error = %v, want %v", size, err, wantErr)
+		must(t, len(buf.String()) <= invalid.Offset, "decodeTo(%d) wrote %q past the invalid byte", size, buf.String())
+	}
+
+	// An error from the writer is returned
+	w := &shortWriter{max: 10}
+	_, err = bpe.decodeTo(w, tokens, 4)
+	must(t, errors.Is(err, io.ErrShortWrite) && w.Len() <= 10, "decodeTo() = %v, want io.ErrShortWrite", err)
+}
+
+func TestBPETokenizer_TimingCallback(t *testing.T) {
+	var calls []gotoken.EncodeTiming
+	bpe, err := NewBPETokenizer(getBabyTokenizerParams(), gotoken.TokenizerOptions{
+		TimingCallback: func(timing gotoken.EncodeTiming) { calls = append(calls, timing) },
+	})
+	must(t, err == nil, "init bpe: %v", err)
+
+	input := "Write 3 knock-knock jokes."
+	tokens, err := bpe.Encode(input)
+	must(t, err == nil, "Encode(%q): %v", input, err)
+	must(t, bpe.Count(input) == len(tokens), "Count(%q) != len(Encode())", input)
+	must(t, len(calls) == 2, "timing callback called %d times, want 2", len(calls))
+
+	timing := calls[0]
+	must(t, timing.Bytes == len(input) && timing.Tokens == len(tokens), "timing = %+v, want Bytes=%d Tokens=%d", timing, len(input), len(tokens))
+	phases := timing.Special + timing.Split + timing.Lookup + timing.Merge
+	must(t, timing.Lookup >= 0 && timing.Total >= phases, "timing phases %v exceed total %v", phases, timing.Total)
+}
+
+func TestBPETokenizer_MetricsHook(t *testing.T) {
+	type call struct {
+		encoding, op string
+		tokens       int
+	}
+	var calls []call
+	params := getBabyTokenizerParams()
+	bpe, err := NewBPETokenizer(params, gotoken.TokenizerOptions{
+		MetricsHook: func(encoding, op string, tokens int, dur time.Duration) {
+			must(t, dur >= 0, "negative duration %v", dur)
+			calls = append(calls, call{encoding, op, tokens})
+		},
+	})
+	must(t, err == nil, "init bpe: %v", err)
+
+	input := "Write 3 knock-knock jokes."
+	tokens, err := bpe.Encode(input)
+	must(t, err == nil, "Encode(%q): %v", input, err)
+	n := len(tokens)
+	bpe.Encode32(input)
+	bpe.EncodeCtx(context.Background(), input)
+	bpe.Count(input)
+	bpe.CountCtx(context.Background(), input)
+	bpe.Decode(tokens)
+	bpe.Decode32([]uint32{uint32(tokens[0])})
+	bpe.AppendDecode(nil, tokens[:2])
+	bpe.Decode([]int{-1})
+	bpe.Count(babyEndOfTextString)
+
+	name := params.Name
+	want := []call{
+		{name, "encode", n}, {name, "encode", n}, {name, "encode", n},
+		{name, "count", n}, {name, "count", n},
+		{name, "decode", n}, {name, "decode", 1}, {name, "decode", 2},
+		{name, "decode", 0}, {name, "count", 0},
+	}
+	must(t, reflect.DeepEqual(calls, want), "metrics hook calls = %v, want %v", calls, want)
+}
+
+func TestBPETokenizer_TraceHook(t *testing.T) {
+	type key struct{}
+	var spans []gotoken.TraceSpan
+	var values []any
+	params := getBabyTokenizerParams()
+	bpe, err := NewBPETokenizer(params, gotoken.TokenizerOptions{
+		TraceHook: func(ctx context.Context, span gotoken.TraceSpan) {
+			spans = append(spans, span)
+			values = append(values, ctx.Value(key{}))
+		},
+	})
+	must(t, err == nil, "init bpe: %v", err)
+
+	input := "Write 3 knock-knock jokes."
+	ctx := context.WithValue(context.Background(), key{}, "parent")
+	tokens, err := bpe.EncodeCtx(ctx, input)
+	must(t, err == nil, "EncodeCtx(%q): %v", input, err)
+	bpe.Decode(tokens[:2])
+	bpe.Count(babyEndOfTextString)
+
+	must(t, len(spans) == 3, "got %d spans, want 3", len(spans))
+	for _, span := range spans {
+		must(t, span.Encoding == params.Name, "span.Encoding = %q, want %q", span.Encoding, params.Name)
+		must(t, !span.End.Before(span.Start), "span %s ends before it starts", span.Op)
+	}
+	must(t, spans[0].Op == "encode" && spans[0].Bytes == len(input) && spans[0].Tokens == len(tokens) && spans[0].Err == nil,
+		"encode span = %+v", spans[0])
+	must(t, values[0] == "parent", "encode span: context value = %v, want the caller's", values[0])
+	must(t, spans[1].Op == "decode" && spans[1].Bytes == len("Wr") && spans[1].Tokens == 2 && values[1] == nil,
+		"decode span = %+v", spans[1])
+	must(t, spans[2].Op == "count" && spans[2].Tokens == 0 && errors.Is(spans[2].Err, gotoken.ErrSpecialToken),
+		"count span = %+v", spans[2])
+}
+
+func TestBPETokenizer_EncodeSuffix(t *testing.T) {
+	bpe, err := getBabyBPETokenizer(false, []string{babyEndOfTextString})
+	must(t, err == nil, "init bpe: %v", err)
+
+	// splitting the input anywhere must give the same tokens as encoding it whole
+	inputs := []string{"Hello world", "a  b\n\n  c", "Write 3 knock-knock jokes.", "It's 12345 o'clock", "For " + babyEndOfTextString + " your information"}
+	for _, input := range inputs {
+		want, _ := bpe.Encode(input)
+		for i := 0; i <= len(input); i++ {
+			prompt, err := bpe.Encode(input[:i])
+			if err != nil {
+				continue // split inside the special token
+			}
+			got, err := bpe.EncodeSuffix(prompt, input[i:])
+			must(t, err == nil, "EncodeSuffix(%q, %q): %v", input[:i], input[i:], err)
+			must(t, reflect.DeepEqual(got, want), "EncodeSuffix(%q, %q) = %v, want %v", input[:i], input[i:], got, want)
+		}
+	}
+
+	// invalid tokens and errors from Encode are returned
+	_, err = bpe.EncodeSuffix([]int{64, -1}, "a")
+	must(t, errors.Is(err, gotoken.ErrInvalidToken), "EncodeSuffix(): expected ErrInvalidToken, got %v", err)
+	bpe2, err := getBabyBPETokenizer(false, nil)
+	must(t, err == nil, "init bpe: %v", err)
+	_, err = bpe2.EncodeSuffix([]int{64}, babyEndOfTextString)
+	must(t, errors.Is(err, gotoken.ErrSpecialToken), "EncodeSuffix(): expected ErrSpecialToken, got %v", err)
+}
+
+func TestBPETokenizer_EncodeBatch(t *testing.T) {
+	bpe, err := getBabyBPETokenizer(false, nil)
+	must(t, err == nil, "init bpe: %v", err)
+
+	inputs := []string{"Hello world", "", "Write 3 knock-knock jokes.", "a a", "It's 12345 o'clock"}
+	for _, workers := range []int{0, 1, 2, 100} {
+		results, err := bpe.EncodeBatch(inputs, workers)
+		must(t, err == nil, "EncodeBatch(workers=%d): %v", workers, err)
+		must(t, len(results) == len(inputs), "EncodeBatch(workers=%d) returned %d results", workers, len(results))
+		for i, input := range inputs {
+			want, _ := bpe.Encode(input)
+			must(t, reflect.DeepEqual(results[i], want), "EncodeBatch(workers=%d)[%d] = %v, want %v", workers, i, results[i], want)
+		}
+	}
+
+	// failed inputs are reported per input, and the others are still encoded
+	inputs = []string{"a", babyEndOfTextString, "b", babyEndOfTextString}
+	results, err := bpe.EncodeBatch(inputs, 2)
+	var batchErr *gotoken.BatchError
+	must(t, errors.As(err, &batchErr), "EncodeBatch(): expected BatchError, got %v", err)
+	must(t, batchErr.Errs[0] == nil && batchErr.Errs[2] == nil, "EncodeBatch(): unexpected errors %v", batchErr.Errs)
+	must(t, errors.Is(batchErr.Errs[1], gotoken.ErrSpecialToken) && errors.Is(batchErr.Errs[3], gotoken.ErrSpecialToken), "EncodeBatch(): expected ErrSpecialToken, got %v", batchErr.Errs)
+	must(t, len(results[0]) == 1 && len(results[2]) == 1 && results[1] == nil, "EncodeBatch() results = %v", results)
+	must(t, err.Error() == `2 of 4 inputs failed to encode; input 1: unexpected special token found: "<|endoftext|>"`, "BatchError.Error() = %q", err.Error())
+}
+
+// countdownCtx is a context that is cancelled after Err has been called n
+// times, for testing cancellation partway through encoding.
+type countdownCtx struct {
+	context.Context
+	n int
+}
+
+func (ctx *countdownCtx) Err() error {
+	if ctx.n <= 0 {
+		return context.Canceled
+	}
+	ctx.n--
+	return nil
+}
+
+func TestBPETokenizer_EncodeCtx(t *testing.T) {
+	bpe, err := getBabyBPETokenizer(false, nil)
+	must(t, err == nil, "init bpe: %v", err)
+
+	// an uncancelled context gives the same result as Encode
+	input := strings.Repeat("Write 3 knock-knock jokes. ", 100)
+	want, _ := bpe.Encode(input)
+	got, err := bpe.EncodeCtx(context.Background(), input)
+	must(t, err == nil && reflect.DeepEqual(got, want), "EncodeCtx() = %v, %v, want Encode() result", got, err)
+	count, err := bpe.CountCtx(context.Background(), input)
+	must(t, err == nil && count == len(want), "CountCtx() = %d, %v, want %d", count, err, len(want))
+
+	// an already-cancelled context fails immediately
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = bpe.EncodeCtx(ctx, input)
+	must(t, errors.Is(err, context.Canceled), "EncodeCtx(): expected context.Canceled, got %v", err)
+	_, err = bpe.CountCtx(ctx, input)
+	must(t, errors.Is(err, context.Canceled), "CountCtx(): expected context.Canceled, got %v", err)
+
+	// cancellation partway through the pieces of a long input, and partway
+	// through the merges of a long piece
+	for _, input := range []string{input, strings.Repeat("abcdefghijklmnopqrstuvwxyz", 100)} {
+		_, err = bpe.EncodeCtx(&countdownCtx{Context: context.Background(), n: 2}, input)
+		must(t, errors.Is(err, context.Canceled), "EncodeCtx(): expected context.Canceled, got %v", err)
+	}
+
+	// with partial results, the tokens before the cancellation are returned
+	bpe2, err := NewBPETokenizer(getBabyTokenizerParams(), gotoken.TokenizerOptions{PartialResults: true})
+	must(t, err == nil, "init bpe: %v", err)
+	tokens, err := bpe2.EncodeCtx(&countdownCtx{Context: context.Background(), n: 2}, input)
+	var partial *gotoken.PartialEncodeError
+	must(t, errors.As(err, &partial) && errors.Is(err, context.Canceled), "EncodeCtx(): expected PartialEncodeError, got %v", err)
+	decoded, _ := bpe2.Decode(tokens)
+	must(t, len(tokens) > 0 && decoded == input[:partial.Offset], "EncodeCtx() partial tokens decode to %q, want %q", decoded, input[:partial.Offset])
+}
+
+func TestBPETokenizer_EncodeParallel(t *testing.T) {
+	samples, err := os.ReadFile("../testdata/samples.txt")
+	must(t, err == nil, "reading samples: %v", err)
+	input := strings.Repeat(string(samples), 50)
+
+	bpe, err := getBabyBPETokenizer(false, nil)
+	must(t, err == nil, "init bpe: %v", err)
+	want, _, err := bpe.encode(input, nil)
+	must(t, err == nil, "encode: %v", err)
+
+	// small chunks, split at every possible boundary
+	var got []int
+	chunks := splitChunks(string(samples), 1)
+	must(t, len(chunks) > 100 && strings.Join(chunks, "") == string(samples), "splitChunks() returned %d chunks", len(chunks))
+	for _, chunk := range chunks {
+		tokens, _, _ := bpe.encode(chunk, nil)
+		got = append(got, tokens...)
+	}
+	wantSamples, _ := bpe.Encode(string(samples))
+	must(t, reflect.DeepEqual(got, wantSamples), "encoding chunks from splitChunks() gave different tokens")
+
+	// Encode in parallel mode must match sequential encoding
+	bpe2, err := NewBPETokenizer(getBabyTokenizerParams(), gotoken.TokenizerOptions{ParallelThreshold: 1})
+	must(t, err == nil, "init bpe: %v", err)
+	got, err = bpe2.Encode(input)
+	must(t, err == nil && reflect.DeepEqual(got, want), "parallel Encode() gave different tokens: %v", err)
+}
+
+func TestBPETokenizer_EncodeFunc(t *testing.T) {
+	samples, err := os.ReadFile("../testdata/samples.txt")
+	must(t, err == nil, "reading samples: %v", err)
+	bpe, err := getBabyBPETokenizer(false, []string{babyEndOfTextString})
+	must(t, err == nil, "init bpe: %v", err)
+
+	// The tokens passed to fn, one piece at a time, are those of Encode
+	for _, input := range []string{"", "Hello world", "a" + babyEndOfTextString + "b", string(samples)} {
+		want, _ := bpe.Encode(input)
+		got := []int{}
+		calls := 0
+		err := bpe.EncodeFunc(input, func(tokens []int) error {
+			must(t, len(tokens) > 0, "EncodeFunc(%.20q) called fn with no tokens", input)
+			got = append(got, tokens...)
+			calls++
+			return nil
+		})
+		must(t, err == nil && reflect.DeepEqual(got, want), "EncodeFunc(%.20q) = %v, %v, want %v", input, got, err, want)
+		explained, _ := bpe.Explain(input)
+		must(t, calls == len(explained), "EncodeFunc(%.20q) called fn %d times, want once for each of %d pieces", input, calls, len(explained))
+	}
+
+	// An error from fn stops encoding
+	errStop := errors.New("stop")
+	calls := 0
+	err = bpe.EncodeFunc("one two three", func([]int) error {
+		calls++
+		return errStop
+	})
+	must(t, errors.Is(err, errStop) && calls == 1, "EncodeFunc() = %v after %d calls, want errStop after 1", err, calls)
+
+	// Disallowed special tokens fail before fn is called
+	bpe2, err := getBabyBPETokenizer(false, nil)
+	must(t, err == nil, "init bpe: %v", err)
+	err = bpe2.EncodeFunc("a b "+babyEndOfTextString, func([]int) error {
+		t.Fatal("EncodeFunc() called fn for input with a disallowed special token")
+		return nil
+	})
+	must(t, errors.Is(err, gotoken.ErrSpecialToken), "EncodeFunc(): expected ErrSpecialToken, got %v", err)
+
+	// The token limit counts the tokens already passed to fn
+	input := "one two three four five six"
+	bpe3, err := NewBPETokenizer(getBabyTokenizerParams(), gotoken.TokenizerOptions{MaxTokens: 4, PartialResults: true})
+	must(t, err == nil, "init bpe: %v", err)
+	want, wantErr := bpe3.Encode(input)
+	var got []int
+	err = bpe3.EncodeFunc(input, func(tokens []int) error {
+		got = append(got, tokens...)
+		return nil
+	})
+	must(t, reflect.DeepEqual(got, want) && err.Error() == wantErr.Error(), "EncodeFunc() = %v, %v, want %v, %v", got, err, want, wantErr)
+}
+
+// readAll calls bpe.encodeReader on input with a buffer of size bytes, reading
+// it one byte at a time, and returns all the tokens emitted.
+func readAll(bpe *BPETokenizer, input string, size int) ([]int, error) {
+	got := []int{}
+	_, _, err := bpe.encodeReader(iotest.OneByteReader(strings.NewReader(input)), func(tokens []int) error {
+		got = append(got, tokens...)
+		return nil
+	}, size)
+	return got, err
+}
+
+func TestBPETokenizer_EncodeReader(t *testing.T) {
+	samples, err := os.ReadFile("../testdata/samples.txt")
+	must(t, err == nil, "reading samples: %v", err)
+	bpe, err := getBabyBPETokenizer(false, nil)
+	must(t, err == nil, "init bpe: %v", err)
+
+	// Any buffer size gives the same tokens as Encode, including buffers too
+	// small for a single piece
+	inputs := []string{"", "Hello world", "  leading and trailing  ", "a \n\n  b   c", "xxxxxxxxxxxxxxxxxxxxxxxx", string(samples)}
+	for _, input := range inputs {
+		want, _ := bpe.Encode(input)
+		for _, size := range []int{1, 2, 7, 64, readChunkSize} {
+			got, err := readAll(bpe, input, size)
+			must(t, err == nil && reflect.DeepEqual(got, want), "encodeReader(%.20q, %d) = %v, %v, want %v", input, size, got, err, want)
+		}
+	}
+	var chunks int
+	err = bpe.EncodeReader(strings.NewReader(string(samples)), func([]int) error {
+		chunks++
+		return nil
+	})
+	must(t, err == nil && chunks == 1, "EncodeReader() emitted %d chunks, %v", chunks, err)
+
+	// Errors from the reader and from emit are returned
+	errRead := errors.New("read failed")
+	err = bpe.EncodeReader(iotest.TimeoutReader(iotest.OneByteReader(strings.NewReader("ab"))), func([]int) error { return nil })
+	must(t, errors.Is(err, iotest.ErrTimeout), "EncodeReader(): expected reader's error, got %v", err)
+	err = bpe.EncodeReader(strings.NewReader("a b"), func([]int) error { return errRead })
+	must(t, errors.Is(err, errRead), "EncodeReader(): expected emit's error, got %v", err)
+
+	// Special tokens are checked in each chunk
+	input := "a b " + babyEndOfTextString + " c"
+	_, err = readAll(bpe, input, 4)
+	must(t, errors.Is(err, gotoken.ErrSpecialToken), "encodeReader(): expected ErrSpecialToken, got %v", err)
+
+	// A special token with a space in it is not split between chunks
+	params := getBabyTokenizerParams()
+	params.SpecialTokens = map[string]int{"<|a b|>": 1000}
+	bpe2, err := NewBPETokenizer(params, gotoken.TokenizerOptions{AllowedSpecialTokens: []string{"<|a b|>"}})
+	must(t, err == nil, "init bpe: %v", err)
+	for _, input := range []string{"x <|a b|> y", "xx<|a b|>", "<|a b|><|a b|> <|a b|>z"} {
+		want, _ := bpe2.Encode(input)
+		for _, size := range []int{1, 3, 8} {
+			got, err := readAll(bpe2, input, size)
+			must(t, err == nil && reflect.DeepEqual(got, want), "encodeReader(%q, %d) = %v, %v, want %v", input, size, got, err, want)
+		}
+	}
+}
+
+func TestBPETokenizer_CountReader(t *testing.T) {
+	samples, err := os.ReadFile("../testdata/samples.txt")
+	must(t, err == nil, "reading samples: %v", err)
+	bpe, err := getBabyBPETokenizer(false, nil)
+	must(t, err == nil, "init bpe: %v", err)
+
+	input := strings.Repeat(string(samples), 50)
+	must(t, len(input) > 2*readChunkSize, "input of %d bytes fits in fewer than 3 chunks", len(input))
+	count, err := bpe.CountReader(strings.NewReader(input))
+	must(t, err == nil && count == int64(bpe.Count(input)), "CountReader() = %d, %v, want %d", count, err, bpe.Count(input))
+
+	count, err = bpe.CountReader(strings.NewReader("a b " + babyEndOfTextString))
+	must(t, errors.Is(err, gotoken.ErrSpecialToken) && count == 0, "CountReader() = %d, %v, want ErrSpecialToken", count, err)
+	count, err = bpe.CountReader(iotest.ErrReader(io.ErrUnexpectedEOF))
+	must(t, errors.Is(err, io.ErrUnexpectedEOF) && count == 0, "CountReader() = %d, %v, want reader's error", count, err)
+}
+
+func TestBPETokenizer_EncodeReaderLimits(t *testing.T) {
+	input := "one two three four five six"
+	bpe, err := NewBPETokenizer(getBabyTokenizerParams(), gotoken.TokenizerOptions{MaxInputBytes: 10})
+	must(t, err == nil, "init bpe: %v", err)
+	_, err = readAll(bpe, input, 4)
+	var tooLarge *gotoken.InputTooLargeError
+	must(t, errors.As(err, &tooLarge) && tooLarge.Max == 10, "encodeReader(): expected InputTooLargeError, got %v", err)
+
+	// The token limit applies to the whole stream, and with partial results,
+	// the tokens up to the limit are emitted, as Encode returns them
+	for _, max := range []int{3, 4} {
+		bpe, err = NewBPETokenizer(getBabyTokenizerParams(), gotoken.TokenizerOptions{MaxTokens: max, PartialResults: true})
+		must(t, err == nil, "init bpe: %v", err)
+		wantErr, _ := bpe.Encode(input)
+		for _, size := range []int{4, 8, 64} {
+			got, err := readAll(bpe, input, size)
+			var tooMany *gotoken.TooManyTokensError
+			var partial *gotoken.PartialEncodeError
+			must(t, errors.As(err, &tooMany) && errors.As(err, &partial), "encodeReader(max=%d, %d): expected partial TooManyTokensError, got %v", max, size, err)
+			must(t, tooMany.Max == max && tooMany.Count > max, "encodeReader(max=%d, %d): error %v", max, size, err)
+			must(t, reflect.DeepEqual(got, wantErr), "encodeReader(max=%d, %d) = %v, want %v", max, size, got, wantErr)
+			decoded, _ := bpe.Decode(got)
+			must(t, decoded == input[:partial.Offset] && partial.Offset == tooMany.Offset, "encodeReader(max=%d, %d): partial offset %d", max, size, partial.Offset)
+		}
+	}
+}
+
+func TestBPETokenizer_HealPrompt(t *testing.T) {
+	bpe, err := getBabyBPETokenizer(false, []string{babyEndOfTextString})
+	must(t, err == nil, "init bpe: %v", err)
+
+	// " a" is the last token of "a a"; candidates are all tokens starting with it
+	healed, err := bpe.HealPrompt("a a")
+	must(t, err == nil, "HealPrompt: %v", err)
+	must(t, reflect.DeepEqual(healed.Tokens, []int{64}), "HealPrompt().Tokens = %#v, want []int{64}", healed.Tokens)
+	must(t, healed.Removed == " a", "HealPrompt().Removed = %q, want \" a\"", healed.Removed)
+	must(t, reflect.DeepEqual(healed.Candidates, bpe.TokensWithPrefix(" a")), "HealPrompt().Candidates = %#v", healed.Candidates)
+	must(t, len(healed.Candidates) > 1, "HealPrompt().Candidates should include longer tokens than \" a\"")
+
+	// nothing is removed from empty prompts or prompts ending in special tokens
+	for _, prompt := range []string{"", "a" + babyEndOfTextString} {
+		healed, err = bpe.HealPrompt(prompt)
+		must(t, err == nil, "HealPrompt(%q): %v", prompt, err)
+		must(t, healed.Removed == "" && healed.Candidates == nil, "HealPrompt(%q) removed %q", prompt, healed.Removed)
+	}
+
+	// errors from Encode are returned
+	bpe2, err := getBabyBPETokenizer(false, nil)
+	must(t, err == nil, "init bpe: %v", err)
+	_, err = bpe2.HealPrompt(babyEndOfTextString)
+	must(t, errors.Is(err, gotoken.ErrSpecialToken), "HealPrompt(): expected ErrSpecialToken, got %v", err)
+}
+
+func TestBPETokenizer_PrefixTokens(t *testing.T) {
+	bpe, err := getBabyBPETokenizer(false, nil)
+	must(t, err == nil, "init bpe: %v", err)
+
+	// every prefix token decodes to a prefix of the input, the last is the
+	// longest, and each appears in TokensWithPrefix of its own text
+	for _, input := range []string{" the weather", "another", "a", "\u2019s"} {
+		tokens := bpe.PrefixTokens(input)
+		must(t, len(tokens) > 0, "PrefixTokens(%q) found no tokens", input)
+		prev := 0
+		for _, token := range tokens {
+			str, err := bpe.Decode([]int{token})
+			must(t, err == nil && strings.HasPrefix(input, str) && len(str) > prev, "PrefixTokens(%q): token %d is %q", input, token, str)
+			prev = len(str)
+		}
+		token, length := bpe.LongestPrefixToken(input)
+		must(t, token == tokens[len(tokens)-1] && length == prev, "LongestPrefixToken(%q) = %d, %d, want %d, %d", input, token, length, tokens[len(tokens)-1], prev)
+	}
+	token, length := bpe.LongestPrefixToken("")
+	must(t, token == -1 && length == 0, "LongestPrefixToken(\"\") = %d, %d, want -1, 0", token, length)
+	_, length = bpe.LongestPrefixToken(babyEndOfTextString)
+	must(t, length < len(babyEndOfTextString), "LongestPrefixToken() should not match special tokens")
+}
+
+func TestBPETokenizer_Explain(t *testing.T) {
+	bpe, err := getBabyBPETokenizer(false, []string{babyEndOfTextString})
+	must(t, err == nil, "init bpe: %v", err)
+
+	inputs := []string{"", "a", "Write 3 knock-knock jokes.", "• Bulleted \n• List\n", "For your information" + babyEndOfTextString}
+	for _, input := range inputs {
+		pieces, err := bpe.Explain(input)
+		must(t, err == nil, "Explain(%q): %v", input, err)
+		want, _ := bpe.Encode(input)
+
+		// the pieces must cover the input and produce the same tokens as Encode
+		var text string
+		got := []int{}
+		for _, piece := range pieces {
+			text += piece.Text
+			got = append(got, piece.Tokens...)
+			if piece.Special {
+				continue
+			}
+
+			// replaying the merges on ByteTokens must produce Tokens, unless the
+			// piece was encoded directly
+			if len(piece.Merges) == 0 {
+				must(t, len(piece.Tokens) == 1 || reflect.DeepEqual(piece.Tokens, piece.ByteTokens), "Explain(%q): piece %q has no merges", input, piece.Text)
+				continue
+			}
+			replay := append([]int(nil), piece.ByteTokens...)
+			starts := make([]int, len(replay))
+			for i := range starts {
+				starts[i] = i
+			}
+			for _, m := range piece.Merges {
+				i := 0
+				for starts[i] != m.Offset {
+					i++
+				}
+				must(t, replay[i] == m.Left && replay[i+1] == m.Right, "Explain(%q): merge %+v does not match tokens %v", input, m, replay)
+				replay = append(replay[:i+1], replay[i+2:]...)
+				starts = append(starts[:i+1], starts[i+2:]...)
+				replay[i] = m.Result
+			}
+			must(t, reflect.DeepEqual(replay, piece.Tokens), "Explain(%q): replayed merges %v, want %v", input, replay, piece.Tokens)
+		}
+		must(t, text == input, "Explain(%q): pieces cover %q", input, text)
+		must(t, reflect.DeepEqual(got, want), "Explain(%q) tokens %v, Encode() = %v", input, got, want)
+	}
+
+	// disallowed special tokens return an error
+	bpe2, err := getBabyBPETokenizer(false, nil)
+	must(t, err == nil, "init bpe: %v", err)
+	_, err = bpe2.Explain(babyEndOfTextString)
+	must(t, errors.Is(err, gotoken.ErrSpecialToken), "Explain(): expected ErrSpecialToken, got %v", err)
+}
+
+func TestBPETokenizer_VocabIter(t *testing.T) {
+	bpe, err := getBabyBPETokenizer(false, nil)
+	must(t, err == nil, "init bpe: %v", err)
+
+	// every token is yielded in order, followed by the special tokens
+	next := 0
+	bpe.VocabIter()(func(token int, b []byte) bool {
+		if next < len(tokenList) {
+			must(t, token == next && string(b) == tokenList[next], "VocabIter() yielded (%d, %q), want (%d, %q)", token, b, next, tokenList[next])
+		} else {
+			must(t, token == babyEndOfTextToken && string(b) == babyEndOfTextString, "VocabIter() yielded (%d, %q), want special token", token, b)
+		}
+		next++
+		return true
+	})
+	must(t, next == len(tokenList)+1, "VocabIter() yielded %d tokens, want %d", next, len(tokenList)+1)
+
+	// stops when yield returns false
+	count := 0
+	bpe.VocabIter()(func(token int, b []byte) bool {
+		count++
+		return count < 10
+	})
+	must(t, count == 10, "VocabIter() did not stop after yield returned false")
 }
 
 func runBpeTests(t *testing.T, bpe *BPETokenizer, suiteName string, tests []bpeTest, expectErrors bool) {
@@ -142,16 +1053,259 @@ func runBpeTests(t *testing.T, bpe *BPETokenizer, suiteName string, tests []bpeT
 			if got2 != tt.text {
 				t.Errorf("BPETokenizer.Decode() = %q, want %q", got2, tt.text)
 			}
+
+			// test the uint32 variants
+			got32, err := bpe.Encode32(tt.text)
+			if err != nil {
+				t.Errorf("BPETokenizer.Encode32() error = %v", err)
+			}
+			if len(got32) != len(tt.tokens) {
+				t.Fatalf("BPETokenizer.Encode32() = %#v, want %#v", got32, tt.tokens)
+			}
+			for i := range got32 {
+				if int(got32[i]) != tt.tokens[i] {
+					t.Fatalf("BPETokenizer.Encode32() = %#v, want %#v", got32, tt.tokens)
+				}
+			}
+			if got3, err := bpe.Decode32(got32); err != nil || got3 != tt.text {
+				t.Errorf("BPETokenizer.Decode32() = %q, %v, want %q", got3, err, tt.text)
+			}
+
+			buf := []byte("prefix:")
+			buf, err = bpe.AppendDecode(buf, tt.tokens)
+			if err != nil || string(buf) != "prefix:"+tt.text {
+				t.Errorf("BPETokenizer.AppendDecode() = %q, %v, want %q", buf, err, "prefix:"+tt.text)
+			}
 		})
 	}
 }
 
+func TestBPETokenizer_CountOnly(t *testing.T) {
+	// a count-only build has no token strings
+	params := getBabyTokenizerParams()
+	params.DecoderMap = TokenList{}
+	bpe, err := NewBPETokenizer(params, gotoken.TokenizerOptions{})
+	must(t, err == nil, "NewBPETokenizer: %v", err)
+	full, err := getBabyBPETokenizer(false, nil)
+	must(t, err == nil, "init bpe: %v", err)
+
+	input := "hello, world! <|endoftext"
+	tokens, err := bpe.Encode(input)
+	must(t, err == nil, "Encode(%q): %v", input, err)
+	want, _ := full.Encode(input)
+	must(t, reflect.DeepEqual(tokens, want), "Encode(%q) = %v, want %v", input, tokens, want)
+	must(t, bpe.Count(input) == len(want), "Count(%q) = %d, want %d", input, bpe.Count(input), len(want))
+
+	_, err = bpe.Decode(tokens)
+	must(t, errors.Is(err, gotoken.ErrCountOnly), "Decode(): expected ErrCountOnly, got %v", err)
+	_, _, err = bpe.EncodeWithOffsets(input)
+	must(t, errors.Is(err, gotoken.ErrCountOnly), "EncodeWithOffsets(): expected ErrCountOnly, got %v", err)
+	_, err = bpe.EncodeSuffix(tokens, "a")
+	must(t, errors.Is(err, gotoken.ErrCountOnly), "EncodeSuffix(): expected ErrCountOnly, got %v", err)
+	_, err = bpe.HealPrompt(input)
+	must(t, errors.Is(err, gotoken.ErrCountOnly), "HealPrompt(): expected ErrCountOnly, got %v", err)
+}
+
+func TestBPETokenizer_EncodeAllocs(t *testing.T) {
+	// With scratch buffers pooled, steady-state encoding should allocate little
+	// besides the result, even with the piece cache disabled.
+	if raceEnabled {
+		t.Skip("sync.Pool randomly drops items when the race detector is enabled")
+	}
+	bpe, err := NewBPETokenizer(getBabyTokenizerParams(), gotoken.TokenizerOptions{CacheSize: -1})
+	must(t, err == nil, "init bpe: %v", err)
+	input := "Write 3 knock-knock jokes about the weather, please."
+	allocs := testing.AllocsPerRun(100, func() {
+		_, _ = bpe.Encode(input)
+	})
+	must(t, allocs <= 4, "Encode() made %v allocations, want <= 4", allocs)
+}
+
+func TestBPETokenizer_DecodeToken(t *testing.T) {
+	bpe, err := getBabyBPETokenizer(false, []string{babyEndOfTextString})
+	must(t, err == nil, "init bpe: %v", err)
+	tokens, err := bpe.Encode("Hello, world! " + babyEndOfTextString)
+	must(t, err == nil, "Encode: %v", err)
+	for _, token := range tokens {
+		want, err := bpe.Decode([]int{token, token})
+		must(t, err == nil, "Decode: %v", err)
+		got, err := bpe.DecodeToken(token)
+		must(t, err == nil && got+got == want, "DecodeToken(%d) = %q, %v; want %q", token, got, err, want[:len(want)/2])
+	}
+	_, err = bpe.DecodeToken(-1)
+	must(t, errors.Is(err, gotoken.ErrInvalidToken), "DecodeToken(-1): got error %v, want ErrInvalidToken", err)
+
+	// The strings come from the vocabulary, for DecodeToken and for Decode of
+	// a single token
+	if !raceEnabled {
+		allocs := testing.AllocsPerRun(100, func() {
+			_, _ = bpe.DecodeToken(tokens[0])
+			_, _ = bpe.Decode(tokens[:1])
+		})
+		must(t, allocs == 0, "DecodeToken() made %v allocations, want 0", allocs)
+	}
+
+	// The limits of the tokenizer still apply
+	strict, err := NewBPETokenizer(getBabyTokenizerParams(), gotoken.TokenizerOptions{StrictUTF8: true, MaxDecodeBytes: 3})
+	must(t, err == nil, "init bpe: %v", err)
+	var invalid *gotoken.InvalidUTF8Error
+	_, err = strict.DecodeToken(strict.ByteToken(0xe2))
+	must(t, errors.As(err, &invalid), "DecodeToken of a partial character: got error %v, want *InvalidUTF8Error", err)
+	var tooLarge *gotoken.DecodeTooLargeError
+	_, err = strict.DecodeToken(babyEndOfTextToken)
+	must(t, errors.As(err, &tooLarge), "DecodeToken of a long token: got error %v, want *DecodeTooLargeError", err)
+}
+
+func TestBPETokenizer_DecodeEach(t *testing.T) {
+	bpe, err := getBabyBPETokenizer(false, []string{babyEndOfTextString})
+	must(t, err == nil, "init bpe: %v", err)
+	input := "Hello • world! " + babyEndOfTextString
+	tokens, err := bpe.Encode(input)
+	must(t, err == nil, "Encode: %v", err)
+	pieces, err := bpe.DecodeEach(tokens)
+	must(t, err == nil && len(pieces) == len(tokens), "DecodeEach = %q, %v", pieces, err)
+	for i, token := range tokens {
+		want, _ := bpe.DecodeToken(token)
+		must(t, pieces[i] == want, "DecodeEach()[%d] = %q, want %q", i, pieces[i], want)
+	}
+	must(t, strings.Join(pieces, "") == input, "DecodeEach pieces join to %q, want %q", strings.Join(pieces, ""), input)
+
+	empty, err := bpe.DecodeEach(nil)
+	must(t, err == nil && len(empty) == 0, "DecodeEach(nil) = %q, %v", empty, err)
+	_, err = bpe.DecodeEach([]int{tokens[0], -1})
+	must(t, errors.Is(err, gotoken.ErrInvalidToken), "DecodeEach with invalid token: got error %v", err)
+
+	// With StrictUTF8, the pieces of a character may be invalid on their own,
+	// but not together
+	strict, err := NewBPETokenizer(getBabyTokenizerParams(), gotoken.TokenizerOptions{StrictUTF8: true, MaxDecodeBytes: 12})
+	must(t, err == nil, "init bpe: %v", err)
+	bullet := []int{strict.ByteToken(0xe2), strict.ByteToken(0x80), strict.ByteToken(0xa2)}
+	pieces, err = strict.DecodeEach(bullet)
+	must(t, err == nil && strings.Join(pieces, "") == "•", "DecodeEach(%v) = %q, %v", bullet, pieces, err)
+	var invalid *gotoken.InvalidUTF8Error
+	_, err = strict.DecodeEach(bullet[:2])
+	must(t, errors.As(err, &invalid), "DecodeEach of a partial character: got error %v, want *InvalidUTF8Error", err)
+	var tooLarge *gotoken.DecodeTooLargeError
+	_, err = strict.DecodeEach(tokens)
+	must(t, errors.As(err, &tooLarge), "DecodeEach over the limit: got error %v, want *DecodeTooLargeError", err)
+}
+
+func BenchmarkBPETokenizer_Encode(b *testing.B) {
+	samples, err := os.ReadFile("../testdata/samples.txt")
+	if err != nil {
+		b.Fatalf("reading samples: %v", err)
+	}
+	lines := strings.Split(string(samples), "\n")
+	for _, bc := range []struct {
+		name      string
+		cacheSize int
+	}{
+		{"cache", 0},
+		{"nocache", -1},
+	} {
+		b.Run(bc.name, func(b *testing.B) {
+			bpe, err := NewBPETokenizer(getBabyTokenizerParams(), gotoken.TokenizerOptions{AllowSpecialAsText: true, CacheSize: bc.cacheSize})
+			if err != nil {
+				b.Fatalf("init bpe: %v", err)
+			}
+			b.ReportAllocs()
+			b.SetBytes(int64(len(samples)))
+			for i := 0; i < b.N; i++ {
+				for _, line := range lines {
+					_, _ = bpe.Encode(line)
+				}
+			}
+		})
+	}
+}
+
+func TestBPETokenizer_mergeByHeap(t *testing.T) {
+	bpe, err := getBabyBPETokenizer(false, nil)
+	must(t, err == nil, "init bpe: %v", err)
+	defer func(old int) { heapMergeThreshold = old }(heapMergeThreshold)
+
+	// Both merge methods must perform the same merges in the same order
+	samples, err := os.ReadFile("../testdata/samples.txt")
+	must(t, err == nil, "reading samples: %v", err)
+	inputs := strings.Split(string(samples), "\n")
+	inputs = append(inputs, strings.Repeat("1", 1000), strings.Repeat("!?", 500), strings.Repeat("aab", 300), string(samples))
+	for _, input := range inputs {
+		heapMergeThreshold = len(input) + 1
+		scan := &encodeState{ex: &explainer{}}
+		want := bpe.applyBPE(nil, []byte(input), scan)
+		heapMergeThreshold = 0
+		heap := &encodeState{ex: &explainer{}}
+		got := bpe.applyBPE(nil, []byte(input), heap)
+		must(t, reflect.DeepEqual(got, want), "applyBPE(%q) with heap = %v, want %v", input, got, want)
+		must(t, reflect.DeepEqual(heap.ex.merges, scan.ex.merges), "applyBPE(%q) with heap performed different merges", input)
+	}
+}
+
+func BenchmarkBPETokenizer_applyBPE(b *testing.B) {
+	bpe, err := getBabyBPETokenizer(false, nil)
+	if err != nil {
+		b.Fatalf("init bpe: %v", err)
+	}
+	for _, n := range []int{16, 256, 4096} {
+		input := []byte(strings.Repeat("in", n/2))
+		b.Run(fmt.Sprint(n), func(b *testing.B) {
+			b.SetBytes(int64(n))
+			for i := 0; i < b.N; i++ {
+				bpe.applyBPE(nil, input, nil)
+			}
+		})
+	}
+
+	// The pieces of natural text, as split by Encode, which are mostly short
+	pieces, size := samplePieces(b)
+	b.Run("samples", func(b *testing.B) {
+		b.SetBytes(size)
+		var dst []int
+		for i := 0; i < b.N; i++ {
+			for _, piece := range pieces {
+				dst = bpe.applyBPE(dst[:0], piece, nil)
+			}
+		}
+	})
+}
+
+// sampleLines returns the lines of the test samples, without line breaks, and
+// their total length in bytes.
+func sampleLines(tb testing.TB) ([][]byte, int64) {
+	samples, err := os.ReadFile("../testdata/samples.txt")
+	if err != nil {
+		tb.Fatalf("reading samples: %v", err)
+	}
+	var size int64
+	var lines [][]byte
+	for _, line := range strings.Split(string(samples), "\n") {
+		lines = append(lines, []byte(line))
+		size += int64(len(line))
+	}
+	return lines, size
+}
+
+// samplePieces returns the pieces the lines of the test samples are split into
+// by GPT2SpanSplitter, and their total length in bytes.
+func samplePieces(tb testing.TB) ([][]byte, int64) {
+	lines, size := sampleLines(tb)
+	var pieces [][]byte
+	var spans []Span
+	for _, line := range lines {
+		spans = GPT2SpanSplitter(spans[:0], line)
+		for _, span := range spans {
+			pieces = append(pieces, line[span.Start:span.End])
+		}
+	}
+	return pieces, size
+}
+
 func TestBPETokenizer_applyBPE(t *testing.T) {
 	// This method is only used by Encode() and the tests above cover it 99%.
 	// The only additional test case is for empty input.
 	bpe, err := getBabyBPETokenizer(false, []string{})
 	must(t, err == nil, "init bpe: %v", err)
-	tokens := bpe.applyBPE([]byte{})
+	tokens := bpe.applyBPE(nil, []byte{}, nil)
 	if len(tokens) != 0 {
 		t.Errorf("BPETokenizer.ApplyBPE([]byte{}) = %#v, want nil or empty slice", tokens)
 	}
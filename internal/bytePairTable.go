@@ -0,0 +1,39 @@
+// Copyright 2023 Peter Hebert. Licensed under the MIT license.
+
+package internal
+
+// BytePairTable maps the pairs of bytes that are tokens to their tokens, to
+// kick off BPE and to encode two-byte pieces directly. A table of all 65,536
+// pairs would be mostly -1, because only a few thousand pairs are tokens, and
+// they start with fewer than 200 different bytes. So the table is split into
+// rows of 256 by the first byte of the pair, and first bytes that start no
+// tokens share one row of -1. A lookup is two array indexes, like the full
+// table, but the table is about a third the size.
+type BytePairTable struct {
+	rowOf [256]uint16 // the row for each first byte, where row 0 is all -1
+	rows  []int32     // rows of 256 tokens, or -1, indexed by the second byte
+}
+
+// NewBytePairTable builds a BytePairTable from pairs in the format of
+// EncodingData.BytePairLookup, left<<28|right<<20|token.
+func NewBytePairTable(pairs []int64) *BytePairTable {
+	t := &BytePairTable{rows: make([]int32, 256)}
+	for i := range t.rows {
+		t.rows[i] = -1
+	}
+	for _, pair := range pairs {
+		left, right := byte(pair>>28), byte(pair>>20)
+		if t.rowOf[left] == 0 {
+			t.rowOf[left] = uint16(len(t.rows) >> 8)
+			t.rows = append(t.rows, t.rows[:256]...)
+		}
+		t.rows[int(t.rowOf[left])<<8|int(right)] = int32(pair & 0xfffff)
+	}
+	return t
+}
+
+// Lookup returns the token for the bytes left and right, or -1 if they are not
+// a token.
+func (t *BytePairTable) Lookup(left, right byte) int {
+	return int(t.rows[int(t.rowOf[left])<<8|int(right)])
+}
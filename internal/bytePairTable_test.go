@@ -0,0 +1,32 @@
+// Copyright 2023 Peter Hebert. Licensed under the MIT license.
+
+package internal
+
+import "testing"
+
+func TestBytePairTable(t *testing.T) {
+	// compare against a full table of every pair, as built before BytePairTable
+	full := make([]int, 65536)
+	for i := range full {
+		full[i] = -1
+	}
+	for _, pair := range bytePairLookup {
+		full[pair>>20] = int(pair) & 0xfffff
+	}
+	table := NewBytePairTable(bytePairLookup)
+	for i, want := range full {
+		if got := table.Lookup(byte(i>>8), byte(i)); got != want {
+			t.Errorf("Lookup(%#x, %#x) = %d, want %d", i>>8, byte(i), got, want)
+		}
+	}
+
+	// every first byte of a pair has its own row, plus the shared row of -1
+	firsts := make(map[int64]bool)
+	for _, pair := range bytePairLookup {
+		firsts[pair>>28] = true
+	}
+	must(t, len(table.rows) == (len(firsts)+1)*256, "table has %d rows, want %d", len(table.rows)/256, len(firsts)+1)
+
+	empty := NewBytePairTable(nil)
+	must(t, empty.Lookup('a', 'b') == -1, "empty table found a token")
+}
@@ -1,33 +1,50 @@
 // Copyright 2023 Peter Hebert. Licensed under the MIT license.
 
-package cl100kbase
+package internal
 
 import (
 	"unicode"
 	"unicode/utf8"
 )
 
-// replacementChar is the Unicode replacement character, used internally as a
-// placeholder for broken UTF-8 code points
-const replacementChar = rune(0xfffd)
-
-// cl100KBaseSplitter is a SplitterFunc that implements the regex:
+// CL100KBaseSplitter implements the splitter function used by cl100k_base to
+// split text before byte-pair encoding. It implements the regex:
 // `(?i:'s|'t|'re|'ve|'m|'ll|'d)|[^\r\n\p{L}\p{N}]?\p{L}+|\p{N}{1,3}| ?[^\s\p{L}\p{N}]+[\r\n]*|\s*[\r\n]+|\s+(?!\S)|\s+`
-func cl100KBaseSplitter(input []byte) [][]byte {
+//
+// The parts of input are appended to dst, which may be nil, and the extended
+// slice is returned.
+func CL100KBaseSplitter(dst [][]byte, input []byte) [][]byte {
+	pos := 0
+	if dst == nil {
+		dst = make([][]byte, 0, len(input)/4)
+	}
+	for pos < len(input) {
+		matchLength := cl100kMatchLength(input[pos:])
+		dst = append(dst, input[pos:pos+matchLength])
+		pos += matchLength
+	}
+	return dst
+}
+
+// CL100KBaseSpanSplitter is like CL100KBaseSplitter, but appends the byte
+// offsets of each part to dst instead of subslices of input.
+func CL100KBaseSpanSplitter(dst []Span, input []byte) []Span {
 	pos := 0
-	matches := make([][]byte, 0, len(input)/4)
+	if dst == nil {
+		dst = make([]Span, 0, len(input)/4)
+	}
 	for pos < len(input) {
-		matchLength := getMatchLength(input[pos:])
-		matches = append(matches, input[pos:pos+matchLength])
+		matchLength := cl100kMatchLength(input[pos:])
+		dst = append(dst, Span{Start: pos, End: pos + matchLength})
 		pos += matchLength
 	}
-	return matches
+	return dst
 }
 
-// getMatchLength runs a match against "input" and returns the length of the
+// cl100kMatchLength runs a match against "input" and returns the length of the
 // match. Because of the construction of the regex, it always matches at least
 // one character. Must be called with a non-empty input.
-func getMatchLength(input []byte) int {
+func cl100kMatchLength(input []byte) int {
 	cc := len(input)
 	pos, next := 0, 0 // offset of current rune and next rune
 	var c rune        // current rune
@@ -63,7 +80,7 @@ func getMatchLength(input []byte) int {
 	// [^\r\n\p{L}\p{N}]?\p{L}+ ... first [^\p{L}]? is elided as it simplifies away
 	isLetter := unicode.IsLetter(c)
 	isNumber := unicode.IsNumber(c)
-	peek := replacementChar
+	peek := rune(replacementChar)
 	if next < cc {
 		peek, _ = utf8.DecodeRune(input[next:])
 	}
@@ -109,14 +126,25 @@ func getMatchLength(input []byte) int {
 		return pos
 	}
 
-	// |\s+(?!\S)|\s+
+	// \s*[\r\n]+ matches through the last line break in the run of white
+	// space, if there is one
+	lastBreak, lastStart := 0, 0 // end of the last line break, start of the last character
 	for pos < cc && unicode.IsSpace(c) {
+		if c == '\r' || c == '\n' {
+			lastBreak = next
+		}
+		lastStart = pos
 		consume()
 	}
-	if pos >= 2 && pos < cc && !unicode.IsSpace(c) {
+	if lastBreak > 0 {
+		return lastBreak
+	}
+
+	// |\s+(?!\S)|\s+
+	if lastStart > 0 && pos < cc {
 		// in a multi-space run, if there is a "next" non-space character, back
 		// up and save the last space to match with that character
-		return pos - 1
+		return lastStart
 	}
 
 	return pos
@@ -1,6 +1,6 @@
 // Copyright 2023 Peter Hebert. Licensed under the MIT license.
 
-package cl100kbase
+package internal
 
 import (
 	"reflect"
@@ -81,21 +81,41 @@ func TestCL100KBaseSplitter(t *testing.T) {
 			args: "Hello, World! How are you today? 🌍",
 			want: []string{"Hello", ",", " World", "!", " How", " are", " you", " today", "?", " 🌍"},
 		},
+		{
+			name: "indentation after a line break",
+			args: "x\n    y \n\tz",
+			want: []string{"x", "\n", "   ", " y", " \n", "\tz"},
+		},
+		{
+			name: "multi-byte spaces",
+			args: "a\u3000\u3000b\u00a0\u00a0",
+			want: []string{"a", "\u3000", "\u3000b", "\u00a0\u00a0"},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got := cl100KBaseSplitter([]byte(tt.args)); !reflect.DeepEqual(asStrings(got), tt.want) {
-				t.Errorf("CL100KBaseMatches() = %#v, want %#v", asStrings(got), tt.want)
+			if got := CL100KBaseSplitter(nil, []byte(tt.args)); !reflect.DeepEqual(asStrings(got), tt.want) {
+				t.Errorf("CL100KBaseSplitter() = %#v, want %#v", asStrings(got), tt.want)
+			}
+			if got := CL100KBaseSpanSplitter(nil, []byte(tt.args)); !reflect.DeepEqual(spansAsStrings(tt.args, got), tt.want) {
+				t.Errorf("CL100KBaseSpanSplitter() = %#v, want %#v", spansAsStrings(tt.args, got), tt.want)
 			}
 		})
 	}
 }
 
-// asStrings converts a slice of byte slices to a slice of strings.
-func asStrings(parts [][]byte) []string {
-	strings := make([]string, len(parts))
-	for i, part := range parts {
-		strings[i] = string(part)
-	}
-	return strings
+func BenchmarkCL100KBaseSplitter(b *testing.B) {
+	lines, size := sampleLines(b)
+	b.Run("bytes", func(b *testing.B) {
+		b.SetBytes(size)
+		var dst [][]byte
+		for i := 0; i < b.N; i++ {
+			for _, line := range lines {
+				dst = CL100KBaseSplitter(dst[:0], line)
+			}
+		}
+	})
+	b.Run("spans", func(b *testing.B) {
+		benchmarkSpanSplitter(b, CL100KBaseSpanSplitter, lines, size)
+	})
 }
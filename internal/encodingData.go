@@ -0,0 +1,356 @@
+// Copyright 2023 Peter Hebert. Licensed under the MIT license.
+
+package internal
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+)
+
+// EncodingData holds the generated tables for an encoding. The encoding
+// packages either embed these as Go literals in data.go, or as a blob, maybe
+// compressed, in the binary format written by MarshalEncodingData.
+type EncodingData struct {
+	Name           string    // name of the encoding, like "cl100k_base"
+	ByteToToken    []byte    // token values for each byte 0-255
+	TokenList      TokenList // strings for each token int
+	TokenTrie      []uint32  // serialized map[string]int of token string -> rank
+	TokenMPHSeeds  []uint32  // bucket seeds for an MPH of token string -> rank
+	BytePairLookup []int64   // left<<28|right<<20|token for two-byte tokens
+}
+
+// encodingDataMagic identifies the binary format of EncodingData. It is
+// followed by a version byte: 1 for the original format, which is still read,
+// or 2 for the current one.
+const encodingDataMagic = "gotoken"
+
+// The sections of the version 2 format. A section's ID is written in the
+// section table, so readers skip IDs they don't know and sections can be added
+// without a new version.
+const (
+	sectionByteToToken = 1 // ByteToToken, as raw bytes
+	sectionTokenList   = 2 // TokenList: count, count+1 offsets, then the strings
+	sectionTokenTrie   = 3 // TokenTrie, as uint32s
+	sectionMPHSeeds    = 4 // TokenMPHSeeds, as uint32s
+	sectionBytePairs   = 5 // BytePairLookup, as int64s
+)
+
+// sectionNames names the sections in errors.
+var sectionNames = map[byte]string{
+	sectionByteToToken: "byte table",
+	sectionTokenList:   "token list",
+	sectionTokenTrie:   "token trie",
+	sectionMPHSeeds:    "MPH seeds",
+	sectionBytePairs:   "byte pair table",
+}
+
+// errBadEncodingData is returned when unmarshaling data that is truncated or
+// otherwise not in the expected format.
+var errBadEncodingData = errors.New("malformed encoding data")
+
+// MarshalEncodingData returns d in a compact binary format, which can be
+// decoded with UnmarshalEncodingData. After the magic and version comes the
+// header: the encoding name, then a table of the sections that follow it, each
+// with its ID, length, and CRC-32 (IEEE) checksum. Lengths are uvarints, and
+// the tables of numbers in the sections are little-endian, so that loading
+// the data is mostly copying.
+func MarshalEncodingData(d *EncodingData) []byte {
+	le := binary.LittleEndian
+	var tmp [8]byte
+	putUint32s := func(buf *bytes.Buffer, table []uint32) {
+		for _, v := range table {
+			le.PutUint32(tmp[:], v)
+			buf.Write(tmp[:4])
+		}
+	}
+
+	type section struct {
+		id   byte
+		data []byte
+	}
+	var tokens, trie, seeds, pairs bytes.Buffer
+	putUint32s(&tokens, []uint32{uint32(d.TokenList.Len())})
+	if d.TokenList.Len() > 0 {
+		putUint32s(&tokens, d.TokenList.offsets)
+		tokens.WriteString(d.TokenList.data)
+	}
+	putUint32s(&trie, d.TokenTrie)
+	putUint32s(&seeds, d.TokenMPHSeeds)
+	for _, v := range d.BytePairLookup {
+		le.PutUint64(tmp[:], uint64(v))
+		pairs.Write(tmp[:])
+	}
+	sections := []section{
+		{sectionByteToToken, d.ByteToToken},
+		{sectionTokenList, tokens.Bytes()},
+		{sectionTokenTrie, trie.Bytes()},
+		{sectionMPHSeeds, seeds.Bytes()},
+		{sectionBytePairs, pairs.Bytes()},
+	}
+
+	var buf bytes.Buffer
+	var varint [binary.MaxVarintLen64]byte
+	putUvarint := func(v int) {
+		buf.Write(varint[:binary.PutUvarint(varint[:], uint64(v))])
+	}
+	buf.WriteString(encodingDataMagic)
+	buf.WriteByte(2)
+	putUvarint(len(d.Name))
+	buf.WriteString(d.Name)
+	putUvarint(len(sections))
+	for _, s := range sections {
+		buf.WriteByte(s.id)
+		putUvarint(len(s.data))
+		le.PutUint32(tmp[:], crc32.ChecksumIEEE(s.data))
+		buf.Write(tmp[:4])
+	}
+	for _, s := range sections {
+		buf.Write(s.data)
+	}
+	return buf.Bytes()
+}
+
+// UnmarshalEncodingData decodes data in the format written by
+// MarshalEncodingData, or in the version 1 format written by earlier versions
+// of gotoken-gen.
+func UnmarshalEncodingData(data []byte) (*EncodingData, error) {
+	if !bytes.HasPrefix(data, []byte(encodingDataMagic)) || len(data) == len(encodingDataMagic) {
+		return nil, fmt.Errorf("%w: bad header", errBadEncodingData)
+	}
+	r := dataReader{buf: data[len(encodingDataMagic)+1:]}
+	var d *EncodingData
+	switch version := data[len(encodingDataMagic)]; version {
+	case 1:
+		d = r.readV1()
+	case 2:
+		d = r.readV2()
+	default:
+		return nil, fmt.Errorf("%w: unsupported version %d", errBadEncodingData, version)
+	}
+	if r.err != nil {
+		return nil, r.err
+	}
+	if r.pos != len(r.buf) {
+		return nil, fmt.Errorf("%w: %d bytes of trailing data", errBadEncodingData, len(r.buf)-r.pos)
+	}
+	return d, nil
+}
+
+// readV2 reads the rest of data in the current format, after the version.
+func (r *dataReader) readV2() *EncodingData {
+	var d EncodingData
+	d.Name = string(r.next(r.length(1)))
+
+	// Read the section table, then check and decode each section
+	type section struct {
+		id     byte
+		length int
+		crc    uint32
+	}
+	sections := make([]section, r.length(6))
+	total := 0
+	for i := range sections {
+		sections[i].id = r.next(1)[0]
+		sections[i].length = r.length(1)
+		sections[i].crc = binary.LittleEndian.Uint32(r.next(4))
+		if total += sections[i].length; total > len(r.buf)-r.pos {
+			r.fail("truncated data")
+		}
+	}
+	seen := make(map[byte]bool)
+	for _, s := range sections {
+		if r.err != nil {
+			break
+		}
+		data := r.next(s.length)
+		name, known := sectionNames[s.id]
+		if !known {
+			continue
+		}
+		if seen[s.id] {
+			r.fail("duplicate " + name)
+			break
+		}
+		seen[s.id] = true
+		if crc32.ChecksumIEEE(data) != s.crc {
+			r.fail("checksum mismatch in " + name)
+			break
+		}
+		section := dataReader{buf: data}
+		switch s.id {
+		case sectionByteToToken:
+			d.ByteToToken = append([]byte(nil), data...)
+			section.pos = len(data)
+		case sectionTokenList:
+			d.TokenList = section.tokenList()
+		case sectionTokenTrie:
+			d.TokenTrie = section.fixedUint32s(len(data) / 4)
+		case sectionMPHSeeds:
+			d.TokenMPHSeeds = section.fixedUint32s(len(data) / 4)
+		case sectionBytePairs:
+			d.BytePairLookup = make([]int64, len(data)/8)
+			for i := range d.BytePairLookup {
+				d.BytePairLookup[i] = int64(binary.LittleEndian.Uint64(section.next(8)))
+			}
+		}
+		if section.err == nil && section.pos != len(data) {
+			section.fail("bad length")
+		}
+		if section.err != nil {
+			r.fail(fmt.Sprintf("%s in %s", section.err, name))
+		}
+	}
+	for id := byte(sectionByteToToken); id <= sectionBytePairs; id++ {
+		if !seen[id] && r.err == nil {
+			r.fail("missing " + sectionNames[id])
+		}
+	}
+	return &d
+}
+
+// tokenList reads a token list section: a uint32 count of tokens, then their
+// offsets if there are any, then the token strings.
+func (r *dataReader) tokenList() TokenList {
+	n := r.fixedUint32s(1)[0]
+	if n == 0 || r.err != nil {
+		return TokenList{}
+	}
+	if uint64(n)+1 > uint64(len(r.buf)-r.pos)/4 {
+		r.fail("bad length")
+		return TokenList{}
+	}
+	offsets := r.fixedUint32s(int(n) + 1)
+	data := r.buf[r.pos:]
+	for i := 1; i < len(offsets); i++ {
+		if offsets[i] < offsets[i-1] {
+			r.fail("bad token offsets")
+			return TokenList{}
+		}
+	}
+	if offsets[0] != 0 || int(offsets[n]) != len(data) {
+		r.fail("bad token offsets")
+		return TokenList{}
+	}
+	r.pos = len(r.buf)
+	return NewTokenList(string(data), offsets)
+}
+
+// readV1 reads the rest of data in the version 1 format, after the version.
+// Lengths are uvarints, strings are raw bytes, and the tables of numbers are
+// little-endian, with no checksums.
+func (r *dataReader) readV1() *EncodingData {
+	var d EncodingData
+	d.Name = string(r.next(r.length(1)))
+	d.ByteToToken = append([]byte(nil), r.next(r.length(1))...)
+
+	// Read all of the token strings into one buffer, and note where each ends
+	offsets := make([]uint32, r.length(1)+1)
+	var all []byte
+	for i := 1; i < len(offsets); i++ {
+		all = append(all, r.next(r.length(1))...)
+		offsets[i] = uint32(len(all))
+	}
+	d.TokenList = NewTokenList(string(all), offsets)
+
+	d.TokenTrie = r.uint32s()
+	d.TokenMPHSeeds = r.uint32s()
+	d.BytePairLookup = make([]int64, r.length(8))
+	for i := range d.BytePairLookup {
+		d.BytePairLookup[i] = int64(binary.LittleEndian.Uint64(r.next(8)))
+	}
+	return &d
+}
+
+// InflateEncodingData decompresses gzipped data in the format written by
+// MarshalEncodingData, and decodes it with UnmarshalEncodingData. If data is
+// not gzipped, it is decoded as is.
+func InflateEncodingData(compressed []byte) (*EncodingData, error) {
+	if !bytes.HasPrefix(compressed, []byte{0x1f, 0x8b}) {
+		return UnmarshalEncodingData(compressed)
+	}
+	zr, err := gzip.NewReader(bytes.NewReader(compressed))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errBadEncodingData, err)
+	}
+	data, err := io.ReadAll(zr)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errBadEncodingData, err)
+	}
+	return UnmarshalEncodingData(data)
+}
+
+// dataReader reads from an encoded EncodingData. After the first error, all
+// reads return zero values, so only the final err needs to be checked.
+type dataReader struct {
+	buf []byte
+	pos int
+	err error
+}
+
+// next returns the next n bytes of buf, or n zero bytes after an error. n must
+// have been checked by length, or be small.
+func (r *dataReader) next(n int) []byte {
+	if r.err != nil || n > len(r.buf)-r.pos {
+		r.fail("truncated data")
+		return make([]byte, n)
+	}
+	r.pos += n
+	return r.buf[r.pos-n : r.pos]
+}
+
+// length reads a uvarint count of items that are each at least size bytes,
+// and checks that that many could fit in the rest of buf.
+func (r *dataReader) length(size int) int {
+	if r.err != nil {
+		return 0
+	}
+	v, n := binary.Uvarint(r.buf[r.pos:])
+	if n <= 0 || v > uint64(len(r.buf)-r.pos-n)/uint64(size) {
+		r.fail("bad length")
+		return 0
+	}
+	r.pos += n
+	return int(v)
+}
+
+// uint32s reads a length-prefixed table of uint32s.
+func (r *dataReader) uint32s() []uint32 {
+	return r.fixedUint32s(r.length(4))
+}
+
+// fixedUint32s reads a table of n uint32s. n must have been checked by length,
+// or be small.
+func (r *dataReader) fixedUint32s(n int) []uint32 {
+	table := make([]uint32, n)
+	for i := range table {
+		table[i] = binary.LittleEndian.Uint32(r.next(4))
+	}
+	return table
+}
+
+func (r *dataReader) fail(msg string) {
+	if r.err == nil {
+		r.err = fmt.Errorf("%w: %s at offset %d", errBadEncodingData, msg, r.pos)
+	}
+}
+
+// Params returns BPEParams with the tables from d filled in, and the lookup
+// tables derived from them built. The caller sets Name, Splitter, and
+// SpecialTokens. The MPH is only built if d has MPH seeds.
+func (d *EncodingData) Params() BPEParams {
+	params := BPEParams{
+		ByteEncoder:    d.ByteToToken,
+		DecoderMap:     d.TokenList,
+		EncoderTrie:    d.TokenTrie,
+		BytePairLookup: NewBytePairTable(d.BytePairLookup),
+	}
+	if len(d.TokenMPHSeeds) > 0 {
+		params.EncoderMPH = NewMPH(d.TokenMPHSeeds, d.TokenList)
+	}
+	return params
+}
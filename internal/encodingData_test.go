@@ -0,0 +1,181 @@
+// Copyright 2023 Peter Hebert. Licensed under the MIT license.
+
+package internal
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"testing/fstest"
+)
+
+func getBabyEncodingData() *EncodingData {
+	return &EncodingData{
+		Name:           "baby",
+		ByteToToken:    byteToToken,
+		TokenList:      NewTokenListFromStrings(tokenList),
+		TokenTrie:      tokenTrie,
+		TokenMPHSeeds:  BuildMPHSeeds(tokenList),
+		BytePairLookup: bytePairLookup,
+	}
+}
+
+func TestEncodingData_Marshal(t *testing.T) {
+	want := getBabyEncodingData()
+	data := MarshalEncodingData(want)
+	got, err := UnmarshalEncodingData(data)
+	must(t, err == nil, "UnmarshalEncodingData: %v", err)
+	must(t, reflect.DeepEqual(got, want), "UnmarshalEncodingData did not round trip")
+
+	// compressed
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	zw.Write(data)
+	zw.Close()
+	got, err = InflateEncodingData(buf.Bytes())
+	must(t, err == nil, "InflateEncodingData: %v", err)
+	must(t, reflect.DeepEqual(got, want), "InflateEncodingData did not round trip")
+
+	// every truncation, and trailing garbage, must be an error and not a panic
+	for i := 0; i < len(data); i++ {
+		_, err := UnmarshalEncodingData(data[:i])
+		must(t, errors.Is(err, errBadEncodingData), "UnmarshalEncodingData(data[:%d]): got %v", i, err)
+	}
+	_, err = UnmarshalEncodingData(append(data, 0))
+	must(t, errors.Is(err, errBadEncodingData), "UnmarshalEncodingData with trailing data: got %v", err)
+	got, err = InflateEncodingData(data)
+	must(t, err == nil, "InflateEncodingData of uncompressed data: %v", err)
+	must(t, reflect.DeepEqual(got, want), "InflateEncodingData of uncompressed data did not round trip")
+	_, err = InflateEncodingData(buf.Bytes()[:buf.Len()-1])
+	must(t, errors.Is(err, errBadEncodingData), "InflateEncodingData of truncated data: got %v", err)
+}
+
+// marshalV1 returns d in the version 1 format, as MarshalEncodingData did
+// before the format had sections and checksums.
+func marshalV1(d *EncodingData) []byte {
+	var buf bytes.Buffer
+	buf.WriteString("gotoken\x01")
+	var tmp [binary.MaxVarintLen64]byte
+	putUvarint := func(v int) {
+		buf.Write(tmp[:binary.PutUvarint(tmp[:], uint64(v))])
+	}
+	putUvarint(len(d.Name))
+	buf.WriteString(d.Name)
+	putUvarint(len(d.ByteToToken))
+	buf.Write(d.ByteToToken)
+	putUvarint(d.TokenList.Len())
+	for i := 0; i < d.TokenList.Len(); i++ {
+		putUvarint(len(d.TokenList.Get(i)))
+		buf.WriteString(d.TokenList.Get(i))
+	}
+	for _, table := range [][]uint32{d.TokenTrie, d.TokenMPHSeeds} {
+		putUvarint(len(table))
+		for _, v := range table {
+			binary.LittleEndian.PutUint32(tmp[:], v)
+			buf.Write(tmp[:4])
+		}
+	}
+	putUvarint(len(d.BytePairLookup))
+	for _, v := range d.BytePairLookup {
+		binary.LittleEndian.PutUint64(tmp[:], uint64(v))
+		buf.Write(tmp[:8])
+	}
+	return buf.Bytes()
+}
+
+func TestEncodingData_Versions(t *testing.T) {
+	want := getBabyEncodingData()
+	got, err := UnmarshalEncodingData(marshalV1(want))
+	must(t, err == nil, "UnmarshalEncodingData of version 1: %v", err)
+	must(t, reflect.DeepEqual(got, want), "UnmarshalEncodingData of version 1 did not round trip")
+
+	data := MarshalEncodingData(want)
+	must(t, data[len(encodingDataMagic)] == 2, "MarshalEncodingData wrote version %d", data[len(encodingDataMagic)])
+	bad := append([]byte(nil), data...)
+	bad[len(encodingDataMagic)] = 3
+	_, err = UnmarshalEncodingData(bad)
+	must(t, errors.Is(err, errBadEncodingData), "UnmarshalEncodingData of version 3: got %v", err)
+
+	// flipping any bit of the last section must fail its checksum
+	bad = append([]byte(nil), data...)
+	bad[len(bad)-1] ^= 0x10
+	_, err = UnmarshalEncodingData(bad)
+	must(t, err != nil && strings.Contains(err.Error(), "checksum mismatch in byte pair table"),
+		"UnmarshalEncodingData of corrupted data: got %v", err)
+}
+
+func TestEncodingData_Sections(t *testing.T) {
+	d := getBabyEncodingData()
+	data := MarshalEncodingData(d)
+	header := len(encodingDataMagic) + 1 + 1 + len(d.Name) // magic, version, name
+	must(t, data[header] == 5, "MarshalEncodingData wrote %d sections", data[header])
+
+	// An unknown section is skipped, but a missing one is an error. Changing
+	// the ID of the MPH seeds section (the fourth) does both.
+	idPos := header + 1
+	for i := 0; i < 3; i++ {
+		_, n := binary.Uvarint(data[idPos+1:])
+		idPos += 1 + n + 4
+	}
+	must(t, data[idPos] == sectionMPHSeeds, "section 4 has ID %d", data[idPos])
+	bad := append([]byte(nil), data...)
+	bad[idPos] = 99
+	_, err := UnmarshalEncodingData(bad)
+	must(t, err != nil && strings.Contains(err.Error(), "missing MPH seeds"), "UnmarshalEncodingData without MPH seeds: got %v", err)
+	bad[idPos] = sectionTokenTrie
+	_, err = UnmarshalEncodingData(bad)
+	must(t, err != nil && strings.Contains(err.Error(), "duplicate token trie"), "UnmarshalEncodingData with two tries: got %v", err)
+
+	// an empty encoding round trips
+	empty := &EncodingData{Name: "empty"}
+	got, err := UnmarshalEncodingData(MarshalEncodingData(empty))
+	must(t, err == nil, "UnmarshalEncodingData of empty data: %v", err)
+	must(t, got.Name == "empty" && got.TokenList.Len() == 0 && len(got.TokenTrie) == 0, "UnmarshalEncodingData of empty data = %+v", got)
+}
+
+func TestEncodingData_Params(t *testing.T) {
+	params := getBabyEncodingData().Params()
+	want := getBabyTokenizerParams()
+	must(t, reflect.DeepEqual(params.BytePairLookup, want.BytePairLookup), "Params().BytePairLookup not correct")
+	must(t, params.EncoderMPH != nil, "Params().EncoderMPH not set")
+	for i, token := range tokenList {
+		must(t, params.EncoderMPH.Lookup([]byte(token)) == i, "Params().EncoderMPH.Lookup(%q) != %d", token, i)
+	}
+
+	params = (&EncodingData{TokenList: NewTokenListFromStrings(tokenList)}).Params()
+	must(t, params.EncoderMPH == nil, "Params().EncoderMPH set without seeds")
+}
+
+func TestExternalParams(t *testing.T) {
+	data := MarshalEncodingData(getBabyEncodingData())
+	path := filepath.Join(t.TempDir(), "baby.gotoken")
+	err := os.WriteFile(path, data, 0644)
+	must(t, err == nil, "writing data file: %v", err)
+	fsys := fstest.MapFS{"baby.gotoken": &fstest.MapFile{Data: data}}
+
+	want := getBabyTokenizerParams()
+	for _, tt := range []struct {
+		fsys fs.FS
+		path string
+	}{{nil, path}, {nil, path}, {fsys, "baby.gotoken"}} {
+		params, err := ExternalParams(tt.fsys, tt.path, "baby")
+		must(t, err == nil, "ExternalParams(%q): %v", tt.path, err)
+		must(t, params.Name == "baby", "ExternalParams(%q).Name = %q", tt.path, params.Name)
+		must(t, reflect.DeepEqual(params.BytePairLookup, want.BytePairLookup), "ExternalParams(%q).BytePairLookup not correct", tt.path)
+
+		_, err = ExternalParams(tt.fsys, tt.path, "not_baby")
+		must(t, err != nil, "ExternalParams(%q) with the wrong name did not fail", tt.path)
+	}
+
+	_, err = ExternalParams(nil, path+".missing", "baby")
+	must(t, errors.Is(err, fs.ErrNotExist), "ExternalParams() of a missing file: got %v", err)
+	_, err = ExternalParams(fstest.MapFS{"bad": &fstest.MapFile{Data: data[:10]}}, "bad", "baby")
+	must(t, errors.Is(err, errBadEncodingData), "ExternalParams() of a bad file: got %v", err)
+}
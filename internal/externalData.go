@@ -0,0 +1,73 @@
+// Copyright 2023 Peter Hebert. Licensed under the MIT license.
+
+package internal
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"sync"
+)
+
+var (
+	// externalParams caches the BPEParams loaded from files in the OS file
+	// system, by path, so that every tokenizer for a file shares its tables.
+	externalParams   = make(map[string]BPEParams)
+	externalParamsMu sync.Mutex
+)
+
+// ExternalParams returns BPEParams for the encoding data in the file at path,
+// which was written by gotoken-gen with the -datadir flag. The file is read from
+// fsys, or from the OS file system if fsys is nil. The data must be for the
+// encoding called name. As with [EncodingData.Params], the caller sets Name,
+// Splitter, and SpecialTokens.
+//
+// Files from the OS file system are only loaded once per path. Files from an
+// fs.FS are loaded on every call, since there's no general way to tell whether
+// two fs.FS values are the same.
+func ExternalParams(fsys fs.FS, path, name string) (BPEParams, error) {
+	if fsys != nil {
+		return loadExternalParams(fsys, path, name)
+	}
+
+	externalParamsMu.Lock()
+	defer externalParamsMu.Unlock()
+	if params, ok := externalParams[path]; ok {
+		if params.Name != name {
+			return BPEParams{}, fmt.Errorf("data file %s is for %q, not %q", path, params.Name, name)
+		}
+		return params, nil
+	}
+	params, err := loadExternalParams(nil, path, name)
+	if err != nil {
+		return BPEParams{}, err
+	}
+	externalParams[path] = params
+	return params, nil
+}
+
+// loadExternalParams reads and decodes the data file at path. Name is set in
+// the returned params, to record which encoding the data is for.
+func loadExternalParams(fsys fs.FS, path, name string) (BPEParams, error) {
+	var data []byte
+	var err error
+	if fsys != nil {
+		data, err = fs.ReadFile(fsys, path)
+	} else {
+		data, err = os.ReadFile(path)
+	}
+	if err != nil {
+		return BPEParams{}, fmt.Errorf("loading %s data: %w", name, err)
+	}
+
+	d, err := InflateEncodingData(data)
+	if err != nil {
+		return BPEParams{}, fmt.Errorf("loading %s data from %s: %w", name, path, err)
+	}
+	if d.Name != name {
+		return BPEParams{}, fmt.Errorf("data file %s is for %q, not %q", path, d.Name, name)
+	}
+	params := d.Params()
+	params.Name = name
+	return params, nil
+}
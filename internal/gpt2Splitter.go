@@ -12,16 +12,35 @@ const replacementChar = 0xfffd
 
 // GPT2Splitter implements the splitter function used by r50k_base and p50k_base
 // to split text before byte-pair encoding. It is located in the internal
-// package since it is shared between multiple encodings.
-func GPT2Splitter(input []byte) [][]byte {
+// package since it is shared between multiple encodings. The parts of input are
+// appended to dst, which may be nil, and the extended slice is returned.
+func GPT2Splitter(dst [][]byte, input []byte) [][]byte {
 	pos := 0
-	matches := make([][]byte, 0, len(input)/4)
+	if dst == nil {
+		dst = make([][]byte, 0, len(input)/4)
+	}
+	for pos < len(input) {
+		matchLength := getMatchLength(input[pos:])
+		dst = append(dst, input[pos:pos+matchLength])
+		pos += matchLength
+	}
+	return dst
+}
+
+// GPT2SpanSplitter is like GPT2Splitter, but appends the byte offsets of each
+// part to dst instead of subslices of input. This avoids the cost of a slice
+// header per part, and is the form used by BPETokenizer.
+func GPT2SpanSplitter(dst []Span, input []byte) []Span {
+	pos := 0
+	if dst == nil {
+		dst = make([]Span, 0, len(input)/4)
+	}
 	for pos < len(input) {
 		matchLength := getMatchLength(input[pos:])
-		matches = append(matches, input[pos:pos+matchLength])
+		dst = append(dst, Span{Start: pos, End: pos + matchLength})
 		pos += matchLength
 	}
-	return matches
+	return dst
 }
 
 // getMatchLength runs a match against "input" and returns the length of the
@@ -103,13 +122,15 @@ func getMatchLength(input []byte) int {
 	}
 
 	// "\s+(?!\S)|\s+"
+	lastStart := 0 // offset of the last white space character in the run
 	for pos < cc && unicode.IsSpace(c) {
+		lastStart = pos
 		consume()
 	}
-	if pos >= 2 && pos < cc && !unicode.IsSpace(c) {
+	if lastStart > 0 && pos < cc {
 		// in a multi-space run where there is a "next" character, back up and
 		// don't consume the last space, so it can match with that character
-		return pos - 1
+		return lastStart
 	}
 
 	return pos
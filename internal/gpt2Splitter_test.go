@@ -71,12 +71,20 @@ func TestGPT2Splitter(t *testing.T) {
 			args: "Hello, World! How are you today? 🌍",
 			want: []string{"Hello", ",", " World", "!", " How", " are", " you", " today", "?", " 🌍"},
 		},
+		{
+			name: "multi-byte spaces",
+			args: "a\u3000b\u00a0\u00a0x",
+			want: []string{"a", "\u3000", "b", "\u00a0", "\u00a0", "x"},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got := GPT2Splitter([]byte(tt.args)); !reflect.DeepEqual(asStrings(got), tt.want) {
+			if got := GPT2Splitter(nil, []byte(tt.args)); !reflect.DeepEqual(asStrings(got), tt.want) {
 				t.Errorf("GPT2Splitter() got %#v, want %#v", got, tt.want)
 			}
+			if got := GPT2SpanSplitter(nil, []byte(tt.args)); !reflect.DeepEqual(spansAsStrings(tt.args, got), tt.want) {
+				t.Errorf("GPT2SpanSplitter() = %#v, want %#v", spansAsStrings(tt.args, got), tt.want)
+			}
 		})
 	}
 }
@@ -89,3 +97,40 @@ func asStrings(parts [][]byte) []string {
 	}
 	return strings
 }
+
+// spansAsStrings converts the spans of input to a slice of strings.
+func spansAsStrings(input string, spans []Span) []string {
+	strings := make([]string, len(spans))
+	for i, span := range spans {
+		strings[i] = input[span.Start:span.End]
+	}
+	return strings
+}
+
+func BenchmarkGPT2Splitter(b *testing.B) {
+	lines, size := sampleLines(b)
+	b.Run("bytes", func(b *testing.B) {
+		b.SetBytes(size)
+		var dst [][]byte
+		for i := 0; i < b.N; i++ {
+			for _, line := range lines {
+				dst = GPT2Splitter(dst[:0], line)
+			}
+		}
+	})
+	b.Run("spans", func(b *testing.B) {
+		benchmarkSpanSplitter(b, GPT2SpanSplitter, lines, size)
+	})
+}
+
+// benchmarkSpanSplitter splits each of lines with split, reusing the output
+// slice as Encode does. size is the total length of lines in bytes.
+func benchmarkSpanSplitter(b *testing.B, split func(dst []Span, input []byte) []Span, lines [][]byte, size int64) {
+	b.SetBytes(size)
+	var dst []Span
+	for i := 0; i < b.N; i++ {
+		for _, line := range lines {
+			dst = split(dst[:0], line)
+		}
+	}
+}
@@ -0,0 +1,180 @@
+// Copyright 2023 Peter Hebert. Licensed under the MIT license.
+
+package internal
+
+import (
+	"encoding/binary"
+	"math/bits"
+	"sort"
+)
+
+// MPH is a minimal perfect hash table that acts as a read-only, precomputed
+// map[string]int from token strings to tokens. It is an alternative to the
+// serialized trie for looking up whole tokens: a lookup hashes the input twice
+// and compares it to one candidate token, instead of walking the trie one byte
+// at a time.
+//
+// The table is built with the "hash, displace, and compress" algorithm
+// described in http://cmph.sourceforge.net/papers/esa09.pdf. Keys are hashed
+// into buckets, and each bucket is assigned a seed that places its keys in
+// free slots of the second level. Finding the seeds is the slow part of
+// building the table, so gotoken-gen emits them as data, and [NewMPH] rebuilds the
+// second level from them.
+type MPH struct {
+	keys       TokenList // the token strings, indexed by token
+	level0     []uint32  // seed for each bucket, power of 2 size
+	level0Mask uint32
+	level1     []uint32 // token for each slot, power of 2 size >= len(keys)
+	level1Mask uint32
+}
+
+// BuildMPHSeeds finds the level-0 seeds of a minimal perfect hash over keys,
+// where keys are the token strings indexed by token. Empty keys, which are gaps
+// in the token numbering, are skipped. This is exported for use in gotoken-gen.
+func BuildMPHSeeds(keys []string) []uint32 {
+	n := 0
+	for _, k := range keys {
+		if k != "" {
+			n++
+		}
+	}
+	level0 := make([]uint32, nextPow2(n/4))
+	level0Mask := uint32(len(level0) - 1)
+	level1Mask := uint32(nextPow2(n) - 1)
+
+	// assign keys to buckets, then place the largest buckets first
+	buckets := make([][]int, len(level0))
+	for i, k := range keys {
+		if k != "" {
+			b := murmur3(0, k) & level0Mask
+			buckets[b] = append(buckets[b], i)
+		}
+	}
+	order := make([]int, len(buckets))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return len(buckets[order[i]]) > len(buckets[order[j]])
+	})
+
+	occupied := make([]bool, level1Mask+1)
+	var placed []uint32
+	for _, b := range order {
+		if len(buckets[b]) == 0 {
+			break
+		}
+		for seed := uint32(1); ; seed++ {
+			placed = placed[:0]
+			ok := true
+			for _, i := range buckets[b] {
+				slot := murmur3(seed, keys[i]) & level1Mask
+				if occupied[slot] {
+					ok = false
+					break
+				}
+				occupied[slot] = true
+				placed = append(placed, slot)
+			}
+			if ok {
+				level0[b] = seed
+				break
+			}
+			for _, slot := range placed {
+				occupied[slot] = false
+			}
+		}
+	}
+	return level0
+}
+
+// NewMPH builds an MPH from keys, the token strings indexed by token, and the
+// seeds returned by [BuildMPHSeeds] for the same keys.
+func NewMPH(seeds []uint32, keys TokenList) *MPH {
+	n := 0
+	for i := 0; i < keys.Len(); i++ {
+		if keys.Get(i) != "" {
+			n++
+		}
+	}
+	t := &MPH{
+		keys:       keys,
+		level0:     seeds,
+		level0Mask: uint32(len(seeds) - 1),
+		level1:     make([]uint32, nextPow2(n)),
+	}
+	t.level1Mask = uint32(len(t.level1) - 1)
+	for i := 0; i < keys.Len(); i++ {
+		if k := keys.Get(i); k != "" {
+			t.level1[t.slot(k)] = uint32(i)
+		}
+	}
+	return t
+}
+
+// slot returns the level-1 slot of a key.
+func (t *MPH) slot(key string) uint32 {
+	seed := t.level0[murmur3(0, key)&t.level0Mask]
+	return murmur3(seed, key) & t.level1Mask
+}
+
+// Lookup returns the token for input, or -1 if input is not a token.
+func (t *MPH) Lookup(input []byte) int {
+	token := t.level1[t.slot(string(input))]
+	if t.keys.Get(int(token)) != string(input) {
+		return -1
+	}
+	return int(token)
+}
+
+// nextPow2 returns the smallest power of 2 that is >= n, and at least 1.
+func nextPow2(n int) int {
+	if n <= 1 {
+		return 1
+	}
+	return 1 << bits.Len(uint(n-1))
+}
+
+// murmur3 returns the 32-bit Murmur3 hash of s. See
+// https://en.wikipedia.org/wiki/MurmurHash.
+func murmur3(seed uint32, s string) uint32 {
+	const (
+		c1 = 0xcc9e2d51
+		c2 = 0x1b873593
+	)
+	h := seed
+	l := len(s)
+	for ; len(s) >= 4; s = s[4:] {
+		k := binary.LittleEndian.Uint32([]byte(s[:4]))
+		k *= c1
+		k = bits.RotateLeft32(k, 15)
+		k *= c2
+		h ^= k
+		h = bits.RotateLeft32(h, 13)
+		h = h*5 + 0xe6546b64
+	}
+
+	var k uint32
+	switch len(s) {
+	case 3:
+		k ^= uint32(s[2]) << 16
+		fallthrough
+	case 2:
+		k ^= uint32(s[1]) << 8
+		fallthrough
+	case 1:
+		k ^= uint32(s[0])
+		k *= c1
+		k = bits.RotateLeft32(k, 15)
+		k *= c2
+		h ^= k
+	}
+
+	h ^= uint32(l)
+	h ^= h >> 16
+	h *= 0x85ebca6b
+	h ^= h >> 13
+	h *= 0xc2b2ae35
+	h ^= h >> 16
+	return h
+}
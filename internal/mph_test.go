@@ -0,0 +1,45 @@
+// Copyright 2023 Peter Hebert. Licensed under the MIT license.
+
+package internal
+
+import "testing"
+
+func TestMPH(t *testing.T) {
+	// leave a gap in the token numbering, like p50k_base has
+	keys := append([]string(nil), tokenList...)
+	keys[300] = ""
+
+	mph := NewMPH(BuildMPHSeeds(keys), NewTokenListFromStrings(keys))
+	for i, key := range keys {
+		if key == "" {
+			continue
+		}
+		got := mph.Lookup([]byte(key))
+		must(t, got == i, "Lookup(%q) = %d, want %d", key, got, i)
+	}
+	for _, key := range []string{"", tokenList[300], "not a token", "\xff\xff"} {
+		got := mph.Lookup([]byte(key))
+		must(t, got == -1, "Lookup(%q) = %d, want -1", key, got)
+	}
+}
+
+func TestMurmur3(t *testing.T) {
+	// test vectors from the reference implementation
+	tests := []struct {
+		seed uint32
+		s    string
+		want uint32
+	}{
+		{0, "", 0},
+		{1, "", 0x514e28b7},
+		{0, "a", 0x3c2569b2},
+		{0, "abc", 0xb3dd93fa},
+		{0, "abcd", 0x43ed676a},
+		{0, "Hello, world!", 0xc0363e43},
+		{0x9747b28c, "The quick brown fox jumps over the lazy dog", 0x2fa826cd},
+	}
+	for _, tt := range tests {
+		got := murmur3(tt.seed, tt.s)
+		must(t, got == tt.want, "murmur3(%#x, %q) = %#x, want %#x", tt.seed, tt.s, got, tt.want)
+	}
+}
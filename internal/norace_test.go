@@ -0,0 +1,9 @@
+// Copyright 2023 Peter Hebert. Licensed under the MIT license.
+
+//go:build !race
+
+package internal
+
+// raceEnabled is true when the race detector is enabled, which changes the
+// allocation behavior of sync.Pool.
+const raceEnabled = false
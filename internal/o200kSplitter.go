@@ -0,0 +1,217 @@
+// Copyright 2023 Peter Hebert. Licensed under the MIT license.
+
+package internal
+
+import (
+	"unicode"
+	"unicode/utf8"
+)
+
+// O200KBaseSpanSplitter implements the splitter function used by o200k_base to
+// split text before byte-pair encoding. It appends the byte offsets of each
+// part of input to dst, which may be nil, and returns the extended slice. It
+// implements the regex:
+//
+//	[^\r\n\p{L}\p{N}]?[\p{Lu}\p{Lt}\p{Lm}\p{Lo}\p{M}]*[\p{Ll}\p{Lm}\p{Lo}\p{M}]+(?i:'s|'t|'re|'ve|'m|'ll|'d)?|
+//	[^\r\n\p{L}\p{N}]?[\p{Lu}\p{Lt}\p{Lm}\p{Lo}\p{M}]+[\p{Ll}\p{Lm}\p{Lo}\p{M}]*(?i:'s|'t|'re|'ve|'m|'ll|'d)?|
+//	\p{N}{1,3}| ?[^\s\p{L}\p{N}]+[\r\n/]*|\s*[\r\n]+|\s+(?!\S)|\s+
+//
+// Unlike cl100k_base, words are split before runs of capital letters, so
+// "HelloWorld" is two parts, and contractions stay attached to their word.
+func O200KBaseSpanSplitter(dst []Span, input []byte) []Span {
+	pos := 0
+	if dst == nil {
+		dst = make([]Span, 0, len(input)/4)
+	}
+	for pos < len(input) {
+		matchLength := o200kMatchLength(input[pos:])
+		dst = append(dst, Span{Start: pos, End: pos + matchLength})
+		pos += matchLength
+	}
+	return dst
+}
+
+// o200kMatchLength returns the length in bytes of the match of the o200k_base
+// regex at the start of input. It always matches at least one byte. Must be
+// called with a non-empty input.
+func o200kMatchLength(input []byte) int {
+	c, size := decodeRune(input)
+
+	// The two word alternatives are tried in the regex's order: the first with
+	// and then without the optional prefix, then the second the same way.
+	// Without a prefix, c must be part of the word, which can only succeed when
+	// the prefix didn't if c is a mark.
+	hasPrefix := size < len(input) && c != '\r' && c != '\n' && !unicode.IsLetter(c) && !unicode.IsNumber(c)
+	var prefixed2 int
+	if hasPrefix {
+		end1, end2 := o200kWordEnds(input, size)
+		if end1 > 0 {
+			return o200kContraction(input, end1)
+		}
+		prefixed2 = end2
+	}
+	if end1, end2 := o200kWordEnds(input, 0); end1 > 0 {
+		return o200kContraction(input, end1)
+	} else if prefixed2 > 0 {
+		return o200kContraction(input, prefixed2)
+	} else if end2 > 0 {
+		return o200kContraction(input, end2)
+	}
+
+	// \p{N}{1,3}
+	if unicode.IsNumber(c) {
+		pos := size
+		for count := 1; count < 3 && pos < len(input); count++ {
+			r, n := decodeRune(input[pos:])
+			if !unicode.IsNumber(r) {
+				break
+			}
+			pos += n
+		}
+		return pos
+	}
+
+	// ` ?[^\s\p{L}\p{N}]+[\r\n/]*`
+	pos := 0
+	if c == ' ' && size < len(input) {
+		if r, _ := decodeRune(input[size:]); isO200KOther(r) {
+			pos = size
+		}
+	}
+	if r, _ := decodeRune(input[pos:]); isO200KOther(r) {
+		for pos < len(input) {
+			r, n := decodeRune(input[pos:])
+			if !isO200KOther(r) {
+				break
+			}
+			pos += n
+		}
+		for pos < len(input) && (input[pos] == '\r' || input[pos] == '\n' || input[pos] == '/') {
+			pos++
+		}
+		return pos
+	}
+
+	// What remains is a run of white space. `\s*[\r\n]+` matches through the
+	// last line break in the run, if there is one.
+	lastBreak, lastStart := 0, 0
+	for pos < len(input) {
+		r, n := decodeRune(input[pos:])
+		if !unicode.IsSpace(r) {
+			break
+		}
+		if r == '\r' || r == '\n' {
+			lastBreak = pos + n
+		}
+		lastStart = pos
+		pos += n
+	}
+	if lastBreak > 0 {
+		return lastBreak
+	}
+
+	// `\s+(?!\S)|\s+`: if the run is followed by something other than white
+	// space, leave its last character to be a prefix of that
+	if pos < len(input) && lastStart > 0 {
+		return lastStart
+	}
+	return pos
+}
+
+// o200kWordEnds returns the end of the match of each of the two word
+// alternatives of the o200k_base regex starting at start, without the
+// optional contraction, or 0 if that alternative doesn't match:
+//
+//	[\p{Lu}\p{Lt}\p{Lm}\p{Lo}\p{M}]*[\p{Ll}\p{Lm}\p{Lo}\p{M}]+
+//	[\p{Lu}\p{Lt}\p{Lm}\p{Lo}\p{M}]+[\p{Ll}\p{Lm}\p{Lo}\p{M}]*
+func o200kWordEnds(input []byte, start int) (end1, end2 int) {
+	// Match the run of upper-case letters, noting where the last one that is
+	// also in the lower-case class ends, for when the first alternative has to
+	// backtrack
+	pos, lastLower := start, 0
+	for pos < len(input) {
+		r, n := decodeRune(input[pos:])
+		if !isO200KUpper(r) {
+			break
+		}
+		pos += n
+		if isO200KLower(r) {
+			lastLower = pos
+		}
+	}
+	upperEnd := pos
+	for pos < len(input) {
+		r, n := decodeRune(input[pos:])
+		if !isO200KLower(r) {
+			break
+		}
+		pos += n
+	}
+
+	switch {
+	case pos > upperEnd:
+		end1 = pos
+	case lastLower > 0:
+		end1 = lastLower
+	}
+	if upperEnd > start {
+		end2 = pos
+	}
+	return end1, end2
+}
+
+// o200kContraction returns end extended by the length of a contraction like
+// "'ll" that follows it in input, if any. The regex matches these case
+// insensitively, which also lets 's' match 'ſ' (U+017F).
+func o200kContraction(input []byte, end int) int {
+	rest := input[end:]
+	if len(rest) < 2 || rest[0] != '\'' {
+		return end
+	}
+	switch rest[1] | 0x20 {
+	case 's', 't', 'm', 'd':
+		return end + 2
+	case 'r', 'v':
+		if len(rest) >= 3 && rest[2]|0x20 == 'e' {
+			return end + 3
+		}
+	case 'l':
+		if len(rest) >= 3 && rest[2]|0x20 == 'l' {
+			return end + 3
+		}
+	}
+	if len(rest) >= 3 && rest[1] == 0xc5 && rest[2] == 0xbf {
+		return end + 3 // "'ſ"
+	}
+	return end
+}
+
+// decodeRune is like utf8.DecodeRune, but returns replacementChar for invalid
+// UTF-8, which is consumed one byte at a time.
+func decodeRune(input []byte) (rune, int) {
+	if input[0] < utf8.RuneSelf {
+		return rune(input[0]), 1
+	}
+	return utf8.DecodeRune(input)
+}
+
+// isO200KUpper reports whether r is in [\p{Lu}\p{Lt}\p{Lm}\p{Lo}\p{M}].
+func isO200KUpper(r rune) bool {
+	if r < utf8.RuneSelf {
+		return 'A' <= r && r <= 'Z'
+	}
+	return unicode.In(r, unicode.Lu, unicode.Lt, unicode.Lm, unicode.Lo, unicode.M)
+}
+
+// isO200KLower reports whether r is in [\p{Ll}\p{Lm}\p{Lo}\p{M}].
+func isO200KLower(r rune) bool {
+	if r < utf8.RuneSelf {
+		return 'a' <= r && r <= 'z'
+	}
+	return unicode.In(r, unicode.Ll, unicode.Lm, unicode.Lo, unicode.M)
+}
+
+// isO200KOther reports whether r is in [^\s\p{L}\p{N}].
+func isO200KOther(r rune) bool {
+	return !unicode.IsSpace(r) && !unicode.IsLetter(r) && !unicode.IsNumber(r)
+}
@@ -0,0 +1,97 @@
+// Copyright 2023 Peter Hebert. Licensed under the MIT license.
+
+package internal
+
+import (
+	"math/rand"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestO200KBaseSplitter(t *testing.T) {
+	tests := []struct {
+		name string
+		args string
+		want []string
+	}{
+		{
+			name: "empty string",
+			args: "",
+			want: []string{},
+		},
+		{
+			name: "regular text",
+			args: "This is a regular sentence without high-maintenance contractions.",
+			want: []string{"This", " is", " a", " regular", " sentence", " without", " high", "-maintenance", " contractions", "."},
+		},
+		{
+			name: "contractions",
+			args: "I'm a test case, aren't I? I'd like to know if YOU'LL be able to tokenize me correctly.",
+			want: []string{"I'm", " a", " test", " case", ",", " aren't", " I", "?", " I'd", " like", " to", " know", " if", " YOU'LL", " be", " able", " to", " tokenize", " me", " correctly", "."},
+		},
+		{
+			name: "camel case",
+			args: "HelloWorld XMLHttpRequest iPhone",
+			want: []string{"Hello", "World", " XMLHttp", "Request", " i", "Phone"},
+		},
+		{
+			name: "numbers",
+			args: "I have 6 apples and 8 oranges,\r\n    ...or 1234 pieces of fruit.\n",
+			want: []string{"I", " have", " ", "6", " apples", " and", " ", "8", " oranges", ",\r\n", "   ", " ...", "or", " ", "123", "4", " pieces", " of", " fruit", ".\n"},
+		},
+		{
+			name: "paths and line breaks",
+			args: "see ./a/b//\n\n  done",
+			want: []string{"see", " ./", "a", "/b", "//\n\n", " ", " done"},
+		},
+		{
+			name: "multi-byte unicode characters",
+			args: "こんにちは、世界！",
+			want: []string{"こんにちは", "、世界", "！"},
+		},
+		{
+			name: "wide spaces",
+			args: "a　　b",
+			want: []string{"a", "　", "　b"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := O200KBaseSpanSplitter(nil, []byte(tt.args)); !reflect.DeepEqual(spansAsStrings(tt.args, got), tt.want) {
+				t.Errorf("O200KBaseSpanSplitter() = %#v, want %#v", spansAsStrings(tt.args, got), tt.want)
+			}
+			if got := referenceSplit(o200kRegexp, tt.args); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("referenceSplit() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestO200KBaseSplitterRandom compares O200KBaseSpanSplitter with the regex it
+// implements, on random inputs drawn from characters of every class that the
+// regex distinguishes.
+func TestO200KBaseSplitterRandom(t *testing.T) {
+	alphabet := []rune("aZ09 \t\r\n'sSlLreEvd/.-! 　ſǅʰא́٣Ⅰ\U0001f600�")
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 20000; i++ {
+		var sb strings.Builder
+		for j := rng.Intn(20); j > 0; j-- {
+			if rng.Intn(50) == 0 {
+				sb.WriteByte(0xff) // invalid UTF-8
+				continue
+			}
+			sb.WriteRune(alphabet[rng.Intn(len(alphabet))])
+		}
+		input := sb.String()
+		got := spansAsStrings(input, O200KBaseSpanSplitter(nil, []byte(input)))
+		if want := referenceSplit(o200kRegexp, input); !reflect.DeepEqual(got, want) {
+			t.Fatalf("O200KBaseSpanSplitter(%q) = %#v, want %#v", input, got, want)
+		}
+	}
+}
+
+func BenchmarkO200KBaseSpanSplitter(b *testing.B) {
+	lines, size := sampleLines(b)
+	benchmarkSpanSplitter(b, O200KBaseSpanSplitter, lines, size)
+}
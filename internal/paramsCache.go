@@ -0,0 +1,58 @@
+// Copyright 2023 Peter Hebert. Licensed under the MIT license.
+
+package internal
+
+import (
+	"sync"
+
+	"github.com/peterheb/gotoken"
+)
+
+// ParamsCache holds the complete BPEParams of one encoding, so that every
+// tokenizer of the encoding shares one *BPEParams, and the tables that
+// NewBPETokenizer derives from it, instead of each holding its own copy. An
+// encoding package keeps one ParamsCache for each encoding it registers. The
+// zero ParamsCache is ready to use.
+type ParamsCache struct {
+	mu     sync.Mutex
+	byPath map[string]*BPEParams // by data file path, or "" for embedded data
+}
+
+// Get returns the BPEParams for the data selected by opts: the embedded data,
+// or the data file named by gotoken.WithDataFile. On first use, they are made
+// by build, which returns complete BPEParams, with Name, Splitter, and
+// SpecialTokens set. Errors are not cached, so a failed build is tried again
+// on the next call.
+//
+// Data files from an fs.FS are not cached, since there's no general way to
+// tell whether two fs.FS values are the same, and their tokenizers each get
+// their own BPEParams.
+func (c *ParamsCache) Get(opts gotoken.TokenizerOptions, build func() (BPEParams, error)) (*BPEParams, error) {
+	if opts.DataFS != nil {
+		return prepareParams(build)
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if params, ok := c.byPath[opts.DataPath]; ok {
+		return params, nil
+	}
+	params, err := prepareParams(build)
+	if err != nil {
+		return nil, err
+	}
+	if c.byPath == nil {
+		c.byPath = make(map[string]*BPEParams)
+	}
+	c.byPath[opts.DataPath] = params
+	return params, nil
+}
+
+// prepareParams calls build, and builds the derived tables of the result.
+func prepareParams(build func() (BPEParams, error)) (*BPEParams, error) {
+	params, err := build()
+	if err != nil {
+		return nil, err
+	}
+	params.derived = newDerivedTables(&params)
+	return &params, nil
+}
@@ -0,0 +1,96 @@
+// Copyright 2023 Peter Hebert. Licensed under the MIT license.
+
+package internal
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"testing/fstest"
+
+	"github.com/peterheb/gotoken"
+)
+
+func TestParamsCache(t *testing.T) {
+	var c ParamsCache
+	builds := 0
+	build := func() (BPEParams, error) {
+		builds++
+		return *getBabyTokenizerParams(), nil
+	}
+
+	p1, err := c.Get(gotoken.TokenizerOptions{}, build)
+	must(t, err == nil, "Get: %v", err)
+	p2, err := c.Get(gotoken.TokenizerOptions{AllowSpecialAsText: true}, build)
+	must(t, err == nil, "Get: %v", err)
+	must(t, p1 == p2, "Get: got different params for the same data")
+	must(t, builds == 1, "Get: build called %d times, want 1", builds)
+
+	// Tokenizers from the same params share everything derived from them,
+	// whatever their options
+	bpe1, err := NewBPETokenizer(p1, gotoken.TokenizerOptions{})
+	must(t, err == nil, "NewBPETokenizer: %v", err)
+	bpe2, err := NewBPETokenizer(p2, gotoken.TokenizerOptions{
+		AllowedSpecialTokens: []string{babyEndOfTextString},
+		CacheSize:            -1,
+	})
+	must(t, err == nil, "NewBPETokenizer: %v", err)
+	must(t, bpe1.params == bpe2.params, "tokenizers have different params")
+	must(t, bpe1.byteDecoder == bpe2.byteDecoder, "tokenizers have separate byteDecoders")
+	must(t, bpe1.specialTokens == bpe2.specialTokens, "tokenizers have separate special token matchers")
+	must(t, reflect.ValueOf(bpe1.decodeSpecialTokens).Pointer() == reflect.ValueOf(bpe2.decodeSpecialTokens).Pointer(),
+		"tokenizers have separate decodeSpecialTokens maps")
+	must(t, bpe1.decodeSpecialTokens[babyEndOfTextToken] == babyEndOfTextString, "decodeSpecialTokens not initialized")
+
+	// A different data file gets its own params
+	p3, err := c.Get(gotoken.TokenizerOptions{DataPath: "other.gotoken"}, build)
+	must(t, err == nil, "Get: %v", err)
+	must(t, p3 != p1 && builds == 2, "Get: data file shares params with embedded data")
+
+	// Files from an fs.FS aren't cached
+	opts := gotoken.TokenizerOptions{DataFS: fstest.MapFS{}, DataPath: "data.gotoken"}
+	p4, _ := c.Get(opts, build)
+	p5, _ := c.Get(opts, build)
+	must(t, p4 != p5 && builds == 4, "Get: cached params from an fs.FS")
+}
+
+func TestParamsCache_Error(t *testing.T) {
+	var c ParamsCache
+	errBuild := errors.New("build failed")
+	_, err := c.Get(gotoken.TokenizerOptions{}, func() (BPEParams, error) {
+		return BPEParams{}, errBuild
+	})
+	must(t, errors.Is(err, errBuild), "Get: got error %v, want %v", err, errBuild)
+
+	// The error is not cached
+	p, err := c.Get(gotoken.TokenizerOptions{}, func() (BPEParams, error) {
+		return *getBabyTokenizerParams(), nil
+	})
+	must(t, err == nil && p != nil, "Get after error: %v", err)
+}
+
+func BenchmarkNewBPETokenizer(b *testing.B) {
+	var c ParamsCache
+	params, err := c.Get(gotoken.TokenizerOptions{}, func() (BPEParams, error) {
+		return *getBabyTokenizerParams(), nil
+	})
+	if err != nil {
+		b.Fatal(err)
+	}
+	// The default options include the piece cache, so they show its cost
+	for _, bb := range []struct {
+		name string
+		opts gotoken.TokenizerOptions
+	}{
+		{"default", gotoken.TokenizerOptions{}},
+		{"allowed", gotoken.TokenizerOptions{AllowedSpecialTokens: []string{babyEndOfTextString}}},
+		{"nocache", gotoken.TokenizerOptions{AllowedSpecialTokens: []string{babyEndOfTextString}, CacheSize: -1}},
+	} {
+		b.Run(bb.name, func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				_, _ = NewBPETokenizer(params, bb.opts)
+			}
+		})
+	}
+}
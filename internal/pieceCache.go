@@ -0,0 +1,102 @@
+// Copyright 2023 Peter Hebert. Licensed under the MIT license.
+
+package internal
+
+import (
+	"container/list"
+	"sync"
+)
+
+// pieceCacheShards is the number of independently locked shards in a
+// pieceCache, to reduce lock contention between goroutines.
+const pieceCacheShards = 16
+
+// maxCachedPieceLen is the length in bytes of the longest piece that will be
+// cached. Longer pieces are rare, and caching them would mostly evict useful
+// entries.
+const maxCachedPieceLen = 64
+
+// pieceCache is a concurrency-safe LRU cache of the tokens for pieces of input
+// that were encoded with byte-pair merges. Natural text repeats the same words
+// constantly, so this avoids recomputing the merges for " the" millions of
+// times. The cache is split into shards by a hash of the key, each with its own
+// lock and LRU list.
+type pieceCache struct {
+	shards [pieceCacheShards]pieceCacheShard
+}
+
+type pieceCacheShard struct {
+	mu       sync.Mutex
+	capacity int
+	entries  map[string]*list.Element // nil until the first add
+	lru      list.List                // most recently used at the front
+}
+
+type pieceCacheEntry struct {
+	key    string
+	tokens []int32 // half the size of an []int, for the same number of entries
+}
+
+// newPieceCache returns a pieceCache that holds about size entries in total.
+// The map of each shard is allocated when it is first added to, so creating a
+// tokenizer stays cheap when it is only used for a few short calls.
+func newPieceCache(size int) *pieceCache {
+	c := &pieceCache{}
+	perShard := (size + pieceCacheShards - 1) / pieceCacheShards
+	for i := range c.shards {
+		c.shards[i].capacity = perShard
+	}
+	return c
+}
+
+// shard returns the shard for a key, chosen by its FNV-1a hash.
+func (c *pieceCache) shard(key []byte) *pieceCacheShard {
+	h := uint32(2166136261)
+	for _, b := range key {
+		h ^= uint32(b)
+		h *= 16777619
+	}
+	return &c.shards[h%pieceCacheShards]
+}
+
+// get appends the cached tokens for key to dst, if present, and returns the
+// extended slice. It returns dst unchanged and false if key is not cached.
+func (c *pieceCache) get(dst []int, key []byte) ([]int, bool) {
+	s := c.shard(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	elem, ok := s.entries[string(key)]
+	if !ok {
+		return dst, false
+	}
+	s.lru.MoveToFront(elem)
+	for _, token := range elem.Value.(*pieceCacheEntry).tokens {
+		dst = append(dst, int(token))
+	}
+	return dst, true
+}
+
+// add stores a copy of the tokens for key, evicting the least recently used
+// entry of its shard if the shard is full.
+func (c *pieceCache) add(key []byte, tokens []int) {
+	s := c.shard(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.entries[string(key)]; ok {
+		return
+	}
+	if s.entries == nil {
+		s.entries = make(map[string]*list.Element)
+	}
+	if s.lru.Len() >= s.capacity {
+		oldest := s.lru.Back()
+		s.lru.Remove(oldest)
+		delete(s.entries, oldest.Value.(*pieceCacheEntry).key)
+	}
+	k := string(key)
+	stored := make([]int32, len(tokens))
+	for i, token := range tokens {
+		stored[i] = int32(token)
+	}
+	s.entries[k] = s.lru.PushFront(&pieceCacheEntry{key: k, tokens: stored})
+}
@@ -0,0 +1,73 @@
+// Copyright 2023 Peter Hebert. Licensed under the MIT license.
+
+package internal
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+	"testing"
+)
+
+func TestPieceCache(t *testing.T) {
+	c := newPieceCache(pieceCacheShards) // one entry per shard
+	got, ok := c.get(nil, []byte("hello"))
+	must(t, !ok && got == nil, "get(hello) = %v, %v from an empty cache", got, ok)
+	for i := range c.shards {
+		must(t, c.shards[i].entries == nil, "shard %d allocated before the first add", i)
+	}
+	tokens := []int{1, 2}
+	c.add([]byte("hello"), tokens)
+	tokens[0] = 3 // the cache keeps its own copy
+	got, ok = c.get([]int{0}, []byte("hello"))
+	must(t, ok && reflect.DeepEqual(got, []int{0, 1, 2}), "get(hello) = %v, %v", got, ok)
+	got, ok = c.get([]int{0}, []byte("world"))
+	must(t, !ok && reflect.DeepEqual(got, []int{0}), "get(world) = %v, %v for an entry that was never added", got, ok)
+
+	// adding another key to the same shard evicts the first one
+	s := c.shard([]byte("hello"))
+	for i := 0; ; i++ {
+		key := []byte(fmt.Sprint(i))
+		if c.shard(key) == s {
+			c.add(key, []int{i})
+			break
+		}
+	}
+	_, ok = c.get(nil, []byte("hello"))
+	must(t, !ok, "get(hello) found an entry that should have been evicted")
+
+	// the least recently used entry is evicted first
+	c = newPieceCache(2 * pieceCacheShards)
+	var keys [][]byte
+	for i := 0; len(keys) < 3; i++ {
+		key := []byte(fmt.Sprint(i))
+		if c.shard(key) == c.shard([]byte("x")) {
+			keys = append(keys, key)
+		}
+	}
+	c.add(keys[0], []int{0})
+	c.add(keys[1], []int{1})
+	c.get(nil, keys[0])
+	c.add(keys[2], []int{2})
+	_, ok0 := c.get(nil, keys[0])
+	_, ok1 := c.get(nil, keys[1])
+	must(t, ok0 && !ok1, "expected %q to be evicted instead of %q", keys[1], keys[0])
+}
+
+func TestPieceCache_Concurrent(t *testing.T) {
+	c := newPieceCache(100)
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for i := 0; i < 1000; i++ {
+				key := []byte(fmt.Sprint((i * g) % 300))
+				if _, ok := c.get(nil, key); !ok {
+					c.add(key, []int{i})
+				}
+			}
+		}(g)
+	}
+	wg.Wait()
+}
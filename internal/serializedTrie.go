@@ -2,13 +2,22 @@
 
 package internal
 
+import "sort"
+
 // serializedTrie is a serialized trie that acts as a read-only, precomputed
-// map[string]int.
+// map[string]int. See the serialize method in cmd/gotoken-gen for the format.
 type serializedTrie []uint32
 
+// pathNode is set in the header of a path node, which stands for a chain of
+// nodes that each have one child and no token. The low 8 bits of its header
+// are the number of bytes in the path, n. It is followed by the first n-1
+// bytes, packed four to a uint32 with the first in the low bits, and then by a
+// child entry for the last byte, as in other nodes.
+const pathNode = 1 << 31
+
 // TrieLookup returns the index of the given input in a serialized trie. It
 // returns -1 if the input is not present, or its token# otherwise. This is
-// exported for use in gen.go.
+// exported for use in gotoken-gen.
 func TrieLookup(trie []uint32, input []byte) int {
 	return serializedTrie(trie).Lookup(input)
 }
@@ -20,15 +29,32 @@ func (trie serializedTrie) Lookup(input []byte) int {
 		return -1
 	}
 	pos := 0
-	trieValue := 0
-	for i, char := range input {
-		trieValue = int(trie[pos])
+	var trieValue uint32
+	for i := 0; i < len(input); i++ {
+		char := input[i]
+		trieValue = trie[pos]
 		pos++
-		childCount := trieValue & 0xff
-		if childCount == 0 {
+		childCount := int(trieValue & 0xff)
+		if trieValue&pathNode != 0 {
+			// Match the path, which has no tokens until its end. The last byte
+			// of the path is in the child entry after the packed bytes.
+			if childCount > len(input)-i {
+				return -1
+			}
+			for j, b := range input[i : i+childCount-1] {
+				if byte(trie[pos+j/4]>>(8*(j%4))) != b {
+					return -1
+				}
+			}
+			i += childCount - 1
+			trieValue = trie[pos+(childCount+2)/4]
+			if byte(trieValue) != input[i] {
+				return -1
+			}
+		} else if childCount == 0 {
 			// 0 means 256, which means a binary search is not necessary because
 			// all 256 children are present in order.
-			trieValue = int(trie[pos+int(char)])
+			trieValue = trie[pos+int(char)]
 		} else {
 			// Binary search the children for the character we are looking for
 			left := 0
@@ -52,7 +78,7 @@ func (trie serializedTrie) Lookup(input []byte) int {
 				return -1
 			}
 			// binary search successful
-			trieValue = int(trie[childIndex])
+			trieValue = trie[childIndex]
 		}
 
 		// trieValue contains serialized trie node in this format:
@@ -65,17 +91,265 @@ func (trie serializedTrie) Lookup(input []byte) int {
 		if trieValue&0x100 != 0 {
 			if i == len(input)-1 {
 				// If this was the last byte, we found the token! return token#
-				return trieValue >> 9
+				return int(trieValue >> 9)
 			}
 			// Otherwise, we ran out of trie before bytes: the token was not found
 			return -1
 		}
 
 		// Otherwise, continue traversing the trie at the specified child node
-		pos = trieValue >> 9
+		pos = int(trieValue >> 9)
 	}
 
 	// If we made it to the end of the input, then trie[pos] points to a node
-	// header. The high 20 bits contain (tokenIndex+1).
-	return int(trie[pos]>>8) - 1
+	// header. Bits 8-30 contain (tokenIndex+1), which is 0 for a path node.
+	return int(trie[pos]&^pathNode>>8) - 1
+}
+
+// TokensWithPrefix returns the token# of every token in a serialized trie that
+// begins with prefix, in ascending order. An empty prefix matches every token.
+// If no tokens match, nil is returned.
+func (trie serializedTrie) TokensWithPrefix(prefix []byte) []int {
+	// Walk the trie to the node that corresponds to prefix
+	pos := 0
+	for i := 0; i < len(prefix); i++ {
+		if header := trie[pos]; header&pathNode != 0 {
+			// If the prefix ends inside the path, everything under the path
+			// node matches if the prefix matches the path so far
+			n := int(header & 0xff)
+			path := trie.path(pos)
+			if rest := prefix[i:]; len(rest) < n {
+				if string(path[:len(rest)]) != string(rest) {
+					return nil
+				}
+				break
+			}
+			if string(path[:n-1]) != string(prefix[i:i+n-1]) {
+				return nil
+			}
+			i += n - 1
+		}
+		child, ok := trie.child(pos, prefix[i])
+		if !ok {
+			return nil
+		}
+		if child&0x100 != 0 {
+			// A leaf has no children, so it only matches as the last byte
+			if i == len(prefix)-1 {
+				return []int{int(child >> 9)}
+			}
+			return nil
+		}
+		pos = int(child >> 9)
+	}
+
+	// Everything under that node is a match
+	var ret []int
+	trie.collect(pos, &ret)
+	sort.Ints(ret)
+	return ret
+}
+
+// Prefixes returns the token# of every token in a serialized trie that is a
+// prefix of input, from shortest to longest. If no tokens match, nil is
+// returned.
+func (trie serializedTrie) Prefixes(input []byte) []int {
+	var ret []int
+	trie.walkPrefixes(input, func(token, _ int) {
+		ret = append(ret, token)
+	})
+	return ret
+}
+
+// LongestPrefix returns the token# of the longest token in a serialized trie
+// that is a prefix of input, and its length in bytes. It returns -1, 0 if no
+// token matches.
+func (trie serializedTrie) LongestPrefix(input []byte) (int, int) {
+	token, length := -1, 0
+	trie.walkPrefixes(input, func(t, n int) {
+		token, length = t, n
+	})
+	return token, length
+}
+
+// walkPrefixes follows input down the trie, calling fn with the token# and
+// length of each token it passes, from shortest to longest.
+func (trie serializedTrie) walkPrefixes(input []byte, fn func(token, length int)) {
+	pos := 0
+	for i := 0; i < len(input); i++ {
+		if header := trie[pos]; header&pathNode != 0 {
+			// Match the path, which has no tokens until its end
+			n := int(header & 0xff)
+			if n > len(input)-i {
+				return
+			}
+			for j, b := range input[i : i+n-1] {
+				if byte(trie[pos+1+j/4]>>(8*(j%4))) != b {
+					return
+				}
+			}
+			i += n - 1
+		}
+		child, ok := trie.child(pos, input[i])
+		if !ok {
+			return
+		}
+		if child&0x100 != 0 {
+			fn(int(child>>9), i+1)
+			return
+		}
+		pos = int(child >> 9)
+		if token := int(trie[pos]&^pathNode>>8) - 1; token >= 0 {
+			fn(token, i+1)
+		}
+	}
+}
+
+// trieWalker implements gotoken.TrieWalker over a serialized trie. It stands
+// at the node at pos, or inPath bytes into it if that is a path node, or at a
+// leaf if leaf is not -1.
+type trieWalker struct {
+	trie   serializedTrie
+	pos    int
+	inPath int
+	leaf   int
+	length int
+}
+
+// newTrieWalker returns a trieWalker at the root of trie.
+func newTrieWalker(trie serializedTrie) *trieWalker {
+	return &trieWalker{trie: trie, leaf: -1}
+}
+
+// Advance moves the walker to the child for b, and reports whether it exists.
+// If not, the walker is unchanged.
+func (w *trieWalker) Advance(b byte) bool {
+	if w.leaf >= 0 {
+		return false
+	}
+	if header := w.trie[w.pos]; header&pathNode != 0 && w.inPath < int(header&0xff)-1 {
+		// Match the next byte of the path, before the last one in its child
+		if byte(w.trie[w.pos+1+w.inPath/4]>>(8*(w.inPath%4))) != b {
+			return false
+		}
+		w.inPath++
+		w.length++
+		return true
+	}
+	child, ok := w.trie.child(w.pos, b)
+	if !ok {
+		return false
+	}
+	if child&0x100 != 0 {
+		w.leaf = int(child >> 9)
+	} else {
+		w.pos, w.inPath = int(child>>9), 0
+	}
+	w.length++
+	return true
+}
+
+// Token returns the token# of the walker's prefix, if it is a token.
+func (w *trieWalker) Token() (int, bool) {
+	if w.leaf >= 0 {
+		return w.leaf, true
+	}
+	if w.inPath > 0 {
+		return -1, false
+	}
+	token := int(w.trie[w.pos]&^pathNode>>8) - 1
+	return token, token >= 0
+}
+
+// Candidates returns the token# of every token that begins with the walker's
+// prefix, in ascending order.
+func (w *trieWalker) Candidates() []int {
+	if w.leaf >= 0 {
+		return []int{w.leaf}
+	}
+	var ret []int
+	w.trie.collect(w.pos, &ret)
+	sort.Ints(ret)
+	return ret
+}
+
+// Len returns the length of the walker's prefix in bytes.
+func (w *trieWalker) Len() int {
+	return w.length
+}
+
+// Reset moves the walker back to the root of the trie.
+func (w *trieWalker) Reset() {
+	w.pos, w.inPath, w.leaf, w.length = 0, 0, -1, 0
+}
+
+// path returns the bytes of the path node at pos, including the last byte,
+// which is stored in its child entry.
+func (trie serializedTrie) path(pos int) []byte {
+	n := int(trie[pos] & 0xff)
+	path := make([]byte, n)
+	for j := 0; j < n-1; j++ {
+		path[j] = byte(trie[pos+1+j/4] >> (8 * (j % 4)))
+	}
+	path[n-1] = byte(trie[pos+1+(n+2)/4])
+	return path
+}
+
+// child returns the serialized child entry for char in the node at pos. It
+// returns false if the node has no such child. For a path node, char is
+// compared to the last byte of the path.
+func (trie serializedTrie) child(pos int, char byte) (uint32, bool) {
+	childCount := int(trie[pos] & 0xff)
+	if trie[pos]&pathNode != 0 {
+		child := trie[pos+1+(childCount+2)/4]
+		return child, byte(child) == char
+	}
+	pos++
+	if childCount == 0 {
+		// 256-ary node, all children are present in order
+		return trie[pos+int(char)], true
+	}
+
+	// Binary search the children for char
+	left, right := 0, childCount
+	for left < right {
+		mid := left + (right-left)/2
+		if byte(trie[pos+mid]&0xff) < char {
+			left = mid + 1
+		} else {
+			right = mid
+		}
+	}
+	if left == childCount || byte(trie[pos+left]&0xff) != char {
+		return 0, false
+	}
+	return trie[pos+left], true
+}
+
+// collect appends the token# of the node at pos, and all of its descendants,
+// to tokens.
+func (trie serializedTrie) collect(pos int, tokens *[]int) {
+	header := trie[pos]
+	children := trie[pos+1:]
+	if header&pathNode != 0 {
+		// a path node has no token, and one child entry after the path
+		n := int(header & 0xff)
+		children = children[(n+2)/4 : (n+2)/4+1]
+	} else {
+		if token := int(header>>8) - 1; token >= 0 {
+			*tokens = append(*tokens, token)
+		}
+		childCount := int(header & 0xff)
+		if childCount == 0 {
+			childCount = 256
+		}
+		children = children[:childCount]
+	}
+	for _, child := range children {
+		if child&0x100 != 0 {
+			*tokens = append(*tokens, int(child>>9))
+		} else {
+			trie.collect(int(child>>9), tokens)
+		}
+	}
 }
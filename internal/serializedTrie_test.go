@@ -2,12 +2,16 @@
 
 package internal
 
-import "testing"
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
 
 // cspell:ignore abca
 
 func TestSerializedTrie_Lookup(t *testing.T) {
-	// this SerializedTrie was generated by commented-out lines in gen/trie_test.go
+	// this SerializedTrie was generated by commented-out lines in cmd/gotoken-gen/trie_test.go
 	nanoTrie := serializedTrie{3, 0x861, 0x362, 0x563, 0x102, 0x761, 0xe62, 0x501, 0xb63}
 	nanoWords := []string{"a", "b", "c", "aa", "ab", "abc"}   // all words in the trie
 	otherWords := []string{"", "d", "ac", "bd", "ca", "abca"} // some words not in the trie
@@ -41,3 +45,210 @@ func TestSerializedTrie_Lookup(t *testing.T) {
 		})
 	}
 }
+
+func TestSerializedTrie_Paths(t *testing.T) {
+	// this SerializedTrie, generated like nanoTrie, has path nodes for "el"
+	// and "copter"
+	pathTrie := serializedTrie{0x2, 0x668, 0x178, 0x80000002, 0x65, 0xc6c, 0x3, 0x1469, 0x1c6c, 0x770,
+		0x80000006, 0x74706f63, 0x65, 0x572, 0x1, 0x36f}
+	pathWords := []string{"x", "hello", "helicopter", "help"}
+	for token, word := range pathWords {
+		if got := pathTrie.Lookup([]byte(word)); got != token {
+			t.Errorf("SerializedTrie.Lookup(%q) = %v, want %v", word, got, token)
+		}
+	}
+	for _, word := range []string{"h", "he", "hel", "hex", "helico", "helicoptex", "helicopters", "hell"} {
+		if got := pathTrie.Lookup([]byte(word)); got != -1 {
+			t.Errorf("SerializedTrie.Lookup(%q) = %v, want -1", word, got)
+		}
+	}
+
+	tests := []struct {
+		prefix string
+		want   []int
+	}{
+		{"", []int{0, 1, 2, 3}},
+		{"h", []int{1, 2, 3}},
+		{"he", []int{1, 2, 3}},
+		{"hel", []int{1, 2, 3}},
+		{"hex", nil},
+		{"helic", []int{2}},
+		{"helicopter", []int{2}},
+		{"helicoptex", nil},
+		{"helicopters", nil},
+		{"helix", nil},
+	}
+	for _, tt := range tests {
+		if got := pathTrie.TokensWithPrefix([]byte(tt.prefix)); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("SerializedTrie.TokensWithPrefix(%q) = %v, want %v", tt.prefix, got, tt.want)
+		}
+	}
+}
+
+func TestSerializedTrie_TokensWithPrefix(t *testing.T) {
+	// same nanoTrie as above: {"a", "b", "c", "aa", "ab", "abc"}
+	nanoTrie := serializedTrie{3, 0x861, 0x362, 0x563, 0x102, 0x761, 0xe62, 0x501, 0xb63}
+	tests := []struct {
+		prefix string
+		want   []int
+	}{
+		{"", []int{0, 1, 2, 3, 4, 5}},
+		{"a", []int{0, 3, 4, 5}},
+		{"ab", []int{4, 5}},
+		{"abc", []int{5}},
+		{"b", []int{1}},
+		{"d", nil},
+		{"ac", nil},
+		{"abca", nil},
+	}
+	for _, tt := range tests {
+		t.Run("nanoTrie.TokensWithPrefix('"+tt.prefix+"')", func(t *testing.T) {
+			if got := nanoTrie.TokensWithPrefix([]byte(tt.prefix)); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("SerializedTrie.TokensWithPrefix(%q) = %v, want %v", tt.prefix, got, tt.want)
+			}
+		})
+	}
+
+	// check the baby tokenizer's trie against a brute-force search
+	babyTrie := serializedTrie(tokenTrie)
+	for _, prefix := range []string{"", " ", " a", "th", "\xe2\x80", "zz"} {
+		var want []int
+		for token, word := range tokenList {
+			if strings.HasPrefix(word, prefix) {
+				want = append(want, token)
+			}
+		}
+		if got := babyTrie.TokensWithPrefix([]byte(prefix)); !reflect.DeepEqual(got, want) {
+			t.Errorf("babyTrie.TokensWithPrefix(%q) = %v, want %v", prefix, got, want)
+		}
+	}
+}
+
+func TestSerializedTrie_Prefixes(t *testing.T) {
+	// same nanoTrie as above: {"a", "b", "c", "aa", "ab", "abc"}
+	nanoTrie := serializedTrie{3, 0x861, 0x362, 0x563, 0x102, 0x761, 0xe62, 0x501, 0xb63}
+	// same pathTrie as above: {"x", "hello", "helicopter", "help"}
+	pathTrie := serializedTrie{0x2, 0x668, 0x178, 0x80000002, 0x65, 0xc6c, 0x3, 0x1469, 0x1c6c, 0x770,
+		0x80000006, 0x74706f63, 0x65, 0x572, 0x1, 0x36f}
+	tests := []struct {
+		trie    serializedTrie
+		input   string
+		want    []int
+		longest int
+		length  int
+	}{
+		{nanoTrie, "", nil, -1, 0},
+		{nanoTrie, "a", []int{0}, 0, 1},
+		{nanoTrie, "abcd", []int{0, 4, 5}, 5, 3},
+		{nanoTrie, "aab", []int{0, 3}, 3, 2},
+		{nanoTrie, "ca", []int{2}, 2, 1},
+		{nanoTrie, "d", nil, -1, 0},
+		{pathTrie, "helicopters", []int{2}, 2, 10},
+		{pathTrie, "hello world", []int{1}, 1, 5},
+		{pathTrie, "helico", nil, -1, 0},
+		{pathTrie, "hex", nil, -1, 0},
+		{pathTrie, "xhello", []int{0}, 0, 1},
+	}
+	for _, tt := range tests {
+		if got := tt.trie.Prefixes([]byte(tt.input)); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("SerializedTrie.Prefixes(%q) = %v, want %v", tt.input, got, tt.want)
+		}
+		if token, length := tt.trie.LongestPrefix([]byte(tt.input)); token != tt.longest || length != tt.length {
+			t.Errorf("SerializedTrie.LongestPrefix(%q) = %v, %v, want %v, %v", tt.input, token, length, tt.longest, tt.length)
+		}
+	}
+
+	// check the baby tokenizer's trie against a brute-force search
+	babyTrie := serializedTrie(tokenTrie)
+	for _, input := range []string{" the", " another", "that's", "\xe2\x80\x99s", "zzz"} {
+		var want []int
+		for length := 1; length <= len(input); length++ {
+			for token, word := range tokenList {
+				if word == input[:length] {
+					want = append(want, token)
+				}
+			}
+		}
+		if got := babyTrie.Prefixes([]byte(input)); !reflect.DeepEqual(got, want) {
+			t.Errorf("babyTrie.Prefixes(%q) = %v, want %v", input, got, want)
+		}
+	}
+}
+
+func TestTrieWalker(t *testing.T) {
+	// same pathTrie as above: {"x", "hello", "helicopter", "help"}
+	pathTrie := serializedTrie{0x2, 0x668, 0x178, 0x80000002, 0x65, 0xc6c, 0x3, 0x1469, 0x1c6c, 0x770,
+		0x80000006, 0x74706f63, 0x65, 0x572, 0x1, 0x36f}
+	babyTrie := serializedTrie(tokenTrie)
+
+	// walking any input must agree with Lookup and TokensWithPrefix
+	for _, trie := range []serializedTrie{pathTrie, babyTrie} {
+		w := newTrieWalker(trie)
+		for _, input := range []string{"helicopters", "help", "hex", "x", " the", "that's", "\xe2\x80\x99s"} {
+			w.Reset()
+			for i := 0; i <= len(input); i++ {
+				prefix := []byte(input[:i])
+				want := trie.TokensWithPrefix(prefix)
+				if want == nil {
+					break
+				}
+				got := w.Candidates()
+				must(t, reflect.DeepEqual(got, want), "after %q, Candidates() = %v, want %v", prefix, got, want)
+				token, ok := w.Token()
+				wantToken := trie.Lookup(prefix)
+				must(t, token == wantToken && ok == (wantToken >= 0), "after %q, Token() = %d, %v, want %d", prefix, token, ok, wantToken)
+				must(t, w.Len() == i, "after %q, Len() = %d", prefix, w.Len())
+				if i < len(input) {
+					next := trie.TokensWithPrefix([]byte(input[:i+1])) != nil
+					must(t, w.Advance(input[i]) == next, "Advance(%q) after %q should return %v", input[i], prefix, next)
+				}
+			}
+		}
+	}
+
+	// a failed Advance leaves the walker where it was
+	w := newTrieWalker(pathTrie)
+	for _, b := range []byte("heli") {
+		w.Advance(b)
+	}
+	must(t, !w.Advance('x') && w.Len() == 4, "Advance('x') after \"heli\" should fail, Len() = %d", w.Len())
+	must(t, reflect.DeepEqual(w.Candidates(), []int{2}), "Candidates() after \"heli\" = %v", w.Candidates())
+	for _, b := range []byte("copter") {
+		must(t, w.Advance(b), "Advance(%q) failed", b)
+	}
+	must(t, !w.Advance('s'), "Advance() past a leaf should fail")
+}
+
+func BenchmarkTrieLookup(b *testing.B) {
+	params := getBabyTokenizerParams()
+	trie := []uint32(params.EncoderTrie)
+
+	// Every token in the vocabulary, which are all found
+	var tokens [][]byte
+	var tokensSize int64
+	for i := 0; i < params.DecoderMap.Len(); i++ {
+		tokens = append(tokens, []byte(params.DecoderMap.Get(i)))
+		tokensSize += int64(len(tokens[i]))
+	}
+	// The pieces of natural text, most of which are not found in the small
+	// vocabulary of the baby tokenizer
+	pieces, piecesSize := samplePieces(b)
+
+	for _, bc := range []struct {
+		name   string
+		inputs [][]byte
+		size   int64
+	}{
+		{"tokens", tokens, tokensSize},
+		{"pieces", pieces, piecesSize},
+	} {
+		b.Run(bc.name, func(b *testing.B) {
+			b.SetBytes(bc.size)
+			for i := 0; i < b.N; i++ {
+				for _, input := range bc.inputs {
+					TrieLookup(trie, input)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,150 @@
+// Copyright 2023 Peter Hebert. Licensed under the MIT license.
+
+package internal
+
+import (
+	"sort"
+	"strings"
+)
+
+// specialMatcher finds special tokens in text. It is an Aho-Corasick automaton
+// over the bytes of the tokens, so the cost of a search depends on the length
+// of the input and not on how many special tokens there are. Most of the input
+// is skipped with strings.IndexByte, since text rarely contains the bytes that
+// special tokens start with.
+type specialMatcher struct {
+	states    []matcherState
+	first     [256]bool // bytes that some pattern starts with
+	firstByte int       // the byte every pattern starts with, or -1
+	maxLen    int       // length of the longest pattern
+}
+
+// matcherState is a node in the trie of patterns, plus its failure link.
+type matcherState struct {
+	edges []matcherEdge // transitions to child states
+	fail  int           // state for the longest proper suffix that is in the trie
+	out   int           // length of the longest pattern ending in this state, or 0
+}
+
+type matcherEdge struct {
+	b    byte
+	next int
+}
+
+// newSpecialMatcher builds a specialMatcher for the given patterns. Empty
+// patterns are ignored. It returns nil if there are no patterns to match.
+func newSpecialMatcher(patterns []string) *specialMatcher {
+	patterns = append([]string(nil), patterns...)
+	sort.Strings(patterns)
+
+	m := &specialMatcher{states: make([]matcherState, 1), firstByte: -1}
+	for _, p := range patterns {
+		if p == "" {
+			continue
+		}
+		if !m.first[p[0]] {
+			m.first[p[0]] = true
+			if m.maxLen == 0 {
+				m.firstByte = int(p[0])
+			} else {
+				m.firstByte = -1
+			}
+		}
+		if len(p) > m.maxLen {
+			m.maxLen = len(p)
+		}
+		state := 0
+		for i := 0; i < len(p); i++ {
+			next := m.child(state, p[i])
+			if next == 0 {
+				next = len(m.states)
+				m.states = append(m.states, matcherState{})
+				m.states[state].edges = append(m.states[state].edges, matcherEdge{p[i], next})
+			}
+			state = next
+		}
+		m.states[state].out = len(p)
+	}
+	if m.maxLen == 0 {
+		return nil
+	}
+
+	// Set the failure links breadth-first, so that the links of shallower
+	// states are done before they are needed
+	queue := []int{0}
+	for len(queue) > 0 {
+		state := queue[0]
+		queue = queue[1:]
+		for _, e := range m.states[state].edges {
+			if state != 0 {
+				fail := m.step(m.states[state].fail, e.b)
+				m.states[e.next].fail = fail
+				if m.states[e.next].out == 0 {
+					m.states[e.next].out = m.states[fail].out
+				}
+			}
+			queue = append(queue, e.next)
+		}
+	}
+	return m
+}
+
+// child returns the child of state for byte b, or 0 if there isn't one.
+func (m *specialMatcher) child(state int, b byte) int {
+	for _, e := range m.states[state].edges {
+		if e.b == b {
+			return e.next
+		}
+	}
+	return 0
+}
+
+// step returns the state after consuming byte b in state.
+func (m *specialMatcher) step(state int, b byte) int {
+	for {
+		if next := m.child(state, b); next != 0 || state == 0 {
+			return next
+		}
+		state = m.states[state].fail
+	}
+}
+
+// index returns the byte offsets [start, end) of the leftmost pattern in s,
+// preferring the longest pattern if more than one starts there. It returns
+// (-1, -1) if no pattern is found.
+func (m *specialMatcher) index(s string) (start, end int) {
+	start, end = -1, -1
+	state := 0
+	for i := 0; i < len(s); i++ {
+		if state == 0 && start < 0 {
+			// skip ahead to the next byte that can start a match
+			if m.firstByte >= 0 {
+				j := strings.IndexByte(s[i:], byte(m.firstByte))
+				if j < 0 {
+					break
+				}
+				i += j
+			} else {
+				for i < len(s) && !m.first[s[i]] {
+					i++
+				}
+				if i == len(s) {
+					break
+				}
+			}
+		}
+		state = m.step(state, s[i])
+		if n := m.states[state].out; n > 0 {
+			// the longest match ending here is also the one that starts
+			// earliest; a later one that starts at the same place is longer
+			if matchStart := i + 1 - n; start < 0 || matchStart <= start {
+				start, end = matchStart, i+1
+			}
+		}
+		if start >= 0 && i+2-start > m.maxLen {
+			// no match ending after i can start at or before start
+			break
+		}
+	}
+	return start, end
+}
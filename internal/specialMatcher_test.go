@@ -0,0 +1,112 @@
+// Copyright 2023 Peter Hebert. Licensed under the MIT license.
+
+package internal
+
+import (
+	"math/rand"
+	"regexp"
+	"sort"
+	"strings"
+	"testing"
+)
+
+func TestSpecialMatcher(t *testing.T) {
+	tests := []struct {
+		name     string
+		patterns []string
+		input    string
+		want     string // the match, or "" for none
+		wantAt   int
+	}{
+		{"none", []string{"<|endoftext|>"}, "hello world", "", -1},
+		{"empty input", []string{"<|endoftext|>"}, "", "", -1},
+		{"whole", []string{"<|endoftext|>"}, "<|endoftext|>", "<|endoftext|>", 0},
+		{"middle", []string{"<|endoftext|>"}, "a <|endoftext|> b", "<|endoftext|>", 2},
+		{"first of two", []string{"<|endoftext|>"}, "<|x|><|endoftext|><|endoftext|>", "<|endoftext|>", 5},
+		{"prefix only", []string{"<|endoftext|>"}, "<|endoftext", "", -1},
+		{"restart after fail", []string{"<|endoftext|>"}, "<|end<|endoftext|>", "<|endoftext|>", 5},
+		{"two specials", []string{"<|fim_prefix|>", "<|fim_suffix|>"}, "x<|fim_suffix|>", "<|fim_suffix|>", 1},
+		{"longest at same start", []string{"ab", "abcd"}, "xabcdy", "abcd", 1},
+		{"longest needs more input", []string{"ab", "abcd"}, "xabcy", "ab", 1},
+		{"leftmost ends later", []string{"bc", "abcd"}, "abcd", "abcd", 0},
+		{"leftmost is shorter", []string{"a", "bcdef"}, "bcdefa", "bcdef", 0},
+		{"suffix of another", []string{"abcd", "cd"}, "abcx cd", "cd", 5},
+		{"different first bytes", []string{"<a>", "[b]"}, "xx [b] <a>", "[b]", 3},
+		{"empty pattern ignored", []string{"", "<a>"}, "x<a>", "<a>", 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := newSpecialMatcher(tt.patterns)
+			start, end := m.index(tt.input)
+			if start != tt.wantAt || (start >= 0 && tt.input[start:end] != tt.want) {
+				t.Errorf("index(%q) = %d, %d; want %q at %d", tt.input, start, end, tt.want, tt.wantAt)
+			}
+		})
+	}
+
+	if m := newSpecialMatcher(nil); m != nil {
+		t.Errorf("newSpecialMatcher(nil) = %v, want nil", m)
+	}
+}
+
+// TestSpecialMatcherRandom compares specialMatcher to a regexp that prefers the
+// longest match, on random inputs over a small alphabet.
+func TestSpecialMatcherRandom(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	randString := func(n int) string {
+		b := make([]byte, n)
+		for i := range b {
+			b[i] = "abc<"[rng.Intn(4)]
+		}
+		return string(b)
+	}
+
+	for i := 0; i < 200; i++ {
+		patterns := make([]string, 1+rng.Intn(5))
+		quoted := make([]string, len(patterns))
+		for j := range patterns {
+			patterns[j] = randString(1 + rng.Intn(5))
+		}
+		sort.Slice(patterns, func(a, b int) bool { return len(patterns[a]) > len(patterns[b]) })
+		for j, p := range patterns {
+			quoted[j] = regexp.QuoteMeta(p)
+		}
+		re := regexp.MustCompile(strings.Join(quoted, "|"))
+		m := newSpecialMatcher(patterns)
+
+		for j := 0; j < 20; j++ {
+			input := randString(rng.Intn(40))
+			want := re.FindStringIndex(input)
+			start, end := m.index(input)
+			if want == nil {
+				must(t, start == -1, "patterns %q: index(%q) = %d, %d; want no match", patterns, input, start, end)
+			} else {
+				must(t, start == want[0] && end == want[1], "patterns %q: index(%q) = %d, %d; want %d, %d", patterns, input, start, end, want[0], want[1])
+			}
+		}
+	}
+}
+
+func BenchmarkSpecialMatcher(b *testing.B) {
+	specials := []string{"<|endoftext|>", "<|fim_prefix|>", "<|fim_middle|>", "<|fim_suffix|>", "<|endofprompt|>"}
+	input := strings.Repeat("The quick brown fox jumps over the lazy dog. ", 20000) + specials[4]
+
+	b.Run("matcher", func(b *testing.B) {
+		m := newSpecialMatcher(specials)
+		b.SetBytes(int64(len(input)))
+		for i := 0; i < b.N; i++ {
+			m.index(input)
+		}
+	})
+	b.Run("regexp", func(b *testing.B) {
+		quoted := make([]string, len(specials))
+		for i, s := range specials {
+			quoted[i] = regexp.QuoteMeta(s)
+		}
+		re := regexp.MustCompile(strings.Join(quoted, "|"))
+		b.SetBytes(int64(len(input)))
+		for i := 0; i < b.N; i++ {
+			re.FindStringIndex(input)
+		}
+	})
+}
@@ -0,0 +1,106 @@
+// Copyright 2023 Peter Hebert. Licensed under the MIT license.
+
+package internal
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+	"unicode"
+	"unicode/utf8"
+)
+
+// The reference regexes of the splitters, without their last two
+// alternatives, `\s+(?!\S)|\s+`, since package regexp doesn't support
+// lookahead; referenceSplit handles those. \s is written out as the Unicode
+// white space that it matches in tiktoken.
+var (
+	gpt2Regexp = newReferenceRegexp(
+		`'s|'t|'re|'ve|'m|'ll|'d| ?\p{L}+| ?\p{N}+| ?[^\s\p{L}\p{N}]+`)
+	cl100kRegexp = newReferenceRegexp(
+		`(?i:'s|'t|'re|'ve|'m|'ll|'d)|[^\r\n\p{L}\p{N}]?\p{L}+|\p{N}{1,3}| ?[^\s\p{L}\p{N}]+[\r\n]*|[\s]*[\r\n]+`)
+	o200kRegexp = newReferenceRegexp(
+		`[^\r\n\p{L}\p{N}]?[\p{Lu}\p{Lt}\p{Lm}\p{Lo}\p{M}]*[\p{Ll}\p{Lm}\p{Lo}\p{M}]+(?i:'s|'t|'re|'ve|'m|'ll|'d)?` +
+			`|[^\r\n\p{L}\p{N}]?[\p{Lu}\p{Lt}\p{Lm}\p{Lo}\p{M}]+[\p{Ll}\p{Lm}\p{Lo}\p{M}]*(?i:'s|'t|'re|'ve|'m|'ll|'d)?` +
+			`|\p{N}{1,3}| ?[^\s\p{L}\p{N}]+[\r\n/]*|[\s]*[\r\n]+`)
+)
+
+// newReferenceRegexp compiles pattern, anchored, with \s expanded. \s must
+// only be used inside a character class.
+func newReferenceRegexp(pattern string) *regexp.Regexp {
+	return regexp.MustCompile(`^(?:` + strings.NewReplacer(`\s`, `\t\n\v\f\r \x{85}\p{Z}`).Replace(pattern) + `)`)
+}
+
+// referenceSplit splits input with re, one of the reference regexes above,
+// handling the white space that it doesn't match by hand.
+func referenceSplit(re *regexp.Regexp, input string) []string {
+	parts := []string{}
+	for input != "" {
+		n := len(re.FindString(input))
+		if n == 0 {
+			// `\s+(?!\S)|\s+`
+			var last int
+			for n < len(input) {
+				r, size := utf8.DecodeRuneInString(input[n:])
+				if !unicode.IsSpace(r) {
+					break
+				}
+				last = n
+				n += size
+			}
+			if n < len(input) && last > 0 {
+				n = last
+			}
+		}
+		parts = append(parts, input[:n])
+		input = input[n:]
+	}
+	return parts
+}
+
+// splitterSeeds are the seed inputs for the splitter fuzz targets.
+var splitterSeeds = []string{
+	"", "Hello, world!", "I'm a test case, aren't I? YOU'LL see.", "HelloWorld XMLHttpRequest",
+	"I have 6 apples,\r\n    ...or 1234 pieces.\n", "see ./a/b//\n\n  done", "a \n\t b",
+	"a　　b", "  x", "x   y", "こんにちは、世界！", "٣٤٥ Ⅰ ¼",
+	"\xff\xfe x\xc3", "  \n  \n", "'s'S'll ſ's", "\U0001f600\U0001f600 a",
+}
+
+// fuzzSplitter fails if split disagrees with referenceSplit on input.
+func fuzzSplitter(f *testing.F, split func(dst []Span, input []byte) []Span, re *regexp.Regexp) {
+	for _, seed := range splitterSeeds {
+		f.Add(seed)
+	}
+	f.Fuzz(func(t *testing.T, input string) {
+		got := spansAsStrings(input, split(nil, []byte(input)))
+		if want := referenceSplit(re, input); !equalStrings(got, want) {
+			t.Fatalf("split(%q) = %#v, want %#v", input, got, want)
+		}
+	})
+}
+
+// equalStrings reports whether a and b are equal, treating nil and empty the
+// same.
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func FuzzGPT2Splitter(f *testing.F) {
+	fuzzSplitter(f, GPT2SpanSplitter, gpt2Regexp)
+}
+
+func FuzzCL100KBaseSplitter(f *testing.F) {
+	fuzzSplitter(f, CL100KBaseSpanSplitter, cl100kRegexp)
+}
+
+func FuzzO200KBaseSplitter(f *testing.F) {
+	fuzzSplitter(f, O200KBaseSpanSplitter, o200kRegexp)
+}
@@ -4,8 +4,11 @@ package internal
 
 import (
 	"bufio"
+	"bytes"
+	"compress/gzip"
 	"encoding/json"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 )
@@ -16,42 +19,77 @@ type TestPair struct {
 	Expected []int
 }
 
-// TestPairReader reads a pair of text files that contain tokenization test cases.
-// The rows from the file are read one-by-one.
+// TestPairReader reads tokenization test cases from a pair of text files, or
+// from one JSONL file. The rows from the files are read one-by-one. Files that
+// are gzip-compressed are decompressed transparently.
 type TestPairReader struct {
 	inputScanner    *bufio.Scanner
-	expectedScanner *bufio.Scanner
-	inputF          *os.File
-	expectedF       *os.File
+	expectedScanner *bufio.Scanner // nil when reading JSONL
+	closers         []io.Closer
 	nameBase        string
 	line            int
 	isClosed        bool
 }
 
+// maxTestLine is the longest line a TestPairReader can read.
+const maxTestLine = 16 << 20
+
 // NewTestPairReader returns a new TestPairReader that reads from the given
 // input and expected files. The parameter inputFile must point to a text file
 // that contains one test case per line, and expectedFile should contain one
 // JSON-encoded array of integers per line.
 func NewTestPairReader(inputFile, expectedFile string) (*TestPairReader, error) {
-	inputF, err := os.Open(inputFile)
-	if err != nil {
+	tpr := &TestPairReader{nameBase: filepath.Base(inputFile)}
+	var err error
+	if tpr.inputScanner, err = tpr.open(inputFile); err != nil {
+		tpr.Close()
 		return nil, err
 	}
-	expectedF, err := os.Open(expectedFile)
-	if err != nil {
+	if tpr.expectedScanner, err = tpr.open(expectedFile); err != nil {
+		tpr.Close()
 		return nil, err
 	}
+	return tpr, nil
+}
 
-	inputScanner := bufio.NewScanner(inputF)
-	expectedScanner := bufio.NewScanner(expectedF)
+// NewJSONLTestPairReader returns a new TestPairReader that reads from a single
+// file with one JSON object per line, holding the input text and the expected
+// tokens of a test case:
+//
+//	{"text": "hello world", "tokens": [31373, 995]}
+//
+// Unlike the text files read by NewTestPairReader, the text may contain line
+// breaks.
+func NewJSONLTestPairReader(filename string) (*TestPairReader, error) {
+	tpr := &TestPairReader{nameBase: filepath.Base(filename)}
+	var err error
+	if tpr.inputScanner, err = tpr.open(filename); err != nil {
+		return nil, err
+	}
+	return tpr, nil
+}
+
+// open opens filename for reading by line, decompressing it if it starts with
+// the gzip header, and adds it to tpr.closers.
+func (tpr *TestPairReader) open(filename string) (*bufio.Scanner, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	tpr.closers = append(tpr.closers, f)
 
-	return &TestPairReader{
-		inputScanner:    inputScanner,
-		expectedScanner: expectedScanner,
-		inputF:          inputF,
-		expectedF:       expectedF,
-		nameBase:        filepath.Base(inputFile),
-	}, nil
+	var r io.Reader = bufio.NewReader(f)
+	if header, _ := r.(*bufio.Reader).Peek(2); bytes.Equal(header, []byte{0x1f, 0x8b}) {
+		zr, err := gzip.NewReader(r)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", filename, err)
+		}
+		tpr.closers = append(tpr.closers, zr)
+		r = zr
+	}
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(nil, maxTestLine)
+	return scanner, nil
 }
 
 // Next returns the next test case from the input and expected files. If the end
@@ -61,6 +99,27 @@ func (tpr *TestPairReader) Next() (*TestPair, error) {
 		return nil, nil
 	}
 
+	if tpr.expectedScanner == nil {
+		if tpr.inputScanner.Scan() {
+			tpr.line++
+			var row struct {
+				Text   string `json:"text"`
+				Tokens *[]int `json:"tokens"`
+			}
+			if err := json.Unmarshal(tpr.inputScanner.Bytes(), &row); err != nil {
+				return nil, fmt.Errorf("%s: %w", tpr.CaseName(), err)
+			}
+			if row.Tokens == nil {
+				return nil, fmt.Errorf("%s: no tokens", tpr.CaseName())
+			}
+			return &TestPair{
+				Input:    row.Text,
+				Expected: *row.Tokens,
+			}, nil
+		}
+		return nil, tpr.finish()
+	}
+
 	// Read a line from both scanners. If one of the files ends, then we are
 	// done.
 	if tpr.inputScanner.Scan() && tpr.expectedScanner.Scan() {
@@ -79,11 +138,21 @@ func (tpr *TestPairReader) Next() (*TestPair, error) {
 			Expected: expectedData,
 		}, nil
 	}
+	return nil, tpr.finish()
+}
 
-	// If we get here, one of the scanners reached EOF, so close the files and
-	// signal the caller.
+// finish closes the files after one of the scanners stopped, and returns the
+// error that stopped it, if it wasn't EOF.
+func (tpr *TestPairReader) finish() error {
+	err := tpr.inputScanner.Err()
+	if err == nil && tpr.expectedScanner != nil {
+		err = tpr.expectedScanner.Err()
+	}
 	tpr.Close()
-	return nil, nil
+	if err != nil {
+		return fmt.Errorf("%s: %w", tpr.CaseName(), err)
+	}
+	return nil
 }
 
 // Line returns the the 1-based line number of the last lines read from the
@@ -101,8 +170,9 @@ func (tpr *TestPairReader) CaseName() string {
 // closed reader.
 func (fp *TestPairReader) Close() {
 	if !fp.isClosed {
-		fp.inputF.Close()
-		fp.expectedF.Close()
+		for _, c := range fp.closers {
+			c.Close()
+		}
 		fp.isClosed = true
 	}
 }
@@ -3,6 +3,13 @@
 package internal
 
 import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -10,7 +17,7 @@ const (
 	testInput        = "../testdata/samples.txt"
 	testExpected     = "../testdata/r50k_base.txt"
 	testDoesNotExist = "../testdata/::does_not_exist::.txt"
-	testNotJOSN      = "../testdata/gen_ground_truth.py"
+	testNotJOSN      = "../testdata/README.md"
 	testInputLine    = "samples.txt#1"
 )
 
@@ -73,3 +80,125 @@ func TestTestPairReader(t *testing.T) {
 		}
 	}
 }
+
+// readAllPairs reads every test case from tpr, and closes it.
+func readAllPairs(t *testing.T, tpr *TestPairReader) ([]TestPair, error) {
+	t.Helper()
+	defer tpr.Close()
+	var pairs []TestPair
+	for {
+		tc, err := tpr.Next()
+		if err != nil {
+			return pairs, err
+		}
+		if tc == nil {
+			return pairs, nil
+		}
+		pairs = append(pairs, *tc)
+	}
+}
+
+// writeGzip writes data gzipped to a file in dir, and returns its path.
+func writeGzip(t *testing.T, dir, name string, data []byte) string {
+	t.Helper()
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	zw.Write(data)
+	zw.Close()
+	path := filepath.Join(dir, name)
+	if err := os.WriteFile(path, buf.Bytes(), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestTestPairReader_Formats(t *testing.T) {
+	tpr, err := NewTestPairReader(testInput, testExpected)
+	if err != nil {
+		t.Fatalf("opening test data: %v", err)
+	}
+	want, err := readAllPairs(t, tpr)
+	if err != nil || len(want) == 0 {
+		t.Fatalf("reading test data: %d cases, %v", len(want), err)
+	}
+
+	// the same pair of files, gzipped
+	dir := t.TempDir()
+	input, _ := os.ReadFile(testInput)
+	expected, _ := os.ReadFile(testExpected)
+	inputGz := writeGzip(t, dir, "samples.txt.gz", input)
+	expectedGz := writeGzip(t, dir, "r50k_base.txt.gz", expected)
+	for _, files := range [][2]string{{inputGz, expectedGz}, {inputGz, testExpected}} {
+		tpr, err := NewTestPairReader(files[0], files[1])
+		if err != nil {
+			t.Fatalf("opening %v: %v", files, err)
+		}
+		got, err := readAllPairs(t, tpr)
+		if err != nil || !reflect.DeepEqual(got, want) {
+			t.Errorf("reading %v: got %d cases, %v; expected the same as uncompressed", files, len(got), err)
+		}
+	}
+
+	// the same test cases as JSONL, plain and gzipped
+	var jsonl bytes.Buffer
+	for _, tc := range want {
+		line, _ := json.Marshal(map[string]any{"text": tc.Input, "tokens": tc.Expected})
+		jsonl.Write(line)
+		jsonl.WriteByte('\n')
+	}
+	jsonlPath := filepath.Join(dir, "r50k_base.jsonl")
+	if err := os.WriteFile(jsonlPath, jsonl.Bytes(), 0644); err != nil {
+		t.Fatal(err)
+	}
+	for _, path := range []string{jsonlPath, writeGzip(t, dir, "r50k_base.jsonl.gz", jsonl.Bytes())} {
+		tpr, err := NewJSONLTestPairReader(path)
+		if err != nil {
+			t.Fatalf("opening %s: %v", path, err)
+		}
+		got, err := readAllPairs(t, tpr)
+		if err != nil || !reflect.DeepEqual(got, want) {
+			t.Errorf("reading %s: got %d cases, %v; expected the same as the text files", path, len(got), err)
+		}
+		if name := tpr.CaseName(); !strings.HasPrefix(name, filepath.Base(path)+"#") {
+			t.Errorf("CaseName() = %q", name)
+		}
+	}
+}
+
+func TestTestPairReader_Errors(t *testing.T) {
+	dir := t.TempDir()
+	for _, tt := range []struct {
+		name, data string
+	}{
+		{"not JSON", "hello\n"},
+		{"no tokens", `{"text": "hello"}` + "\n"},
+		{"bad tokens", `{"text": "hello", "tokens": ["a"]}` + "\n"},
+	} {
+		path := filepath.Join(dir, "bad.jsonl")
+		os.WriteFile(path, []byte(tt.data), 0644)
+		tpr, err := NewJSONLTestPairReader(path)
+		if err != nil {
+			t.Fatalf("%s: opening: %v", tt.name, err)
+		}
+		if _, err := readAllPairs(t, tpr); err == nil {
+			t.Errorf("%s: expected an error", tt.name)
+		}
+	}
+
+	// a truncated gzip file is an error, not the end of the test cases
+	input, _ := os.ReadFile(testInput)
+	gz, _ := os.ReadFile(writeGzip(t, dir, "samples.txt.gz", input))
+	truncated := filepath.Join(dir, "truncated.txt.gz")
+	os.WriteFile(truncated, gz[:len(gz)/2], 0644)
+	tpr, err := NewTestPairReader(truncated, testExpected)
+	if err != nil {
+		t.Fatalf("opening truncated file: %v", err)
+	}
+	if _, err := readAllPairs(t, tpr); err == nil {
+		t.Errorf("reading a truncated gzip file: expected an error")
+	}
+
+	if _, err := NewJSONLTestPairReader(testDoesNotExist); err == nil {
+		t.Errorf("expected error loading non-existent test data")
+	}
+}
@@ -0,0 +1,46 @@
+// Copyright 2023 Peter Hebert. Licensed under the MIT license.
+
+package internal
+
+// TokenList is the strings for every token of an encoding, indexed by token.
+// Rather than a []string, which would need a string header per token, the
+// strings are concatenated into one backing string with a table of offsets.
+// Unused token numbers are empty strings.
+type TokenList struct {
+	data    string
+	offsets []uint32 // token i is data[offsets[i]:offsets[i+1]]
+}
+
+// NewTokenList returns a TokenList for data and offsets, as generated by
+// gotoken-gen. offsets has one more entry than there are tokens.
+func NewTokenList(data string, offsets []uint32) TokenList {
+	return TokenList{data: data, offsets: offsets}
+}
+
+// NewTokenListFromStrings returns a TokenList with the given token strings.
+func NewTokenListFromStrings(tokens []string) TokenList {
+	n := 0
+	for _, s := range tokens {
+		n += len(s)
+	}
+	data := make([]byte, 0, n)
+	offsets := make([]uint32, 1, len(tokens)+1)
+	for _, s := range tokens {
+		data = append(data, s...)
+		offsets = append(offsets, uint32(len(data)))
+	}
+	return TokenList{data: string(data), offsets: offsets}
+}
+
+// Len returns the number of tokens in the list.
+func (l TokenList) Len() int {
+	if len(l.offsets) == 0 {
+		return 0
+	}
+	return len(l.offsets) - 1
+}
+
+// Get returns the string for token i, which must be in [0, Len()).
+func (l TokenList) Get(i int) string {
+	return l.data[l.offsets[i]:l.offsets[i+1]]
+}
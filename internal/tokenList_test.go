@@ -0,0 +1,22 @@
+// Copyright 2023 Peter Hebert. Licensed under the MIT license.
+
+package internal
+
+import "testing"
+
+func TestTokenList(t *testing.T) {
+	tokens := []string{"a", "", "bc", "\xff", "def"}
+	list := NewTokenListFromStrings(tokens)
+	must(t, list.Len() == len(tokens), "Len() = %d, want %d", list.Len(), len(tokens))
+	for i, want := range tokens {
+		must(t, list.Get(i) == want, "Get(%d) = %q, want %q", i, list.Get(i), want)
+	}
+
+	list = NewTokenList("abcdef", []uint32{0, 1, 1, 3, 6})
+	for i, want := range []string{"a", "", "bc", "def"} {
+		must(t, list.Get(i) == want, "Get(%d) = %q, want %q", i, list.Get(i), want)
+	}
+
+	must(t, TokenList{}.Len() == 0, "zero TokenList has Len() %d", TokenList{}.Len())
+	must(t, NewTokenListFromStrings(nil).Len() == 0, "empty TokenList has Len() %d", NewTokenListFromStrings(nil).Len())
+}
@@ -0,0 +1,55 @@
+// Copyright 2023 Peter Hebert. Licensed under the MIT license.
+
+package gotoken
+
+import (
+	"fmt"
+	"strings"
+)
+
+// LogitBias builds a map of token IDs to bias values for the given words or
+// phrases, suitable for the logit_bias parameter of the OpenAI API. The API
+// accepts bias values from -100 (ban the token) to 100 (exclusively select the
+// token); bias values outside this range return an error.
+//
+// The same word is usually a different token depending on whether it follows a
+// space, so each phrase is encoded both with and without a leading space. For
+// example, "yes" and " yes" are both added to the map.
+//
+// Logit bias applies to individual tokens, not to phrases. A variant that
+// encodes to more than one token is skipped, since biasing its tokens would
+// also affect the unrelated words that contain them, like the " " token of
+// every leading-space variant. A description of each skipped variant is
+// returned, so that they can be reviewed and handled some other way.
+func LogitBias(tok Tokenizer, phrases []string, bias float64) (map[int]float64, []string, error) {
+	if bias < -100 || bias > 100 {
+		return nil, nil, fmt.Errorf("logit bias %v is out of range [-100, 100]", bias)
+	}
+
+	ret := make(map[int]float64)
+	var skipped []string
+	seen := make(map[string]bool)
+	for _, phrase := range phrases {
+		base := strings.TrimLeft(phrase, " ")
+		if base == "" {
+			continue
+		}
+		for _, variant := range []string{base, " " + base} {
+			if seen[variant] {
+				continue
+			}
+			seen[variant] = true
+
+			tokens, err := tok.Encode(variant)
+			if err != nil {
+				return nil, nil, fmt.Errorf("encoding %q: %w", variant, err)
+			}
+			if len(tokens) != 1 {
+				skipped = append(skipped, fmt.Sprintf("%q encodes to %d tokens %v; it is not biased", variant, len(tokens), tokens))
+				continue
+			}
+			ret[tokens[0]] = bias
+		}
+	}
+	return ret, skipped, nil
+}
@@ -0,0 +1,39 @@
+// Copyright 2023 Peter Hebert. Licensed under the MIT license.
+
+package gotoken
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestLogitBias(t *testing.T) {
+	tok, err := GetTokenizer("runes")
+	if err != nil {
+		t.Fatalf("GetTokenizer('runes'): %v", err)
+	}
+
+	// The runes tokenizer encodes each rune as a token, so every leading-space
+	// variant is two tokens, and is skipped rather than biasing the space.
+	got, skipped, err := LogitBias(tok, []string{"a", " a", "", "b", "cd"}, -100)
+	if err != nil {
+		t.Fatalf("LogitBias: %v", err)
+	}
+	want := map[int]float64{'a': -100, 'b': -100}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("LogitBias() = %v, want %v", got, want)
+	}
+	wantSkipped := []string{
+		`" a" encodes to 2 tokens [32 97]; it is not biased`,
+		`" b" encodes to 2 tokens [32 98]; it is not biased`,
+		`"cd" encodes to 2 tokens [99 100]; it is not biased`,
+		`" cd" encodes to 3 tokens [32 99 100]; it is not biased`,
+	}
+	if !reflect.DeepEqual(skipped, wantSkipped) {
+		t.Errorf("LogitBias() skipped %q, want %q", skipped, wantSkipped)
+	}
+
+	if _, _, err := LogitBias(tok, []string{"a"}, 101); err == nil {
+		t.Errorf("LogitBias() did not reject out-of-range bias")
+	}
+}
@@ -0,0 +1,304 @@
+// Copyright 2023 Peter Hebert. Licensed under the MIT license.
+
+// Package metrics exports Prometheus metrics for any gotoken Tokenizer. A
+// [Collector] wraps tokenizers so that each call to encode, count, or decode is
+// counted and timed, and registers with Prometheus like any other collector:
+//
+//	c := metrics.NewCollector("myservice")
+//	prometheus.MustRegister(c)
+//	tok, err := gotoken.GetTokenizer("cl100k_base")
+//	...
+//	tok = c.Wrap(tok)
+//
+// The metrics are labeled by encoding and by operation, which is "encode",
+// "count", or "decode", as for [gotoken.WithMetricsHook]:
+//
+//   - <namespace>_gotoken_tokens_total counts the tokens encoded, counted, or
+//     decoded.
+//   - <namespace>_gotoken_call_duration_seconds is a histogram of the time
+//     taken by each call.
+//   - <namespace>_gotoken_errors_total counts failed calls, with a "type" label
+//     for the kind of error, like "special_token" or "too_many_tokens".
+//
+// This package is a module of its own, so that programs using gotoken without
+// Prometheus don't depend on the Prometheus client library.
+package metrics
+
+import (
+	"context"
+	"errors"
+	"io"
+	"time"
+
+	"github.com/peterheb/gotoken"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Collector collects metrics for the tokenizers wrapped by [Collector.Wrap].
+// It implements [prometheus.Collector]. One Collector can wrap any number of
+// tokenizers, of any encodings, which are told apart by the "encoding" label.
+type Collector struct {
+	tokens   *prometheus.CounterVec
+	duration *prometheus.HistogramVec
+	errors   *prometheus.CounterVec
+}
+
+// NewCollector returns a new Collector, whose metrics have names starting
+// with namespace, or with "gotoken_" if namespace is empty.
+func NewCollector(namespace string) *Collector {
+	return &Collector{
+		tokens: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "gotoken",
+			Name:      "tokens_total",
+			Help:      "Number of tokens encoded, counted, or decoded.",
+		}, []string{"encoding", "op"}),
+		duration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "gotoken",
+			Name:      "call_duration_seconds",
+			Help:      "Duration of calls to encode, count, or decode.",
+			// 10µs to about 2.6s, which spans a short chat message to a
+			// large document
+			Buckets: prometheus.ExponentialBuckets(10e-6, 4, 10),
+		}, []string{"encoding", "op"}),
+		errors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "gotoken",
+			Name:      "errors_total",
+			Help:      "Number of failed calls to encode, count, or decode, by type of error.",
+		}, []string{"encoding", "op", "type"}),
+	}
+}
+
+// Describe implements [prometheus.Collector].
+func (c *Collector) Describe(ch chan<- *prometheus.Desc) {
+	c.tokens.Describe(ch)
+	c.duration.Describe(ch)
+	c.errors.Describe(ch)
+}
+
+// Collect implements [prometheus.Collector].
+func (c *Collector) Collect(ch chan<- prometheus.Metric) {
+	c.tokens.Collect(ch)
+	c.duration.Collect(ch)
+	c.errors.Collect(ch)
+}
+
+// Wrap returns a Tokenizer that calls tok, and records its calls in c. The
+// methods that encode, count, or decode text are recorded, including those
+// like EncodeBatch and Encode32; the others are passed through to tok as they
+// are. Wrapping a tokenizer that is already wrapped records its calls twice.
+func (c *Collector) Wrap(tok gotoken.Tokenizer) gotoken.Tokenizer {
+	return &tokenizer{Tokenizer: tok, c: c, encoding: tok.Name()}
+}
+
+// record records a call for the given operation that started at start.
+func (c *Collector) record(encoding, op string, tokens int, start time.Time, err error) {
+	c.duration.WithLabelValues(encoding, op).Observe(time.Since(start).Seconds())
+	if tokens > 0 {
+		c.tokens.WithLabelValues(encoding, op).Add(float64(tokens))
+	}
+	if err != nil {
+		c.errors.WithLabelValues(encoding, op, ErrorType(err)).Inc()
+	}
+}
+
+// ErrorType returns the value of the "type" label for err in the errors_total
+// metric, like "special_token" for an error that wraps
+// [gotoken.ErrSpecialToken]. Errors that gotoken does not define are "other".
+func ErrorType(err error) string {
+	var (
+		tooMany     *gotoken.TooManyTokensError
+		inputLarge  *gotoken.InputTooLargeError
+		decodeLarge *gotoken.DecodeTooLargeError
+		invalidUTF8 *gotoken.InvalidUTF8Error
+	)
+	switch {
+	case errors.Is(err, gotoken.ErrSpecialToken):
+		return "special_token"
+	case errors.Is(err, gotoken.ErrInvalidToken):
+		return "invalid_token"
+	case errors.Is(err, gotoken.ErrCountOnly):
+		return "count_only"
+	case errors.As(err, &tooMany):
+		return "too_many_tokens"
+	case errors.As(err, &inputLarge):
+		return "input_too_large"
+	case errors.As(err, &decodeLarge):
+		return "decode_too_large"
+	case errors.As(err, &invalidUTF8):
+		return "invalid_utf8"
+	case errors.Is(err, context.Canceled):
+		return "canceled"
+	case errors.Is(err, context.DeadlineExceeded):
+		return "deadline_exceeded"
+	}
+	return "other"
+}
+
+// tokenizer is a Tokenizer wrapped by Collector.Wrap.
+type tokenizer struct {
+	gotoken.Tokenizer
+	c        *Collector
+	encoding string
+}
+
+func (t *tokenizer) Count(input string) int {
+	start := time.Now()
+	n := t.Tokenizer.Count(input)
+	t.c.record(t.encoding, "count", n, start, nil)
+	return n
+}
+
+func (t *tokenizer) CountCtx(ctx context.Context, input string) (int, error) {
+	start := time.Now()
+	n, err := t.Tokenizer.CountCtx(ctx, input)
+	t.c.record(t.encoding, "count", n, start, err)
+	return n, err
+}
+
+func (t *tokenizer) CountReader(r io.Reader) (int64, error) {
+	start := time.Now()
+	n, err := t.Tokenizer.CountReader(r)
+	t.c.record(t.encoding, "count", int(n), start, err)
+	return n, err
+}
+
+func (t *tokenizer) Encode(input string) ([]int, error) {
+	start := time.Now()
+	tokens, err := t.Tokenizer.Encode(input)
+	t.c.record(t.encoding, "encode", len(tokens), start, err)
+	return tokens, err
+}
+
+func (t *tokenizer) EncodeCtx(ctx context.Context, input string) ([]int, error) {
+	start := time.Now()
+	tokens, err := t.Tokenizer.EncodeCtx(ctx, input)
+	t.c.record(t.encoding, "encode", len(tokens), start, err)
+	return tokens, err
+}
+
+func (t *tokenizer) EncodeOrdinary(input string) ([]int, error) {
+	start := time.Now()
+	tokens, err := t.Tokenizer.EncodeOrdinary(input)
+	t.c.record(t.encoding, "encode", len(tokens), start, err)
+	return tokens, err
+}
+
+func (t *tokenizer) EncodeSpecial(input string, allowed, disallowed []string) ([]int, error) {
+	start := time.Now()
+	tokens, err := t.Tokenizer.EncodeSpecial(input, allowed, disallowed)
+	t.c.record(t.encoding, "encode", len(tokens), start, err)
+	return tokens, err
+}
+
+func (t *tokenizer) EncodeWithOffsets(input string) ([]int, []int, error) {
+	start := time.Now()
+	tokens, offsets, err := t.Tokenizer.EncodeWithOffsets(input)
+	t.c.record(t.encoding, "encode", len(tokens), start, err)
+	return tokens, offsets, err
+}
+
+func (t *tokenizer) EncodeSuffix(tokens []int, suffix string) ([]int, error) {
+	start := time.Now()
+	ret, err := t.Tokenizer.EncodeSuffix(tokens, suffix)
+	// Only the tokens added for the suffix are new
+	added := len(ret) - len(tokens)
+	if added < 0 {
+		added = 0
+	}
+	t.c.record(t.encoding, "encode", added, start, err)
+	return ret, err
+}
+
+func (t *tokenizer) EncodeBatch(inputs []string, workers int) ([][]int, error) {
+	start := time.Now()
+	ret, err := t.Tokenizer.EncodeBatch(inputs, workers)
+	n := 0
+	for _, tokens := range ret {
+		n += len(tokens)
+	}
+	t.c.record(t.encoding, "encode", n, start, err)
+	return ret, err
+}
+
+func (t *tokenizer) EncodeFunc(input string, fn func([]int) error) error {
+	start := time.Now()
+	n := 0
+	err := t.Tokenizer.EncodeFunc(input, func(tokens []int) error {
+		n += len(tokens)
+		return fn(tokens)
+	})
+	t.c.record(t.encoding, "encode", n, start, err)
+	return err
+}
+
+func (t *tokenizer) EncodeReader(r io.Reader, emit func([]int) error) error {
+	start := time.Now()
+	n := 0
+	err := t.Tokenizer.EncodeReader(r, func(tokens []int) error {
+		n += len(tokens)
+		return emit(tokens)
+	})
+	t.c.record(t.encoding, "encode", n, start, err)
+	return err
+}
+
+func (t *tokenizer) Encode32(input string) ([]uint32, error) {
+	start := time.Now()
+	tokens, err := t.Tokenizer.Encode32(input)
+	t.c.record(t.encoding, "encode", len(tokens), start, err)
+	return tokens, err
+}
+
+func (t *tokenizer) Decode(input []int) (string, error) {
+	start := time.Now()
+	ret, err := t.Tokenizer.Decode(input)
+	t.c.record(t.encoding, "decode", decoded(len(input), err), start, err)
+	return ret, err
+}
+
+func (t *tokenizer) DecodeToken(token int) (string, error) {
+	start := time.Now()
+	ret, err := t.Tokenizer.DecodeToken(token)
+	t.c.record(t.encoding, "decode", decoded(1, err), start, err)
+	return ret, err
+}
+
+func (t *tokenizer) DecodeEach(input []int) ([]string, error) {
+	start := time.Now()
+	ret, err := t.Tokenizer.DecodeEach(input)
+	t.c.record(t.encoding, "decode", decoded(len(input), err), start, err)
+	return ret, err
+}
+
+func (t *tokenizer) AppendDecode(dst []byte, input []int) ([]byte, error) {
+	start := time.Now()
+	ret, err := t.Tokenizer.AppendDecode(dst, input)
+	t.c.record(t.encoding, "decode", decoded(len(input), err), start, err)
+	return ret, err
+}
+
+func (t *tokenizer) DecodeTo(w io.Writer, input []int) error {
+	start := time.Now()
+	err := t.Tokenizer.DecodeTo(w, input)
+	t.c.record(t.encoding, "decode", decoded(len(input), err), start, err)
+	return err
+}
+
+func (t *tokenizer) Decode32(input []uint32) (string, error) {
+	start := time.Now()
+	ret, err := t.Tokenizer.Decode32(input)
+	t.c.record(t.encoding, "decode", decoded(len(input), err), start, err)
+	return ret, err
+}
+
+// decoded returns the number of tokens decoded by a call to decode n tokens
+// that returned err.
+func decoded(n int, err error) int {
+	if err != nil {
+		return 0
+	}
+	return n
+}
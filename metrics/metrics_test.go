@@ -0,0 +1,92 @@
+// Copyright 2023 Peter Hebert. Licensed under the MIT license.
+
+package metrics
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/peterheb/gotoken"
+	_ "github.com/peterheb/gotoken/r50kbase"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func TestCollector(t *testing.T) {
+	base, err := gotoken.GetTokenizer("r50k_base", gotoken.WithMaxTokens(10))
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := NewCollector("test")
+	reg := prometheus.NewPedanticRegistry()
+	if err := reg.Register(c); err != nil {
+		t.Fatalf("Register(): %v", err)
+	}
+	tok := c.Wrap(base)
+
+	tokens, err := tok.Encode("hello world") // 2 tokens
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n := tok.Count("hello world"); n != 2 {
+		t.Fatalf("Count() = %d, want 2", n)
+	}
+	if _, err := tok.Decode(tokens); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := tok.Encode("<|endoftext|>"); !errors.Is(err, gotoken.ErrSpecialToken) {
+		t.Fatalf("Encode() of a special token: got error %v", err)
+	}
+	if _, err := tok.Decode([]int{-1}); err == nil {
+		t.Fatalf("Decode() of an invalid token: expected error, got nil")
+	}
+
+	for _, tt := range []struct {
+		metric prometheus.Collector
+		want   float64
+	}{
+		{c.tokens.WithLabelValues("r50k_base", "encode"), 2},
+		{c.tokens.WithLabelValues("r50k_base", "count"), 2},
+		{c.tokens.WithLabelValues("r50k_base", "decode"), 2},
+		{c.errors.WithLabelValues("r50k_base", "encode", "special_token"), 1},
+		{c.errors.WithLabelValues("r50k_base", "decode", "invalid_token"), 1},
+	} {
+		if got := testutil.ToFloat64(tt.metric); got != tt.want {
+			t.Errorf("%v: got %v, want %v", tt.metric, got, tt.want)
+		}
+	}
+	// 2 encodes, 2 decodes, and 1 count
+	if n := testutil.CollectAndCount(c, "test_gotoken_call_duration_seconds"); n != 3 {
+		t.Errorf("call_duration_seconds: got %d series, want 3", n)
+	}
+
+	// Methods that don't encode, count, or decode are passed through
+	if tok.Name() != "r50k_base" || tok.MaxToken() != base.MaxToken() {
+		t.Errorf("Wrap(): Name() or MaxToken() not passed through")
+	}
+}
+
+func TestErrorType(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	for _, tt := range []struct {
+		err  error
+		want string
+	}{
+		{fmt.Errorf("%w: <|endoftext|>", gotoken.ErrSpecialToken), "special_token"},
+		{fmt.Errorf("%w: -1", gotoken.ErrInvalidToken), "invalid_token"},
+		{&gotoken.TooManyTokensError{Max: 1, Count: 2}, "too_many_tokens"},
+		{&gotoken.InputTooLargeError{Max: 1, Size: 2}, "input_too_large"},
+		{&gotoken.DecodeTooLargeError{Max: 1}, "decode_too_large"},
+		{&gotoken.InvalidUTF8Error{}, "invalid_utf8"},
+		{&gotoken.PartialEncodeError{Err: gotoken.ErrSpecialToken}, "special_token"},
+		{ctx.Err(), "canceled"},
+		{errors.New("something else"), "other"},
+	} {
+		if got := ErrorType(tt.err); got != tt.want {
+			t.Errorf("ErrorType(%v) = %q, want %q", tt.err, got, tt.want)
+		}
+	}
+}
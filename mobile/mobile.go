@@ -0,0 +1,111 @@
+// Copyright 2023 Peter Hebert. Licensed under the MIT license.
+
+// Package mobile is a binding layer for using gotoken from iOS and Android
+// apps, for example to count tokens offline before calling an API. It only
+// uses types supported by gomobile, and can be built with:
+//
+//	gomobile bind -target=android github.com/peterheb/gotoken/mobile
+//	gomobile bind -target=ios github.com/peterheb/gotoken/mobile
+//
+// gomobile does not support slices of integers, so lists of tokens are passed
+// as [Tokens] values. All encodings are included.
+package mobile
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/peterheb/gotoken"
+	_ "github.com/peterheb/gotoken/cl100kbase"
+	_ "github.com/peterheb/gotoken/p50kbase"
+	_ "github.com/peterheb/gotoken/r50kbase"
+)
+
+// Encodings returns the names of the available encodings, separated by
+// newlines.
+func Encodings() string {
+	return strings.Join(gotoken.ListTokenizers(), "\n")
+}
+
+// Tokenizer wraps a [gotoken.Tokenizer]. It is safe to use from multiple
+// threads.
+type Tokenizer struct {
+	tok gotoken.Tokenizer
+}
+
+// NewTokenizer returns a Tokenizer for an encoding, like "cl100k_base". If
+// specialAsText is true, special tokens like "<|endoftext|>" in the input are
+// encoded as text; otherwise, they cause an error.
+func NewTokenizer(encoding string, specialAsText bool) (*Tokenizer, error) {
+	var opts []gotoken.Option
+	if specialAsText {
+		opts = append(opts, gotoken.WithSpecialTokensAsText())
+	}
+	tok, err := gotoken.GetTokenizer(encoding, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &Tokenizer{tok: tok}, nil
+}
+
+// Count returns the number of tokens in text.
+func (t *Tokenizer) Count(text string) (int64, error) {
+	tokens, err := t.tok.Encode(text)
+	if err != nil {
+		return 0, err
+	}
+	return int64(len(tokens)), nil
+}
+
+// Encode returns the tokens for text.
+func (t *Tokenizer) Encode(text string) (*Tokens, error) {
+	tokens, err := t.tok.Encode(text)
+	if err != nil {
+		return nil, err
+	}
+	return &Tokens{tokens: tokens}, nil
+}
+
+// Decode returns the text for tokens.
+func (t *Tokenizer) Decode(tokens *Tokens) (string, error) {
+	if tokens == nil {
+		return "", nil
+	}
+	return t.tok.Decode(tokens.tokens)
+}
+
+// Tokens is a list of tokens.
+type Tokens struct {
+	tokens []int
+}
+
+// NewTokens returns an empty list of tokens, for building input to Decode.
+func NewTokens() *Tokens {
+	return &Tokens{}
+}
+
+// Len returns the number of tokens in the list.
+func (t *Tokens) Len() int64 {
+	return int64(len(t.tokens))
+}
+
+// Get returns the token at index i, or -1 if i is out of range.
+func (t *Tokens) Get(i int64) int64 {
+	if i < 0 || i >= int64(len(t.tokens)) {
+		return -1
+	}
+	return int64(t.tokens[i])
+}
+
+// Add appends a token to the list. Tokens are 32-bit in every encoding, so a
+// token outside that range is not added, and an error that wraps
+// [gotoken.ErrInvalidToken] is returned; otherwise it would be truncated on
+// 32-bit platforms, and decode as a different token.
+func (t *Tokens) Add(token int64) error {
+	if token < math.MinInt32 || token > math.MaxInt32 {
+		return fmt.Errorf("%w: %d", gotoken.ErrInvalidToken, token)
+	}
+	t.tokens = append(t.tokens, int(token))
+	return nil
+}
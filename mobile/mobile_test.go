@@ -0,0 +1,66 @@
+// Copyright 2023 Peter Hebert. Licensed under the MIT license.
+
+package mobile_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/peterheb/gotoken"
+	"github.com/peterheb/gotoken/mobile"
+)
+
+func TestTokenizer(t *testing.T) {
+	if !strings.Contains(mobile.Encodings(), "cl100k_base\n") {
+		t.Errorf("Encodings() got %q; expected it to contain cl100k_base", mobile.Encodings())
+	}
+
+	tok, err := mobile.NewTokenizer("cl100k_base", false)
+	if err != nil {
+		t.Fatalf("NewTokenizer: %v", err)
+	}
+	tokens, err := tok.Encode("hello world")
+	if err != nil {
+		t.Fatalf("Encode: %v", err)
+	}
+	if tokens.Len() != 2 || tokens.Get(0) != 15339 || tokens.Get(1) != 1917 || tokens.Get(2) != -1 {
+		t.Errorf("Encode() got %d tokens: %d, %d", tokens.Len(), tokens.Get(0), tokens.Get(1))
+	}
+
+	input := mobile.NewTokens()
+	for _, token := range []int64{15339, 1917} {
+		if err := input.Add(token); err != nil {
+			t.Fatalf("Add(%d): %v", token, err)
+		}
+	}
+	// Tokens that don't fit in 32 bits are rejected, not truncated
+	if err := input.Add(1<<32 + 15339); !errors.Is(err, gotoken.ErrInvalidToken) {
+		t.Errorf("Add(1<<32 + 15339) got %v; expected ErrInvalidToken", err)
+	}
+	if input.Len() != 2 {
+		t.Errorf("Len() after rejected Add got %d; expected 2", input.Len())
+	}
+	if text, err := tok.Decode(input); err != nil || text != "hello world" {
+		t.Errorf("Decode() got %q, %v; expected \"hello world\"", text, err)
+	}
+
+	if count, err := tok.Count("hello world"); err != nil || count != 2 {
+		t.Errorf("Count() got %d, %v; expected 2", count, err)
+	}
+	if _, err := tok.Count("<|endoftext|>"); err == nil {
+		t.Errorf("Count() of a special token: expected an error")
+	}
+
+	tok2, err := mobile.NewTokenizer("cl100k_base", true)
+	if err != nil {
+		t.Fatalf("NewTokenizer: %v", err)
+	}
+	if count, err := tok2.Count("<|endoftext|>"); err != nil || count != 7 {
+		t.Errorf("Count() of a special token as text got %d, %v; expected 7", count, err)
+	}
+
+	if _, err := mobile.NewTokenizer("nope", false); err == nil {
+		t.Errorf("NewTokenizer(\"nope\"): expected an error")
+	}
+}
@@ -0,0 +1,177 @@
+// Copyright 2023 Peter Hebert. Licensed under the MIT license.
+
+package gotoken
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// ErrUnknownModel is returned, wrapped, by [EncodingForModel] for a model name
+// that it can't map to an encoding.
+var ErrUnknownModel = errors.New("unknown model")
+
+// modelEncodings maps OpenAI model names to the names of their encodings, as
+// in tiktoken.
+var modelEncodings = map[string]string{
+	// chat
+	"gpt-4o":        "o200k_base",
+	"o1":            "o200k_base",
+	"gpt-4":         "cl100k_base",
+	"gpt-3.5-turbo": "cl100k_base",
+	"gpt-3.5":       "cl100k_base",
+	"gpt-35-turbo":  "cl100k_base", // Azure's name
+	// base
+	"davinci-002": "cl100k_base",
+	"babbage-002": "cl100k_base",
+	// embeddings
+	"text-embedding-ada-002": "cl100k_base",
+	"text-embedding-3-small": "cl100k_base",
+	"text-embedding-3-large": "cl100k_base",
+	// deprecated text models
+	"text-davinci-003": "p50k_base",
+	"text-davinci-002": "p50k_base",
+	"text-davinci-001": "r50k_base",
+	"text-curie-001":   "r50k_base",
+	"text-babbage-001": "r50k_base",
+	"text-ada-001":     "r50k_base",
+	"davinci":          "r50k_base",
+	"curie":            "r50k_base",
+	"babbage":          "r50k_base",
+	"ada":              "r50k_base",
+	// code
+	"code-davinci-002": "p50k_base",
+	"code-davinci-001": "p50k_base",
+	"code-cushman-002": "p50k_base",
+	"code-cushman-001": "p50k_base",
+	"davinci-codex":    "p50k_base",
+	"cushman-codex":    "p50k_base",
+	// edit
+	"text-davinci-edit-001": "p50k_edit",
+	"code-davinci-edit-001": "p50k_edit",
+	// old embeddings
+	"text-similarity-davinci-001":  "r50k_base",
+	"text-similarity-curie-001":    "r50k_base",
+	"text-similarity-babbage-001":  "r50k_base",
+	"text-similarity-ada-001":      "r50k_base",
+	"text-search-davinci-doc-001":  "r50k_base",
+	"text-search-curie-doc-001":    "r50k_base",
+	"text-search-babbage-doc-001":  "r50k_base",
+	"text-search-ada-doc-001":      "r50k_base",
+	"code-search-babbage-code-001": "r50k_base",
+	"code-search-ada-code-001":     "r50k_base",
+	// open source
+	"gpt2": "r50k_base",
+}
+
+// modelPrefixEncodings maps prefixes of model names, for dated snapshots and
+// other variants like "gpt-4-0613", to the names of their encodings.
+var modelPrefixEncodings = map[string]string{
+	"o1-":            "o200k_base",
+	"chatgpt-4o-":    "o200k_base",
+	"gpt-4o-":        "o200k_base",
+	"gpt-4-":         "cl100k_base",
+	"gpt-3.5-turbo-": "cl100k_base",
+	"gpt-35-turbo-":  "cl100k_base",
+}
+
+var (
+	// models registered with RegisterModel, which take precedence over the
+	// built-in tables
+	userModels        = make(map[string]string)
+	userModelPrefixes = make(map[string]string)
+	modelMu           sync.RWMutex
+)
+
+// EncodingForModel returns the name of the encoding used by an OpenAI model,
+// like "cl100k_base" for "gpt-4", which can be passed to [GetTokenizer]. If no
+// matching model is found, an error is returned that wraps [ErrUnknownModel].
+//
+// Besides exact names, EncodingForModel knows the prefixes of the dated
+// snapshots and variants of a model, like "gpt-4-0613" and "gpt-4-32k", and
+// maps a fine-tuned model like "ft:gpt-3.5-turbo:my-org::abc123" to the
+// encoding of its base model. Names registered with [RegisterModel] and
+// [RegisterModelPrefix], like Azure deployment names, are checked first.
+// Among prefixes, the longest one that matches is used.
+//
+// The encoding is returned whether or not a package that provides it has been
+// imported, so for models like "gpt-4o", whose o200k_base package has not
+// been generated yet, [GetTokenizer] returns [ErrUnknownEncoding].
+func EncodingForModel(model string) (string, error) {
+	if encoding, ok := lookupModel(model); ok {
+		return encoding, nil
+	}
+	// A fine-tuned model is named "ft:" followed by its base model and other
+	// fields, separated by colons
+	if base := strings.TrimPrefix(model, "ft:"); base != model {
+		if i := strings.IndexByte(base, ':'); i >= 0 {
+			base = base[:i]
+		}
+		if encoding, ok := lookupModel(base); ok {
+			return encoding, nil
+		}
+	}
+	return "", fmt.Errorf("%w: %s", ErrUnknownModel, model)
+}
+
+// lookupModel returns the encoding of model from the registered and built-in
+// tables, and whether one was found.
+func lookupModel(model string) (string, bool) {
+	modelMu.RLock()
+	defer modelMu.RUnlock()
+	for _, table := range []struct{ exact, prefixes map[string]string }{
+		{userModels, userModelPrefixes},
+		{modelEncodings, modelPrefixEncodings},
+	} {
+		if encoding, ok := table.exact[model]; ok {
+			return encoding, true
+		}
+		if encoding, ok := longestPrefixMatch(table.prefixes, model); ok {
+			return encoding, true
+		}
+	}
+	return "", false
+}
+
+// longestPrefixMatch returns the value of the longest key in prefixes that
+// model starts with, and whether there is one.
+func longestPrefixMatch(prefixes map[string]string, model string) (string, bool) {
+	best, ret := "", ""
+	for prefix, encoding := range prefixes {
+		if len(prefix) > len(best) && strings.HasPrefix(model, prefix) {
+			best, ret = prefix, encoding
+		}
+	}
+	return ret, best != ""
+}
+
+// GetTokenizerForModel returns a tokenizer for the encoding of model, as found
+// by [EncodingForModel], configured with opts like [GetTokenizer].
+func GetTokenizerForModel(model string, opts ...Option) (Tokenizer, error) {
+	encoding, err := EncodingForModel(model)
+	if err != nil {
+		return nil, err
+	}
+	return GetTokenizer(encoding, opts...)
+}
+
+// RegisterModel registers the name of a model, like an Azure OpenAI deployment
+// name, as using the named encoding, for [EncodingForModel]. Registered names
+// take precedence over the built-in ones, and registering a name again
+// replaces it. The encoding is not checked, so a model can be registered
+// before the package that provides its encoding is imported.
+func RegisterModel(name, encoding string) {
+	modelMu.Lock()
+	defer modelMu.Unlock()
+	userModels[name] = encoding
+}
+
+// RegisterModelPrefix is like [RegisterModel], but registers every model whose
+// name starts with prefix, like "prod-gpt4-" for a family of deployments.
+func RegisterModelPrefix(prefix, encoding string) {
+	modelMu.Lock()
+	defer modelMu.Unlock()
+	userModelPrefixes[prefix] = encoding
+}
@@ -0,0 +1,77 @@
+// Copyright 2023 Peter Hebert. Licensed under the MIT license.
+
+package gotoken
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestEncodingForModel(t *testing.T) {
+	tests := []struct {
+		model, want string
+	}{
+		{"gpt-4", "cl100k_base"},
+		{"gpt-4-0613", "cl100k_base"},
+		{"gpt-4-32k", "cl100k_base"},
+		{"gpt-4o", "o200k_base"},
+		{"gpt-4o-mini-2024-07-18", "o200k_base"},
+		{"chatgpt-4o-latest", "o200k_base"},
+		{"o1", "o200k_base"},
+		{"o1-mini", "o200k_base"},
+		{"gpt-3.5-turbo-16k", "cl100k_base"},
+		{"gpt-35-turbo-0613", "cl100k_base"},
+		{"text-embedding-ada-002", "cl100k_base"},
+		{"text-davinci-003", "p50k_base"},
+		{"text-davinci-edit-001", "p50k_edit"},
+		{"davinci", "r50k_base"},
+		{"ft:gpt-3.5-turbo:my-org::abc123", "cl100k_base"},
+		{"ft:gpt-3.5-turbo-0613:my-org:custom:abc123", "cl100k_base"},
+		{"ft:davinci-002", "cl100k_base"},
+	}
+	for _, tt := range tests {
+		if got, err := EncodingForModel(tt.model); got != tt.want || err != nil {
+			t.Errorf("EncodingForModel(%q) = %q, %v; want %q", tt.model, got, err, tt.want)
+		}
+	}
+	for _, model := range []string{"", "gpt-5", "gpt-40", "o10", "ft:", "ft:unknown:org", "davinci-"} {
+		if got, err := EncodingForModel(model); !errors.Is(err, ErrUnknownModel) {
+			t.Errorf("EncodingForModel(%q) = %q, %v; want ErrUnknownModel", model, got, err)
+		}
+	}
+}
+
+func TestRegisterModel(t *testing.T) {
+	defer func() {
+		modelMu.Lock()
+		delete(userModels, "my-deployment")
+		delete(userModels, "gpt-4")
+		delete(userModelPrefixes, "prod-")
+		modelMu.Unlock()
+	}()
+	RegisterModel("my-deployment", "runes")
+	RegisterModel("gpt-4", "runes")
+	RegisterModelPrefix("prod-", "p50k_base")
+	tests := []struct {
+		model, want string
+	}{
+		{"my-deployment", "runes"},
+		{"gpt-4", "runes"},
+		{"gpt-4-0613", "cl100k_base"},
+		{"prod-chat", "p50k_base"},
+		{"ft:my-deployment:org::id", "runes"},
+	}
+	for _, tt := range tests {
+		if got, err := EncodingForModel(tt.model); got != tt.want || err != nil {
+			t.Errorf("EncodingForModel(%q) = %q, %v; want %q", tt.model, got, err, tt.want)
+		}
+	}
+
+	tok, err := GetTokenizerForModel("my-deployment")
+	if err != nil || tok.Name() != "runes" {
+		t.Errorf("GetTokenizerForModel(\"my-deployment\") = %v, %v; want the runes tokenizer", tok, err)
+	}
+	if _, err := GetTokenizerForModel("gpt-5"); !errors.Is(err, ErrUnknownModel) {
+		t.Errorf("GetTokenizerForModel(\"gpt-5\"): %v, want ErrUnknownModel", err)
+	}
+}
@@ -0,0 +1,21 @@
+module github.com/peterheb/gotoken/otelgotoken
+
+go 1.19
+
+require (
+	github.com/peterheb/gotoken v0.0.0-20261015062741-eb46e086c2c2
+	go.opentelemetry.io/otel v1.16.0
+	go.opentelemetry.io/otel/sdk v1.16.0
+	go.opentelemetry.io/otel/trace v1.16.0
+)
+
+require (
+	github.com/go-logr/logr v1.2.4 // indirect
+	github.com/go-logr/stdr v1.2.2 // indirect
+	go.opentelemetry.io/otel/metric v1.16.0 // indirect
+	golang.org/x/sys v0.8.0 // indirect
+)
+
+// The replace builds against the gotoken in this repository; it is ignored
+// when this module is required by another one.
+replace github.com/peterheb/gotoken => ../
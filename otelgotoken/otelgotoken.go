@@ -0,0 +1,315 @@
+// Copyright 2023 Peter Hebert. Licensed under the MIT license.
+
+// Package otelgotoken instruments any gotoken Tokenizer with OpenTelemetry
+// tracing. [Wrap] returns a Tokenizer that starts a span for each call to
+// encode, count, or decode:
+//
+//	tok, err := gotoken.GetTokenizer("cl100k_base")
+//	...
+//	tok = otelgotoken.Wrap(tok)
+//	tokens, err := tok.EncodeCtx(ctx, text)
+//
+// Spans are named "gotoken.encode", "gotoken.count", or "gotoken.decode", with
+// the operations grouped as for [gotoken.WithMetricsHook]. Each span has the
+// attributes "gotoken.encoding", "gotoken.bytes" for the length of the text,
+// and "gotoken.tokens" for the number of tokens; a failed call records its
+// error and sets the span's status to Error. EncodeCtx and CountCtx start
+// their spans as children of the span in their context; the other methods have
+// no context, so their spans are roots.
+//
+// This package is a module of its own, so that programs using gotoken without
+// OpenTelemetry don't depend on it. For a tracing system other than
+// OpenTelemetry, use [gotoken.WithTraceHook] instead.
+package otelgotoken
+
+import (
+	"context"
+	"io"
+
+	"github.com/peterheb/gotoken"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// ScopeName is the name of the tracer that spans are created with, the
+// instrumentation scope in OpenTelemetry.
+const ScopeName = "github.com/peterheb/gotoken/otelgotoken"
+
+// Option is an option for [Wrap], such as [WithTracerProvider].
+type Option func(*config)
+
+// config collects the Options passed to Wrap.
+type config struct {
+	provider trace.TracerProvider
+}
+
+// WithTracerProvider is an option for [Wrap] that creates spans with tp,
+// instead of the global TracerProvider from [otel.GetTracerProvider].
+func WithTracerProvider(tp trace.TracerProvider) Option {
+	return func(cfg *config) {
+		cfg.provider = tp
+	}
+}
+
+// Wrap returns a Tokenizer that calls tok, and traces its calls. The methods
+// that encode, count, or decode text are traced, including those like
+// EncodeBatch and Encode32; the others are passed through to tok as they are.
+func Wrap(tok gotoken.Tokenizer, opts ...Option) gotoken.Tokenizer {
+	var cfg config
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	if cfg.provider == nil {
+		cfg.provider = otel.GetTracerProvider()
+	}
+	return &tokenizer{
+		Tokenizer: tok,
+		tracer:    cfg.provider.Tracer(ScopeName),
+		encoding:  attribute.String("gotoken.encoding", tok.Name()),
+	}
+}
+
+// tokenizer is a Tokenizer wrapped by Wrap.
+type tokenizer struct {
+	gotoken.Tokenizer
+	tracer   trace.Tracer
+	encoding attribute.KeyValue
+}
+
+// start starts a span for the operation op, with attrs in addition to the
+// encoding.
+func (t *tokenizer) start(ctx context.Context, op string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return t.tracer.Start(ctx, "gotoken."+op,
+		trace.WithSpanKind(trace.SpanKindInternal),
+		trace.WithAttributes(t.encoding),
+		trace.WithAttributes(attrs...))
+}
+
+// bytes returns the gotoken.bytes attribute for n bytes of text.
+func bytes(n int) attribute.KeyValue {
+	return attribute.Int("gotoken.bytes", n)
+}
+
+// end ends span, for a call that returned the given number of tokens and err.
+func end(span trace.Span, tokens int, err error) {
+	span.SetAttributes(attribute.Int("gotoken.tokens", tokens))
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+func (t *tokenizer) Count(input string) int {
+	_, span := t.start(context.Background(), "count", bytes(len(input)))
+	n := t.Tokenizer.Count(input)
+	end(span, n, nil)
+	return n
+}
+
+func (t *tokenizer) CountCtx(ctx context.Context, input string) (int, error) {
+	ctx, span := t.start(ctx, "count", bytes(len(input)))
+	n, err := t.Tokenizer.CountCtx(ctx, input)
+	end(span, n, err)
+	return n, err
+}
+
+func (t *tokenizer) CountReader(r io.Reader) (int64, error) {
+	_, span := t.start(context.Background(), "count")
+	cr := &countingReader{r: r}
+	n, err := t.Tokenizer.CountReader(cr)
+	span.SetAttributes(bytes(cr.n))
+	end(span, int(n), err)
+	return n, err
+}
+
+func (t *tokenizer) Encode(input string) ([]int, error) {
+	_, span := t.start(context.Background(), "encode", bytes(len(input)))
+	tokens, err := t.Tokenizer.Encode(input)
+	end(span, len(tokens), err)
+	return tokens, err
+}
+
+func (t *tokenizer) EncodeCtx(ctx context.Context, input string) ([]int, error) {
+	ctx, span := t.start(ctx, "encode", bytes(len(input)))
+	tokens, err := t.Tokenizer.EncodeCtx(ctx, input)
+	end(span, len(tokens), err)
+	return tokens, err
+}
+
+func (t *tokenizer) EncodeOrdinary(input string) ([]int, error) {
+	_, span := t.start(context.Background(), "encode", bytes(len(input)))
+	tokens, err := t.Tokenizer.EncodeOrdinary(input)
+	end(span, len(tokens), err)
+	return tokens, err
+}
+
+func (t *tokenizer) EncodeSpecial(input string, allowed, disallowed []string) ([]int, error) {
+	_, span := t.start(context.Background(), "encode", bytes(len(input)))
+	tokens, err := t.Tokenizer.EncodeSpecial(input, allowed, disallowed)
+	end(span, len(tokens), err)
+	return tokens, err
+}
+
+func (t *tokenizer) EncodeWithOffsets(input string) ([]int, []int, error) {
+	_, span := t.start(context.Background(), "encode", bytes(len(input)))
+	tokens, offsets, err := t.Tokenizer.EncodeWithOffsets(input)
+	end(span, len(tokens), err)
+	return tokens, offsets, err
+}
+
+func (t *tokenizer) EncodeSuffix(tokens []int, suffix string) ([]int, error) {
+	_, span := t.start(context.Background(), "encode", bytes(len(suffix)))
+	ret, err := t.Tokenizer.EncodeSuffix(tokens, suffix)
+	// Only the tokens added for the suffix are new
+	added := len(ret) - len(tokens)
+	if added < 0 {
+		added = 0
+	}
+	end(span, added, err)
+	return ret, err
+}
+
+func (t *tokenizer) EncodeBatch(inputs []string, workers int) ([][]int, error) {
+	n := 0
+	for _, input := range inputs {
+		n += len(input)
+	}
+	_, span := t.start(context.Background(), "encode", bytes(n))
+	span.SetAttributes(attribute.Int("gotoken.inputs", len(inputs)))
+	ret, err := t.Tokenizer.EncodeBatch(inputs, workers)
+	n = 0
+	for _, tokens := range ret {
+		n += len(tokens)
+	}
+	end(span, n, err)
+	return ret, err
+}
+
+func (t *tokenizer) EncodeFunc(input string, fn func([]int) error) error {
+	_, span := t.start(context.Background(), "encode", bytes(len(input)))
+	n := 0
+	err := t.Tokenizer.EncodeFunc(input, func(tokens []int) error {
+		n += len(tokens)
+		return fn(tokens)
+	})
+	end(span, n, err)
+	return err
+}
+
+// The length of the input to EncodeReader and CountReader isn't known until it
+// has been read, so gotoken.bytes is added at the end.
+
+func (t *tokenizer) EncodeReader(r io.Reader, emit func([]int) error) error {
+	_, span := t.start(context.Background(), "encode")
+	cr := &countingReader{r: r}
+	n := 0
+	err := t.Tokenizer.EncodeReader(cr, func(tokens []int) error {
+		n += len(tokens)
+		return emit(tokens)
+	})
+	span.SetAttributes(bytes(cr.n))
+	end(span, n, err)
+	return err
+}
+
+// countingReader counts the bytes read from r.
+type countingReader struct {
+	r io.Reader
+	n int
+}
+
+func (cr *countingReader) Read(p []byte) (int, error) {
+	n, err := cr.r.Read(p)
+	cr.n += n
+	return n, err
+}
+
+func (t *tokenizer) Encode32(input string) ([]uint32, error) {
+	_, span := t.start(context.Background(), "encode", bytes(len(input)))
+	tokens, err := t.Tokenizer.Encode32(input)
+	end(span, len(tokens), err)
+	return tokens, err
+}
+
+// The length of the decoded text isn't known until the call returns, so the
+// decode methods add gotoken.bytes at the end, if they succeed.
+
+func (t *tokenizer) Decode(input []int) (string, error) {
+	_, span := t.start(context.Background(), "decode")
+	ret, err := t.Tokenizer.Decode(input)
+	if err == nil {
+		span.SetAttributes(bytes(len(ret)))
+	}
+	end(span, len(input), err)
+	return ret, err
+}
+
+func (t *tokenizer) DecodeToken(token int) (string, error) {
+	_, span := t.start(context.Background(), "decode")
+	ret, err := t.Tokenizer.DecodeToken(token)
+	if err == nil {
+		span.SetAttributes(bytes(len(ret)))
+	}
+	end(span, 1, err)
+	return ret, err
+}
+
+func (t *tokenizer) DecodeEach(input []int) ([]string, error) {
+	_, span := t.start(context.Background(), "decode")
+	ret, err := t.Tokenizer.DecodeEach(input)
+	if err == nil {
+		n := 0
+		for _, str := range ret {
+			n += len(str)
+		}
+		span.SetAttributes(bytes(n))
+	}
+	end(span, len(input), err)
+	return ret, err
+}
+
+func (t *tokenizer) AppendDecode(dst []byte, input []int) ([]byte, error) {
+	_, span := t.start(context.Background(), "decode")
+	ret, err := t.Tokenizer.AppendDecode(dst, input)
+	if err == nil {
+		span.SetAttributes(bytes(len(ret) - len(dst)))
+	}
+	end(span, len(input), err)
+	return ret, err
+}
+
+func (t *tokenizer) DecodeTo(w io.Writer, input []int) error {
+	_, span := t.start(context.Background(), "decode")
+	cw := &countingWriter{w: w}
+	err := t.Tokenizer.DecodeTo(cw, input)
+	if err == nil {
+		span.SetAttributes(bytes(cw.n))
+	}
+	end(span, len(input), err)
+	return err
+}
+
+// countingWriter counts the bytes written to w.
+type countingWriter struct {
+	w io.Writer
+	n int
+}
+
+func (cw *countingWriter) Write(p []byte) (int, error) {
+	n, err := cw.w.Write(p)
+	cw.n += n
+	return n, err
+}
+
+func (t *tokenizer) Decode32(input []uint32) (string, error) {
+	_, span := t.start(context.Background(), "decode")
+	ret, err := t.Tokenizer.Decode32(input)
+	if err == nil {
+		span.SetAttributes(bytes(len(ret)))
+	}
+	end(span, len(input), err)
+	return ret, err
+}
@@ -0,0 +1,82 @@
+// Copyright 2023 Peter Hebert. Licensed under the MIT license.
+
+package otelgotoken
+
+import (
+	"context"
+	"testing"
+
+	"github.com/peterheb/gotoken"
+	_ "github.com/peterheb/gotoken/r50kbase"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestWrap(t *testing.T) {
+	base, err := gotoken.GetTokenizer("r50k_base")
+	if err != nil {
+		t.Fatal(err)
+	}
+	sr := tracetest.NewSpanRecorder()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(sr))
+	tok := Wrap(base, WithTracerProvider(tp))
+
+	ctx, parent := tp.Tracer("test").Start(context.Background(), "parent")
+	tokens, err := tok.EncodeCtx(ctx, "hello world") // 2 tokens
+	if err != nil {
+		t.Fatal(err)
+	}
+	parent.End()
+	if _, err := tok.Decode(tokens); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := tok.Encode("<|endoftext|>"); err == nil {
+		t.Fatalf("Encode() of a special token: expected error, got nil")
+	}
+
+	spans := sr.Ended()
+	if len(spans) != 4 {
+		t.Fatalf("got %d spans, want 4", len(spans))
+	}
+	for i, tt := range []struct {
+		name   string
+		attrs  map[attribute.Key]int64
+		status codes.Code
+	}{
+		{"gotoken.encode", map[attribute.Key]int64{"gotoken.bytes": 11, "gotoken.tokens": 2}, codes.Unset},
+		{"parent", nil, codes.Unset},
+		{"gotoken.decode", map[attribute.Key]int64{"gotoken.bytes": 11, "gotoken.tokens": 2}, codes.Unset},
+		{"gotoken.encode", map[attribute.Key]int64{"gotoken.bytes": 13, "gotoken.tokens": 0}, codes.Error},
+	} {
+		span := spans[i]
+		if span.Name() != tt.name {
+			t.Errorf("span %d: got name %q, want %q", i, span.Name(), tt.name)
+			continue
+		}
+		if span.Status().Code != tt.status {
+			t.Errorf("span %d (%s): got status %v, want %v", i, tt.name, span.Status().Code, tt.status)
+		}
+		got := make(map[attribute.Key]attribute.Value)
+		for _, kv := range span.Attributes() {
+			got[kv.Key] = kv.Value
+		}
+		for key, want := range tt.attrs {
+			if got[key].AsInt64() != want {
+				t.Errorf("span %d (%s): got %s = %v, want %d", i, tt.name, key, got[key].Emit(), want)
+			}
+		}
+		if tt.attrs != nil && got["gotoken.encoding"].AsString() != "r50k_base" {
+			t.Errorf("span %d (%s): got gotoken.encoding = %q", i, tt.name, got["gotoken.encoding"].Emit())
+		}
+	}
+
+	// The span from EncodeCtx is a child of the span in its context
+	if spans[0].Parent().SpanID() != spans[1].SpanContext().SpanID() {
+		t.Errorf("EncodeCtx() span is not a child of its context's span")
+	}
+	if spans[2].Parent().IsValid() {
+		t.Errorf("Decode() span has a parent, want a root span")
+	}
+}
@@ -3,6 +3,9 @@
 package p50kbase
 
 import (
+	"sync"
+	"time"
+
 	"github.com/peterheb/gotoken"
 	"github.com/peterheb/gotoken/internal"
 )
@@ -15,53 +18,99 @@ const (
 	FIMSuffix = "<|fim_suffix|>"
 )
 
-// pairsToToken is a lookup table that maps pairs of bytes to their token, or -1
-// if the pair is not present in the encoding. This is used to bootstrap
-// byte-pair-encoding and is generated from bytePairLookup in data.go.
-var pairsToToken []int
+// These are the token IDs of the special tokens. The FIM tokens are only
+// defined by p50k_edit.
+const (
+	EndOfTextID = 50256
+	FIMPrefixID = 50281
+	FIMMiddleID = 50282
+	FIMSuffixID = 50283
+)
 
-func init() {
-	// inflate bytePairLookup into pairsToToken
-	pairsToToken = make([]int, 65536)
-	for i := 0; i < 65536; i++ {
-		pairsToToken[i] = -1
-	}
-	for _, pair := range bytePairLookup {
-		pairsToToken[pair>>20] = int(pair) & 0xfffff
+var (
+	baseParams     internal.BPEParams
+	baseParamsErr  error
+	baseParamsOnce sync.Once
+
+	// the complete params, shared by every tokenizer of each encoding
+	baseCache internal.ParamsCache
+	editCache internal.ParamsCache
+)
+
+// getBaseParams returns the BPEParams shared by the tokenizers in this
+// package, without Name, Splitter, or SpecialTokens. The data from data.go is
+// loaded and the lookup tables are built on first use. If opts name a data
+// file, the data is loaded from that instead.
+func getBaseParams(opts gotoken.TokenizerOptions) (internal.BPEParams, error) {
+	if opts.DataPath != "" {
+		return internal.ExternalParams(opts.DataFS, opts.DataPath, "p50k_base")
 	}
+	baseParamsOnce.Do(func() {
+		var data *internal.EncodingData
+		data, baseParamsErr = loadData()
+		if baseParamsErr == nil {
+			baseParams = data.Params()
+			baseParams.DataInfo = DataInfo()
+		}
+	})
+	return baseParams, baseParamsErr
 }
 
 // getTokenizerBase returns a BPE tokenizer that uses the OpenAI p50k_base
 // encoding.
-func getTokenizerBase(allowSpecialAsText bool, allowedSpecial []string) (gotoken.Tokenizer, error) {
-	return internal.NewBPETokenizer(&internal.BPEParams{
-		Name:           "p50k_base",
-		Splitter:       internal.GPT2Splitter,
-		ByteEncoder:    byteToToken,
-		DecoderMap:     tokenList,
-		EncoderTrie:    tokenTrie,
-		SpecialTokens:  map[string]int{EndOfText: 50256},
-		BytePairLookup: pairsToToken,
-	}, allowSpecialAsText, allowedSpecial)
+func getTokenizerBase(opts gotoken.TokenizerOptions) (gotoken.Tokenizer, error) {
+	params, err := baseCache.Get(opts, func() (internal.BPEParams, error) {
+		params, err := getBaseParams(opts)
+		params.Name = "p50k_base"
+		params.Splitter = internal.GPT2SpanSplitter
+		params.SpecialTokens = SpecialTokens()
+		return params, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return internal.NewBPETokenizer(params, opts)
 }
 
 // getTokenizerEdit returns a BPE tokenizer that uses the OpenAI p50k_edit
 // variation of p50k_base.
-func getTokenizerEdit(allowSpecialAsText bool, allowedSpecial []string) (gotoken.Tokenizer, error) {
-	return internal.NewBPETokenizer(&internal.BPEParams{
-		Name:        "r50k_edit",
-		Splitter:    internal.GPT2Splitter,
-		ByteEncoder: byteToToken,
-		DecoderMap:  tokenList,
-		EncoderTrie: tokenTrie,
-		SpecialTokens: map[string]int{
-			EndOfText: 50256,
-			FIMPrefix: 50281,
-			FIMMiddle: 50282,
-			FIMSuffix: 50283,
-		},
-		BytePairLookup: pairsToToken,
-	}, allowSpecialAsText, allowedSpecial)
+func getTokenizerEdit(opts gotoken.TokenizerOptions) (gotoken.Tokenizer, error) {
+	params, err := editCache.Get(opts, func() (internal.BPEParams, error) {
+		params, err := getBaseParams(opts)
+		params.Name = "p50k_edit"
+		params.Splitter = internal.GPT2SpanSplitter
+		params.SpecialTokens = EditSpecialTokens()
+		return params, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return internal.NewBPETokenizer(params, opts)
+}
+
+// SpecialTokens returns the special tokens of the p50k_base encoding, mapped
+// to their token IDs. The map is a new copy on each call.
+func SpecialTokens() map[string]int {
+	return map[string]int{EndOfText: EndOfTextID}
+}
+
+// EditSpecialTokens is like [SpecialTokens], but for the p50k_edit encoding,
+// which adds the fill-in-the-middle tokens.
+func EditSpecialTokens() map[string]int {
+	return map[string]int{
+		EndOfText: EndOfTextID,
+		FIMPrefix: FIMPrefixID,
+		FIMMiddle: FIMMiddleID,
+		FIMSuffix: FIMSuffixID,
+	}
+}
+
+// DataInfo returns the source URL and SHA-256 of the .tiktoken file that the
+// data in this package was generated from, and when it was generated. In
+// gotoken_nodata builds, which embed no data, it returns the zero DataInfo.
+func DataInfo() gotoken.DataInfo {
+	generated, _ := time.Parse(time.RFC3339, dataGenerated)
+	return gotoken.DataInfo{Source: dataSource, SHA256: dataSHA256, Generated: generated}
 }
 
 func init() {
@@ -6,6 +6,7 @@ import (
 	"bytes"
 	"os"
 	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/peterheb/gotoken"
@@ -43,6 +44,9 @@ func TestTokenizer(t *testing.T) {
 		if err != nil {
 			t.Fatalf("instantiating tokenizer %q: %v", encoding, err)
 		}
+		if tok.Name() != encoding {
+			t.Errorf("GetTokenizer(%q).Name() = %q", encoding, tok.Name())
+		}
 		toks = append(toks, tok)
 	}
 
@@ -110,3 +114,81 @@ func FuzzP50K(f *testing.F) {
 		}
 	})
 }
+
+func TestSpecialTokens(t *testing.T) {
+	for _, tc := range []struct {
+		encoding string
+		specials map[string]int
+	}{
+		{"p50k_base", p50kbase.SpecialTokens()},
+		{"p50k_edit", p50kbase.EditSpecialTokens()},
+	} {
+		tok, err := gotoken.GetTokenizer(tc.encoding)
+		if err != nil {
+			t.Fatalf("instantiating tokenizer: %v", err)
+		}
+		for name, want := range tc.specials {
+			if got, ok := tok.SpecialTokenID(name); got != want || !ok {
+				t.Errorf("%s: SpecialTokenID(%q) = %d, %v; expected %d, true", tc.encoding, name, got, ok, want)
+			}
+			if got, err := tok.Decode([]int{want}); got != name || err != nil {
+				t.Errorf("%s: Decode([]int{%d}) = %q, %v; expected %q", tc.encoding, want, got, err, name)
+			}
+		}
+		n := 0
+		tok.VocabIter()(func(token int, _ []byte) bool {
+			if tok.IsSpecialToken(token) {
+				n++
+			}
+			return true
+		})
+		if n != len(tc.specials) {
+			t.Errorf("%s: %d special tokens in the vocabulary; expected %d", tc.encoding, n, len(tc.specials))
+		}
+	}
+
+	// Each call returns a new map
+	p50kbase.SpecialTokens()[p50kbase.EndOfText] = -1
+	if got := p50kbase.SpecialTokens()[p50kbase.EndOfText]; got == -1 {
+		t.Error("SpecialTokens() returned a map modified by the caller")
+	}
+}
+
+func TestDataInfo(t *testing.T) {
+	info := p50kbase.DataInfo()
+	if !strings.HasSuffix(info.Source, "/p50k_base.tiktoken") || len(info.SHA256) != 64 || info.Generated.IsZero() {
+		t.Errorf("DataInfo() = %+v; expected the source URL, SHA-256, and time", info)
+	}
+	for _, encoding := range []string{"p50k_base", "p50k_edit"} {
+		tok, err := gotoken.GetTokenizer(encoding)
+		if err != nil {
+			t.Fatalf("instantiating tokenizer: %v", err)
+		}
+		if got := tok.(gotoken.Describer).DataInfo(); got != info {
+			t.Errorf("%s: DataInfo() = %+v; expected %+v", encoding, got, info)
+		}
+	}
+}
+
+// TestHealPromptSpecial checks that HealPrompt leaves a final <|endoftext|>
+// alone. Its ID, 50256, falls in a gap in p50k_base's decoder list, between
+// ordinary tokens, so it can't be told apart by range.
+func TestHealPromptSpecial(t *testing.T) {
+	tok, err := gotoken.GetTokenizer("p50k_base", gotoken.WithSpecialTokens(p50kbase.EndOfText))
+	if err != nil {
+		t.Fatalf("GetTokenizer: %v", err)
+	}
+	const prompt = "hello " + p50kbase.EndOfText
+	want, err := tok.Encode(prompt)
+	if err != nil {
+		t.Fatalf("Encode(%q): %v", prompt, err)
+	}
+	healed, err := tok.HealPrompt(prompt)
+	if err != nil {
+		t.Fatalf("HealPrompt(%q): %v", prompt, err)
+	}
+	if healed.Removed != "" || healed.Candidates != nil || !reflect.DeepEqual(healed.Tokens, want) {
+		t.Errorf("HealPrompt(%q) = %v, removed %q with %d candidates; want %v, nothing removed",
+			prompt, healed.Tokens, healed.Removed, len(healed.Candidates), want)
+	}
+}
@@ -0,0 +1,136 @@
+// Copyright 2023 Peter Hebert. Licensed under the MIT license.
+
+// Package pricing estimates the cost of API requests from their token counts.
+// Prices are typed as US dollars per million tokens, so that per-token,
+// per-thousand, and per-million prices can't be mixed up:
+//
+//	est, err := pricing.Default.Estimate("gpt-4", promptTokens, 500)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Printf("about %v\n", est.Total())
+//
+// The prices in [Default] are OpenAI's list prices at the time of writing, and
+// will go out of date. Copy the table and change or add entries as needed:
+//
+//	prices := pricing.Default.Clone()
+//	prices["my-fine-tune"] = pricing.Price{Prompt: 12, Completion: 16}
+package pricing
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/peterheb/gotoken"
+)
+
+// USD is an amount of money in US dollars.
+type USD float64
+
+// String formats u with enough decimal places to show the cost of a single
+// token, like "$0.0015".
+func (u USD) String() string {
+	s := fmt.Sprintf("%.6f", float64(u))
+	s = strings.TrimRight(s, "0")
+	if i := strings.IndexByte(s, '.'); len(s)-i-1 < 2 {
+		s += strings.Repeat("0", 2-(len(s)-i-1))
+	}
+	if strings.HasPrefix(s, "-") {
+		return "-$" + s[1:]
+	}
+	return "$" + s
+}
+
+// Price is the price of a model's tokens, in US dollars per million tokens.
+type Price struct {
+	Prompt     USD // per million prompt (input) tokens
+	Completion USD // per million completion (output) tokens
+}
+
+// Table maps model names to their prices.
+type Table map[string]Price
+
+// Default holds OpenAI's list prices as of June 2023.
+var Default = Table{
+	"gpt-4":                  {Prompt: 30, Completion: 60},
+	"gpt-4-32k":              {Prompt: 60, Completion: 120},
+	"gpt-3.5-turbo":          {Prompt: 1.5, Completion: 2},
+	"gpt-3.5-turbo-16k":      {Prompt: 3, Completion: 4},
+	"text-davinci-003":       {Prompt: 20, Completion: 20},
+	"text-davinci-002":       {Prompt: 20, Completion: 20},
+	"text-curie-001":         {Prompt: 2, Completion: 2},
+	"text-babbage-001":       {Prompt: 0.5, Completion: 0.5},
+	"text-ada-001":           {Prompt: 0.4, Completion: 0.4},
+	"text-embedding-ada-002": {Prompt: 0.1},
+}
+
+// Estimate is the estimated cost of a request.
+type Estimate struct {
+	Model            string // the model the price was found for
+	PromptTokens     int
+	CompletionTokens int
+	Prompt           USD // the cost of the prompt tokens
+	Completion       USD // the cost of the completion tokens
+}
+
+// Total returns the cost of the prompt and completion together.
+func (e Estimate) Total() USD {
+	return e.Prompt + e.Completion
+}
+
+// Clone returns a copy of t that can be changed without affecting t.
+func (t Table) Clone() Table {
+	ret := make(Table, len(t))
+	for model, price := range t {
+		ret[model] = price
+	}
+	return ret
+}
+
+// Lookup returns the price of model, and the name it was found under. A model
+// that isn't in t uses the price of the longest name in t that it starts with,
+// followed by "-", so that dated snapshots like "gpt-4-0613" are priced like
+// "gpt-4". It returns an error if no price is found.
+func (t Table) Lookup(model string) (Price, string, error) {
+	if price, ok := t[model]; ok {
+		return price, model, nil
+	}
+	best := ""
+	for name := range t {
+		if len(name) > len(best) && strings.HasPrefix(model, name+"-") {
+			best = name
+		}
+	}
+	if best == "" {
+		return Price{}, "", fmt.Errorf("no price for model %q", model)
+	}
+	return t[best], best, nil
+}
+
+// Estimate returns the cost of a request to model with the given numbers of
+// prompt and completion tokens. For a completion that hasn't happened yet,
+// completionTokens can be the max_tokens of the request, for the highest
+// possible cost.
+func (t Table) Estimate(model string, promptTokens, completionTokens int) (Estimate, error) {
+	price, name, err := t.Lookup(model)
+	if err != nil {
+		return Estimate{}, err
+	}
+	return Estimate{
+		Model:            name,
+		PromptTokens:     promptTokens,
+		CompletionTokens: completionTokens,
+		Prompt:           price.Prompt * USD(promptTokens) / 1e6,
+		Completion:       price.Completion * USD(completionTokens) / 1e6,
+	}, nil
+}
+
+// EstimateText is like Estimate, but counts the tokens of prompt with tok. It
+// returns an error if prompt can't be encoded.
+func (t Table) EstimateText(tok gotoken.Tokenizer, model, prompt string, completionTokens int) (Estimate, error) {
+	tokens, err := tok.Encode(prompt)
+	if err != nil {
+		return Estimate{}, err
+	}
+	return t.Estimate(model, len(tokens), completionTokens)
+}
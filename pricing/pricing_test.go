@@ -0,0 +1,93 @@
+// Copyright 2023 Peter Hebert. Licensed under the MIT license.
+
+package pricing
+
+import (
+	"testing"
+
+	"github.com/peterheb/gotoken"
+	_ "github.com/peterheb/gotoken/cl100kbase"
+)
+
+func TestUSD_String(t *testing.T) {
+	tests := []struct {
+		u    USD
+		want string
+	}{
+		{0, "$0.00"},
+		{1.5, "$1.50"},
+		{12, "$12.00"},
+		{0.0015, "$0.0015"},
+		{0.00003, "$0.00003"},
+		{-2.25, "-$2.25"},
+	}
+	for _, tt := range tests {
+		if got := tt.u.String(); got != tt.want {
+			t.Errorf("USD(%v).String() = %q, want %q", float64(tt.u), got, tt.want)
+		}
+	}
+}
+
+func TestTable_Estimate(t *testing.T) {
+	tests := []struct {
+		model      string
+		wantModel  string
+		prompt     USD
+		completion USD
+	}{
+		{"gpt-4", "gpt-4", 0.03, 0.03},
+		{"gpt-4-0613", "gpt-4", 0.03, 0.03},
+		{"gpt-4-32k-0613", "gpt-4-32k", 0.06, 0.06},
+		{"gpt-3.5-turbo-16k", "gpt-3.5-turbo-16k", 0.003, 0.002},
+	}
+	for _, tt := range tests {
+		got, err := Default.Estimate(tt.model, 1000, 500)
+		if err != nil {
+			t.Fatalf("Estimate(%q): %v", tt.model, err)
+		}
+		if got.Model != tt.wantModel || !near(got.Prompt, tt.prompt) || !near(got.Completion, tt.completion) {
+			t.Errorf("Estimate(%q) = %+v, want %s at %v + %v", tt.model, got, tt.wantModel, tt.prompt, tt.completion)
+		}
+		if !near(got.Total(), tt.prompt+tt.completion) {
+			t.Errorf("Estimate(%q).Total() = %v", tt.model, got.Total())
+		}
+	}
+
+	for _, model := range []string{"gpt-5", "gpt-40", ""} {
+		if _, err := Default.Estimate(model, 1, 1); err == nil {
+			t.Errorf("Estimate(%q): no error", model)
+		}
+	}
+
+	prices := Default.Clone()
+	prices["gpt-4"] = Price{Prompt: 1, Completion: 2}
+	if got, _ := prices.Estimate("gpt-4", 1e6, 1e6); got.Total() != 3 {
+		t.Errorf("Estimate() with a changed price: Total() = %v, want 3", got.Total())
+	}
+	if got, _ := Default.Estimate("gpt-4", 1e6, 0); got.Total() != 30 {
+		t.Errorf("changing a clone changed Default: Total() = %v", got.Total())
+	}
+}
+
+func TestTable_EstimateText(t *testing.T) {
+	tok, err := gotoken.GetTokenizer("cl100k_base")
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := Default.EstimateText(tok, "gpt-4", "hello world", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.PromptTokens != 2 || !near(got.Prompt, 0.00006) {
+		t.Errorf("EstimateText() = %+v, want 2 tokens costing $0.00006", got)
+	}
+	if _, err := Default.EstimateText(tok, "gpt-4", "<|endoftext|>", 0); err == nil {
+		t.Error("EstimateText() with a special token: no error")
+	}
+}
+
+// near reports whether a and b are equal, give or take rounding.
+func near(a, b USD) bool {
+	d := a - b
+	return d < 1e-12 && d > -1e-12
+}
@@ -0,0 +1,57 @@
+// Copyright 2023 Peter Hebert. Licensed under the MIT license.
+
+package r50kbase
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"testing/fstest"
+
+	"github.com/peterheb/gotoken"
+	"github.com/peterheb/gotoken/internal"
+)
+
+// TestDataFile checks that a tokenizer using a data file, written from the
+// embedded data, encodes the same as one using the embedded data.
+func TestDataFile(t *testing.T) {
+	d, err := loadData()
+	if err != nil {
+		t.Skipf("no embedded data: %v", err)
+	}
+	data := internal.MarshalEncodingData(d)
+	path := filepath.Join(t.TempDir(), "r50k_base.gotoken")
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		t.Fatalf("writing data file: %v", err)
+	}
+	fsys := fstest.MapFS{"r50k_base.gotoken": &fstest.MapFile{Data: data}}
+
+	embedded, err := gotoken.GetTokenizer("r50k_base")
+	if err != nil {
+		t.Fatalf("instantiating tokenizer: %v", err)
+	}
+	const input = "Hello, world! It's a test of data files. 🎉"
+	want, err := embedded.Encode(input)
+	if err != nil {
+		t.Fatalf("Encode(%q): %v", input, err)
+	}
+
+	for _, opt := range []gotoken.Option{gotoken.WithDataFile(path), gotoken.WithDataFS(fsys, "r50k_base.gotoken")} {
+		tok, err := gotoken.GetTokenizer("r50k_base", opt)
+		if err != nil {
+			t.Fatalf("instantiating tokenizer: %v", err)
+		}
+		got, err := tok.Encode(input)
+		if err != nil || !reflect.DeepEqual(got, want) {
+			t.Errorf("Encode(%q) got %v, %v; expected %v", input, got, err, want)
+		}
+		decoded, err := tok.Decode(got)
+		if err != nil || decoded != input {
+			t.Errorf("Decode(%v) got %q, %v; expected %q", got, decoded, err, input)
+		}
+		if info := tok.(gotoken.Describer).DataInfo(); info != (gotoken.DataInfo{}) {
+			t.Errorf("DataInfo() got %+v for a data file; expected the zero DataInfo", info)
+		}
+	}
+}
@@ -3,6 +3,9 @@
 package r50kbase
 
 import (
+	"sync"
+	"time"
+
 	"github.com/peterheb/gotoken"
 	"github.com/peterheb/gotoken/internal"
 )
@@ -12,35 +15,69 @@ const (
 	EndOfText = "<|endoftext|>"
 )
 
-// pairsToToken is a lookup table that maps pairs of bytes to their token, or -1
-// if the pair is not present in the encoding. This is used to bootstrap
-// byte-pair-encoding and is generated from bytePairLookup in data.go.
-var pairsToToken []int
+// This is the token ID of the special token.
+const (
+	EndOfTextID = 50256
+)
+
+var (
+	baseParams     internal.BPEParams
+	baseParamsErr  error
+	baseParamsOnce sync.Once
 
-func init() {
-	// inflate bytePairLookup into pairsToToken
-	pairsToToken = make([]int, 65536)
-	for i := 0; i < 65536; i++ {
-		pairsToToken[i] = -1
+	// the complete params, shared by every tokenizer of this encoding
+	paramsCache internal.ParamsCache
+)
+
+// getBaseParams returns the BPEParams shared by the tokenizers in this
+// package, without Name, Splitter, or SpecialTokens. The data from data.go is
+// loaded and the lookup tables are built on first use. If opts name a data
+// file, the data is loaded from that instead.
+func getBaseParams(opts gotoken.TokenizerOptions) (internal.BPEParams, error) {
+	if opts.DataPath != "" {
+		return internal.ExternalParams(opts.DataFS, opts.DataPath, "r50k_base")
 	}
-	for _, pair := range bytePairLookup {
-		pairsToToken[pair>>20] = int(pair) & 0xfffff
+	baseParamsOnce.Do(func() {
+		var data *internal.EncodingData
+		data, baseParamsErr = loadData()
+		if baseParamsErr == nil {
+			baseParams = data.Params()
+			baseParams.DataInfo = DataInfo()
+		}
+	})
+	return baseParams, baseParamsErr
+}
+
+// getTokenizer returns a BPE tokenizer that uses the OpenAI r50k_base encoding.
+func getTokenizer(opts gotoken.TokenizerOptions) (gotoken.Tokenizer, error) {
+	params, err := paramsCache.Get(opts, func() (internal.BPEParams, error) {
+		params, err := getBaseParams(opts)
+		params.Name = "r50k_base"
+		params.Splitter = internal.GPT2SpanSplitter
+		params.SpecialTokens = SpecialTokens()
+		return params, err
+	})
+	if err != nil {
+		return nil, err
 	}
+	return internal.NewBPETokenizer(params, opts)
+}
+
+// SpecialTokens returns the special tokens of this encoding, mapped to their
+// token IDs. The map is a new copy on each call.
+func SpecialTokens() map[string]int {
+	return map[string]int{EndOfText: EndOfTextID}
 }
 
-// Tokenizer returns a BPE tokenizer that uses the OpenAI r50k_base encoding.
-func getTokenizer(allowSpecialAsText bool, allowedSpecial []string) (gotoken.Tokenizer, error) {
-	return internal.NewBPETokenizer(&internal.BPEParams{
-		Name:           "r50k_base",
-		Splitter:       internal.GPT2Splitter,
-		ByteEncoder:    byteToToken,
-		DecoderMap:     tokenList,
-		EncoderTrie:    tokenTrie,
-		SpecialTokens:  map[string]int{EndOfText: 50256},
-		BytePairLookup: pairsToToken,
-	}, allowSpecialAsText, allowedSpecial)
+// DataInfo returns the source URL and SHA-256 of the .tiktoken file that the
+// data in this package was generated from, and when it was generated. In
+// gotoken_nodata builds, which embed no data, it returns the zero DataInfo.
+func DataInfo() gotoken.DataInfo {
+	generated, _ := time.Parse(time.RFC3339, dataGenerated)
+	return gotoken.DataInfo{Source: dataSource, SHA256: dataSHA256, Generated: generated}
 }
 
 func init() {
 	gotoken.RegisterTokenizer("r50k_base", getTokenizer)
+	gotoken.RegisterAlias("gpt2", "r50k_base") // tiktoken's name for r50k_base
 }
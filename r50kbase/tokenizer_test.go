@@ -7,6 +7,7 @@ import (
 	"bytes"
 	"os"
 	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/peterheb/gotoken"
@@ -66,6 +67,63 @@ func TestTokenizer(t *testing.T) {
 	}
 }
 
+// TestEncodeSuffix checks that splitting each sample at every byte offset and
+// joining the halves with EncodeSuffix gives the same tokens as Encode.
+func TestEncodeSuffix(t *testing.T) {
+	tpr, err := internal.NewTestPairReader(testInput, testExpected)
+	if err != nil {
+		t.Fatalf("loading test data: %v", err)
+	}
+	defer tpr.Close()
+
+	tok, err := gotoken.GetTokenizer("r50k_base")
+	if err != nil {
+		t.Fatalf("instantiating tokenizer: %v", err)
+	}
+
+	for {
+		tc, err := tpr.Next()
+		if err != nil {
+			t.Fatalf("loading test data: %v", err)
+		}
+		if tc == nil {
+			break
+		}
+		for i := 0; i <= len(tc.Input); i++ {
+			prompt, err := tok.Encode(tc.Input[:i])
+			if err != nil {
+				t.Fatalf("Encode(%q): %v", tc.Input[:i], err)
+			}
+			actual, err := tok.EncodeSuffix(prompt, tc.Input[i:])
+			if err != nil {
+				t.Fatalf("EncodeSuffix(%q, %q): %v", tc.Input[:i], tc.Input[i:], err)
+			}
+			if !reflect.DeepEqual(actual, tc.Expected) {
+				t.Errorf("%s: EncodeSuffix(%q, %q) got %#v; expected %#v", tpr.CaseName(), tc.Input[:i], tc.Input[i:], actual, tc.Expected)
+			}
+		}
+	}
+}
+
+func TestAlias(t *testing.T) {
+	tok, err := gotoken.GetTokenizer("gpt2")
+	if err != nil {
+		t.Fatalf("instantiating tokenizer: %v", err)
+	}
+	if tokens, _ := tok.Encode("hello world"); !reflect.DeepEqual(tokens, []int{31373, 995}) {
+		t.Errorf("Encode(%q) got %#v; expected %#v", "hello world", tokens, []int{31373, 995})
+	}
+	if got, ok := tok.EOTToken(); got != 50256 || !ok {
+		t.Errorf("EOTToken() = %d, %v; expected 50256, true", got, ok)
+	}
+	if got := tok.Name(); got != "r50k_base" {
+		t.Errorf("Name() = %q; expected \"r50k_base\"", got)
+	}
+	if got := gotoken.ListAliases()["gpt2"]; got != "r50k_base" {
+		t.Errorf("ListAliases()[\"gpt2\"] = %q; expected \"r50k_base\"", got)
+	}
+}
+
 func FuzzR50K(f *testing.F) {
 	tok, err := gotoken.GetTokenizer("r50k_base", gotoken.WithSpecialTokensAsText())
 	if err != nil {
@@ -146,3 +204,57 @@ func BenchmarkEncode(b *testing.B) {
 		}
 	}
 }
+
+func TestSpecialTokens(t *testing.T) {
+	for _, tc := range []struct {
+		encoding string
+		specials map[string]int
+	}{
+		{"r50k_base", r50kbase.SpecialTokens()},
+	} {
+		tok, err := gotoken.GetTokenizer(tc.encoding)
+		if err != nil {
+			t.Fatalf("instantiating tokenizer: %v", err)
+		}
+		for name, want := range tc.specials {
+			if got, ok := tok.SpecialTokenID(name); got != want || !ok {
+				t.Errorf("%s: SpecialTokenID(%q) = %d, %v; expected %d, true", tc.encoding, name, got, ok, want)
+			}
+			if got, err := tok.Decode([]int{want}); got != name || err != nil {
+				t.Errorf("%s: Decode([]int{%d}) = %q, %v; expected %q", tc.encoding, want, got, err, name)
+			}
+		}
+		n := 0
+		tok.VocabIter()(func(token int, _ []byte) bool {
+			if tok.IsSpecialToken(token) {
+				n++
+			}
+			return true
+		})
+		if n != len(tc.specials) {
+			t.Errorf("%s: %d special tokens in the vocabulary; expected %d", tc.encoding, n, len(tc.specials))
+		}
+	}
+
+	// Each call returns a new map
+	r50kbase.SpecialTokens()[r50kbase.EndOfText] = -1
+	if got := r50kbase.SpecialTokens()[r50kbase.EndOfText]; got == -1 {
+		t.Error("SpecialTokens() returned a map modified by the caller")
+	}
+}
+
+func TestDataInfo(t *testing.T) {
+	info := r50kbase.DataInfo()
+	if !strings.HasSuffix(info.Source, "/r50k_base.tiktoken") || len(info.SHA256) != 64 || info.Generated.IsZero() {
+		t.Errorf("DataInfo() = %+v; expected the source URL, SHA-256, and time", info)
+	}
+	for _, encoding := range []string{"r50k_base"} {
+		tok, err := gotoken.GetTokenizer(encoding)
+		if err != nil {
+			t.Fatalf("instantiating tokenizer: %v", err)
+		}
+		if got := tok.(gotoken.Describer).DataInfo(); got != info {
+			t.Errorf("%s: DataInfo() = %+v; expected %+v", encoding, got, info)
+		}
+	}
+}
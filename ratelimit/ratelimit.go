@@ -0,0 +1,129 @@
+// Copyright 2023 Peter Hebert. Licensed under the MIT license.
+
+// Package ratelimit limits requests by the number of model tokens they
+// contain, rather than by the number of requests, for enforcing a
+// tokens-per-minute quota like OpenAI's:
+//
+//	tok, err := gotoken.GetTokenizer("cl100k_base", gotoken.WithSpecialTokensAsText())
+//	...
+//	lim := ratelimit.New(tok, 90000, 0)
+//	ok, retryAfter, err := lim.Allow(prompt)
+//	if err != nil {
+//	    http.Error(w, err.Error(), http.StatusBadRequest)
+//	    return
+//	}
+//	if !ok {
+//	    w.Header().Set("Retry-After", strconv.Itoa(int(retryAfter.Seconds()+1)))
+//	    w.WriteHeader(http.StatusTooManyRequests)
+//	    return
+//	}
+//
+// A [Limiter] is a token bucket: it holds up to a burst of tokens, and refills
+// at a steady rate. Each allowed request takes its tokens from the bucket.
+package ratelimit
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/peterheb/gotoken"
+)
+
+// Never is the retryAfter returned by [Limiter.Allow] for a request that can
+// never be allowed, because it has more tokens than the Limiter's burst.
+const Never = time.Duration(math.MaxInt64)
+
+// Limiter allows requests at a rate measured in tokens. It is safe for
+// concurrent use.
+type Limiter struct {
+	tok   gotoken.Tokenizer
+	rate  float64 // tokens added per second
+	burst float64 // size of the bucket
+
+	mu     sync.Mutex
+	tokens float64   // tokens in the bucket as of last
+	last   time.Time // when tokens was last brought up to date
+
+	now func() time.Time // time.Now, replaced in tests
+}
+
+// New returns a Limiter that counts tokens with tok, and allows
+// tokensPerMinute tokens per minute, with bursts of up to burst tokens. If
+// burst is 0 or less, it is tokensPerMinute, so that a full minute's quota
+// can be used at once. The Limiter starts with a full bucket.
+//
+// Inputs that tok fails to count, like those with special tokens that tok
+// doesn't allow, are rejected by [Limiter.Allow] with an error, so tok should
+// usually be created with [gotoken.WithSpecialTokensAsText].
+func New(tok gotoken.Tokenizer, tokensPerMinute, burst int) *Limiter {
+	if burst <= 0 {
+		burst = tokensPerMinute
+	}
+	l := &Limiter{
+		tok:   tok,
+		rate:  float64(tokensPerMinute) / 60,
+		burst: float64(burst),
+		now:   time.Now,
+	}
+	l.tokens = l.burst
+	l.last = l.now()
+	return l
+}
+
+// Allow counts the tokens in input, and reports whether they are available.
+// If they are, they are taken from the Limiter, and retryAfter is 0. If not,
+// nothing is taken, and retryAfter is how long until they will be, if no other
+// requests take them first, or [Never] if input has more tokens than the
+// Limiter's burst.
+//
+// If the tokens of input can't be counted, for example because it is longer
+// than the tokenizer's [gotoken.WithMaxInputBytes] limit, the error is
+// returned, ok is false, and nothing is taken.
+func (l *Limiter) Allow(input string) (ok bool, retryAfter time.Duration, err error) {
+	n, err := l.tok.CountCtx(context.Background(), input)
+	if err != nil {
+		return false, 0, err
+	}
+	ok, retryAfter = l.AllowN(n)
+	return ok, retryAfter, nil
+}
+
+// AllowN is like [Limiter.Allow], but for n tokens that have already been
+// counted, like the max_tokens of a completion request.
+func (l *Limiter) AllowN(n int) (ok bool, retryAfter time.Duration) {
+	need := float64(n)
+	if need > l.burst {
+		return false, Never
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.refill()
+	if need <= l.tokens {
+		l.tokens -= need
+		return true, 0
+	}
+	if l.rate <= 0 {
+		return false, Never
+	}
+	wait := time.Duration(math.Ceil((need - l.tokens) / l.rate * float64(time.Second)))
+	return false, wait
+}
+
+// Tokens returns the number of tokens available now.
+func (l *Limiter) Tokens() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.refill()
+	return int(l.tokens)
+}
+
+// refill adds the tokens earned since the last refill. l.mu must be held.
+func (l *Limiter) refill() {
+	now := l.now()
+	if elapsed := now.Sub(l.last); elapsed > 0 {
+		l.tokens = math.Min(l.burst, l.tokens+elapsed.Seconds()*l.rate)
+	}
+	l.last = now
+}
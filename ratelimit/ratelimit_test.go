@@ -0,0 +1,103 @@
+// Copyright 2023 Peter Hebert. Licensed under the MIT license.
+
+package ratelimit
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/peterheb/gotoken"
+	_ "github.com/peterheb/gotoken/r50kbase"
+)
+
+// newTestLimiter returns a Limiter whose clock only moves when the returned
+// function is called.
+func newTestLimiter(t *testing.T, tokensPerMinute, burst int) (*Limiter, func(time.Duration)) {
+	tok, err := gotoken.GetTokenizer("r50k_base", gotoken.WithSpecialTokensAsText())
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Unix(0, 0)
+	l := New(tok, tokensPerMinute, burst)
+	l.now = func() time.Time { return now }
+	l.last = now
+	return l, func(d time.Duration) { now = now.Add(d) }
+}
+
+func TestLimiter_Allow(t *testing.T) {
+	// 60 tokens per minute is 1 per second, with a burst of 5
+	l, advance := newTestLimiter(t, 60, 5)
+
+	// "hello world" is 2 tokens
+	for i := 0; i < 2; i++ {
+		if ok, retry, err := l.Allow("hello world"); !ok || retry != 0 || err != nil {
+			t.Fatalf("Allow() #%d = %v, %v, %v; want true, 0, nil", i, ok, retry, err)
+		}
+	}
+	if n := l.Tokens(); n != 1 {
+		t.Errorf("Tokens() = %d, want 1", n)
+	}
+	if ok, retry, _ := l.Allow("hello world"); ok || retry != time.Second {
+		t.Errorf("Allow() with 1 token left = %v, %v; want false, 1s", ok, retry)
+	}
+	// A denied request takes nothing
+	if n := l.Tokens(); n != 1 {
+		t.Errorf("Tokens() after denied request = %d, want 1", n)
+	}
+
+	advance(time.Second)
+	if ok, _, _ := l.Allow("hello world"); !ok {
+		t.Errorf("Allow() after refill = false, want true")
+	}
+
+	// The bucket doesn't fill past the burst
+	advance(time.Hour)
+	if n := l.Tokens(); n != 5 {
+		t.Errorf("Tokens() after an hour = %d, want 5", n)
+	}
+
+	// More tokens than the burst can never be allowed
+	if ok, retry := l.AllowN(6); ok || retry != Never {
+		t.Errorf("AllowN(6) = %v, %v; want false, Never", ok, retry)
+	}
+	if ok, _ := l.AllowN(5); !ok {
+		t.Errorf("AllowN(5) = false, want true")
+	}
+	if ok, retry := l.AllowN(3); ok || retry != 3*time.Second {
+		t.Errorf("AllowN(3) on empty bucket = %v, %v; want false, 3s", ok, retry)
+	}
+}
+
+func TestLimiter_AllowError(t *testing.T) {
+	tok, err := gotoken.GetTokenizer("r50k_base")
+	if err != nil {
+		t.Fatal(err)
+	}
+	l := New(tok, 60, 5)
+
+	// A disallowed special token can't be counted, so the input is rejected
+	// rather than admitted for free
+	ok, retry, err := l.Allow("<|endoftext|>")
+	if ok || retry != 0 || !errors.Is(err, gotoken.ErrSpecialToken) {
+		t.Errorf("Allow() = %v, %v, %v; want false, 0, ErrSpecialToken", ok, retry, err)
+	}
+	if n := l.Tokens(); n != 5 {
+		t.Errorf("Tokens() after error = %d, want 5", n)
+	}
+}
+
+func TestNew_DefaultBurst(t *testing.T) {
+	l, _ := newTestLimiter(t, 1000, 0)
+	if n := l.Tokens(); n != 1000 {
+		t.Errorf("Tokens() = %d, want 1000", n)
+	}
+
+	// A zero rate never refills
+	l, advance := newTestLimiter(t, 0, 10)
+	l.AllowN(10)
+	advance(time.Hour)
+	if ok, retry := l.AllowN(1); ok || retry != Never {
+		t.Errorf("AllowN(1) with zero rate = %v, %v; want false, Never", ok, retry)
+	}
+}
@@ -0,0 +1,184 @@
+// Copyright 2023 Peter Hebert. Licensed under the MIT license.
+
+// Package stats collects token statistics for a corpus: how many tokens it
+// encodes to, how many tokens per byte, and how often each token occurs. It is
+// for comparing encodings on a particular kind of text, or estimating the cost
+// of processing a corpus:
+//
+//	tok, err := gotoken.GetTokenizer("cl100k_base")
+//	...
+//	s, err := stats.Analyze(tok, corpus)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Printf("%d tokens, %.3f tokens/byte\n", s.Tokens, s.TokensPerByte())
+//	for _, tc := range s.Top(10) {
+//	    fmt.Printf("%6d %q\n", tc.Count, tc.Text)
+//	}
+//
+// The "gotoken stats" command does the same from the command line.
+package stats
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/peterheb/gotoken"
+)
+
+// Stats holds the token statistics of the text added to it.
+type Stats struct {
+	tok    gotoken.Tokenizer
+	Bytes  int64   // number of bytes of text
+	Lines  int64   // number of lines of text read by Analyze
+	Tokens int64   // number of tokens the text encoded to
+	Counts []int64 // number of times each token occurred, indexed by token
+}
+
+// TokenCount is the number of times a token occurred.
+type TokenCount struct {
+	Token int
+	Text  string // the decoded token; invalid UTF-8 is kept as is
+	Count int64
+}
+
+// Bucket is a range of a token-frequency histogram: the number of distinct
+// tokens that occurred between Min and Max times, inclusive.
+type Bucket struct {
+	Min, Max int64
+	Tokens   int
+}
+
+// New returns an empty Stats for text encoded with tok.
+func New(tok gotoken.Tokenizer) *Stats {
+	return &Stats{
+		tok:    tok,
+		Counts: make([]int64, tok.MaxToken()+1),
+	}
+}
+
+// Analyze returns the Stats for the text read from r, encoded with tok. The
+// text is encoded one line at a time, including the line break, so that a
+// corpus of any size can be read without holding it in memory. Because tokens
+// don't span lines this way, the count can differ slightly from encoding all
+// of the text at once.
+func Analyze(tok gotoken.Tokenizer, r io.Reader) (*Stats, error) {
+	ret, err := AnalyzeEach([]gotoken.Tokenizer{tok}, r)
+	if err != nil {
+		return nil, err
+	}
+	return ret[0], nil
+}
+
+// AnalyzeEach is like Analyze, but returns the Stats for each of toks, reading
+// r only once.
+func AnalyzeEach(toks []gotoken.Tokenizer, r io.Reader) ([]*Stats, error) {
+	ret := make([]*Stats, len(toks))
+	for i, tok := range toks {
+		ret[i] = New(tok)
+	}
+	br := bufio.NewReaderSize(r, 64*1024)
+	for {
+		line, err := br.ReadString('\n')
+		if len(line) > 0 {
+			for _, s := range ret {
+				if addErr := s.Add(line); addErr != nil {
+					return nil, fmt.Errorf("%s: line %d: %w", s.tok.Name(), s.Lines+1, addErr)
+				}
+				s.Lines++
+			}
+		}
+		if err == io.EOF {
+			return ret, nil
+		} else if err != nil {
+			return nil, err
+		}
+	}
+}
+
+// Add encodes text and adds its tokens to s. If text can't be encoded, s is
+// unchanged and the error is returned.
+func (s *Stats) Add(text string) error {
+	tokens, err := s.tok.Encode(text)
+	if err != nil {
+		return err
+	}
+	s.Bytes += int64(len(text))
+	s.Tokens += int64(len(tokens))
+	for _, token := range tokens {
+		s.Counts[token]++
+	}
+	return nil
+}
+
+// Name returns the name of the encoding of s.
+func (s *Stats) Name() string {
+	return s.tok.Name()
+}
+
+// TokensPerByte returns the average number of tokens per byte of text, or 0 if
+// no text has been added.
+func (s *Stats) TokensPerByte() float64 {
+	if s.Bytes == 0 {
+		return 0
+	}
+	return float64(s.Tokens) / float64(s.Bytes)
+}
+
+// Distinct returns the number of different tokens that occurred.
+func (s *Stats) Distinct() int {
+	n := 0
+	for _, count := range s.Counts {
+		if count > 0 {
+			n++
+		}
+	}
+	return n
+}
+
+// Top returns the n most frequent tokens, most frequent first, with ties in
+// token order. Fewer are returned if fewer than n different tokens occurred.
+func (s *Stats) Top(n int) []TokenCount {
+	var ret []TokenCount
+	for token, count := range s.Counts {
+		if count > 0 {
+			ret = append(ret, TokenCount{Token: token, Count: count})
+		}
+	}
+	sort.SliceStable(ret, func(i, j int) bool {
+		return ret[i].Count > ret[j].Count
+	})
+	if len(ret) > n {
+		ret = ret[:n]
+	}
+	for i := range ret {
+		// Decoding fails for count-only builds, which leaves the text empty
+		ret[i].Text, _ = s.tok.DecodeToken(ret[i].Token)
+	}
+	return ret
+}
+
+// Histogram returns the token-frequency histogram of s, in buckets of powers
+// of two: the number of distinct tokens that occurred once, 2-3 times, 4-7
+// times, and so on, up to the bucket of the most frequent token. Tokens that
+// never occurred are not counted.
+func (s *Stats) Histogram() []Bucket {
+	var ret []Bucket
+	for _, count := range s.Counts {
+		if count == 0 {
+			continue
+		}
+		i := 0
+		for c := count; c > 1; c >>= 1 {
+			i++
+		}
+		for len(ret) <= i {
+			min := int64(1) << len(ret)
+			ret = append(ret, Bucket{Min: min, Max: 2*min - 1})
+		}
+		ret[i].Tokens++
+	}
+	return ret
+}
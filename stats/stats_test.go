@@ -0,0 +1,91 @@
+// Copyright 2023 Peter Hebert. Licensed under the MIT license.
+
+package stats
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/peterheb/gotoken"
+	_ "github.com/peterheb/gotoken/p50kbase"
+	_ "github.com/peterheb/gotoken/r50kbase"
+)
+
+func TestAnalyze(t *testing.T) {
+	tok, err := gotoken.GetTokenizer("r50k_base")
+	if err != nil {
+		t.Fatal(err)
+	}
+	corpus := "the cat\nthe dog\nthe the"
+	s, err := Analyze(tok, strings.NewReader(corpus))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// "the", " cat", "\n", "the", " dog", "\n", "the", " the"
+	if s.Lines != 3 || s.Bytes != int64(len(corpus)) || s.Tokens != 8 {
+		t.Errorf("Lines, Bytes, Tokens = %d, %d, %d; want 3, %d, 8", s.Lines, s.Bytes, s.Tokens, len(corpus))
+	}
+	if got, want := s.TokensPerByte(), 8.0/float64(len(corpus)); got != want {
+		t.Errorf("TokensPerByte() = %v, want %v", got, want)
+	}
+	if got := s.Distinct(); got != 5 {
+		t.Errorf("Distinct() = %d, want 5", got)
+	}
+
+	top := s.Top(2)
+	if len(top) != 2 || top[0].Text != "the" || top[0].Count != 3 || top[1].Text != "\n" || top[1].Count != 2 {
+		t.Errorf("Top(2) = %+v, want \"the\" x3, \"\\n\" x2", top)
+	}
+	if got := len(s.Top(100)); got != 5 {
+		t.Errorf("len(Top(100)) = %d, want 5", got)
+	}
+
+	want := []Bucket{{1, 1, 3}, {2, 3, 2}}
+	if got := s.Histogram(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Histogram() = %+v, want %+v", got, want)
+	}
+}
+
+func TestAdd(t *testing.T) {
+	tok, err := gotoken.GetTokenizer("r50k_base")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := New(tok)
+	if err := s.Add("<|endoftext|>"); err == nil {
+		t.Error("Add() with a special token: no error")
+	}
+	if s.Bytes != 0 || s.Tokens != 0 {
+		t.Errorf("after an error, Bytes, Tokens = %d, %d; want 0, 0", s.Bytes, s.Tokens)
+	}
+	if s.TokensPerByte() != 0 || s.Histogram() != nil || s.Top(1) != nil {
+		t.Error("empty Stats: want zero TokensPerByte, Histogram, and Top")
+	}
+}
+
+func TestAnalyzeEach(t *testing.T) {
+	var toks []gotoken.Tokenizer
+	for _, name := range []string{"r50k_base", "p50k_base"} {
+		tok, err := gotoken.GetTokenizer(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		toks = append(toks, tok)
+	}
+	got, err := AnalyzeEach(toks, strings.NewReader("x = 1\n        return x\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// p50k_base has tokens for runs of spaces, which r50k_base doesn't
+	if got[0].Name() != "r50k_base" || got[1].Name() != "p50k_base" || got[0].Tokens <= got[1].Tokens {
+		t.Errorf("AnalyzeEach() = %s with %d tokens, %s with %d; want more for r50k_base",
+			got[0].Name(), got[0].Tokens, got[1].Name(), got[1].Tokens)
+	}
+
+	_, err = AnalyzeEach(toks, strings.NewReader("ok\n<|endoftext|>\n"))
+	if err == nil || !strings.Contains(err.Error(), "line 2") {
+		t.Errorf("AnalyzeEach() with a special token: err = %v, want an error on line 2", err)
+	}
+}
@@ -0,0 +1,65 @@
+// Copyright 2023 Peter Hebert. Licensed under the MIT license.
+
+package gotoken
+
+import "unicode/utf8"
+
+// StreamDecoder decodes tokens that arrive a few at a time, like the chunks of
+// a streaming completion, into text that is safe to display as it arrives.
+// Tokens can end in the middle of a UTF-8 sequence, so decoding each chunk on
+// its own with [Tokenizer.Decode] can produce broken characters. StreamDecoder
+// holds back the start of an incomplete character until the tokens that
+// complete it arrive. It is created with [NewStreamDecoder].
+//
+// A StreamDecoder is not safe for concurrent use.
+type StreamDecoder struct {
+	tok     Tokenizer
+	pending []byte
+}
+
+// NewStreamDecoder returns a StreamDecoder that decodes tokens with tok.
+func NewStreamDecoder(tok Tokenizer) *StreamDecoder {
+	return &StreamDecoder{tok: tok}
+}
+
+// Decode decodes tokens and returns the text that is ready to display: the
+// text held back from earlier calls, followed by the text of tokens, without
+// an incomplete UTF-8 sequence at its end. Bytes that can never form a valid
+// character are not held back. If tokens can't be decoded, nothing is returned
+// and the held-back text is kept.
+func (sd *StreamDecoder) Decode(tokens []int) (string, error) {
+	buf, err := sd.tok.AppendDecode(sd.pending, tokens)
+	if err != nil {
+		return "", err
+	}
+	n := len(buf) - incompleteSuffix(buf)
+	ret := string(buf[:n])
+	sd.pending = append(buf[:0], buf[n:]...)
+	return ret, nil
+}
+
+// Flush returns the text that is held back, even though it is incomplete, and
+// resets sd for a new stream. Call it when a stream ends, so that no text is
+// lost.
+func (sd *StreamDecoder) Flush() string {
+	ret := string(sd.pending)
+	sd.pending = sd.pending[:0]
+	return ret
+}
+
+// incompleteSuffix returns the length of the incomplete UTF-8 sequence at the
+// end of b: the start of a valid multi-byte sequence that is missing its last
+// bytes, or 0 if there is none.
+func incompleteSuffix(b []byte) int {
+	for i := 1; i < utf8.UTFMax && i <= len(b); i++ {
+		c := b[len(b)-i]
+		if utf8.RuneStart(c) {
+			tail := b[len(b)-i:]
+			if c < utf8.RuneSelf || utf8.FullRune(tail) {
+				return 0
+			}
+			return i
+		}
+	}
+	return 0
+}
@@ -0,0 +1,29 @@
+// Copyright 2023 Peter Hebert. Licensed under the MIT license.
+
+package gotoken
+
+import "testing"
+
+func TestIncompleteSuffix(t *testing.T) {
+	tests := []struct {
+		input string
+		want  int
+	}{
+		{"", 0},
+		{"abc", 0},
+		{"é", 0},
+		{"a\xc3", 1},
+		{"\xe6\x97", 2},      // 日 without its last byte
+		{"x\xf0\x9f\x98", 3}, // 😄 without its last byte
+		{"\xf0\x9f\x98\x84", 0},
+		{"a\x84", 0},    // a continuation byte without a start
+		{"\xc3\x28", 0}, // an invalid sequence
+		{"\xff", 0},     // never valid
+		{"\xf0\x9f\x98\xc3", 1},
+	}
+	for _, tt := range tests {
+		if got := incompleteSuffix([]byte(tt.input)); got != tt.want {
+			t.Errorf("incompleteSuffix(%q) = %d, want %d", tt.input, got, tt.want)
+		}
+	}
+}
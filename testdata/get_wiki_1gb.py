@@ -5,7 +5,7 @@ import gzip
 # It's pretty large (1GB), so is not included in the git repo. This file is not
 # required by the regular unit tests, but can be used for benchmarking or
 # testing via manual code changes. See the commented out lines in the various
-# tokenizer_test.go files and gen_ground_truth.py to generate test files.
+# tokenizer_test.go files, and cmd/gotoken-truth to generate test files.
 #
 # The script that generated the contents of this file can be found here:
 #
@@ -23,17 +23,21 @@
 //	)
 //
 // The _ indicates that cl100kbase should be imported even without a direct
-// reference in your code. Encoding packages have no public functions or types,
-// but they do contain public constants defining special tokens.
+// reference in your code. Encoding packages have no public types, but they do
+// contain public constants defining special tokens, and a SpecialTokens
+// function that maps them to their token IDs.
 //
 // [tiktoken]: https://github.com/openai/tiktoken
 package gotoken
 
 import (
-	"errors"
+	"context"
 	"fmt"
+	"io"
+	"io/fs"
 	"sort"
 	"sync"
+	"time"
 )
 
 // Tokenizer is the primary public interface provided by gotoken. It is
@@ -41,41 +45,243 @@ import (
 // [github.com/peterheb/gotoken/r50kbase]. A Tokenizer is created using
 // [GetTokenizer].
 //
-// Tokenizer supports four methods:
+// Tokenizer supports the following methods:
 //
 //   - Count returns the number of tokens in an input string, or 0 on error.
 //   - Encode tokenizes an input string to an []int.
+//   - EncodeWithOffsets tokenizes an input string, and also returns the byte
+//     offset in the input where each token begins. [NewTokenMap] uses it to map
+//     byte offsets to token indexes and back.
+//   - EncodeOrdinary is like Encode, but encodes special tokens as text, and
+//     EncodeSpecial takes the special tokens to allow and disallow as
+//     arguments. They match encode_ordinary and encode in tiktoken.
+//   - EncodeSuffix extends an already-encoded prompt with more text, without
+//     re-encoding the whole prompt.
+//   - EncodeBatch tokenizes many input strings in parallel.
+//   - EncodeFunc tokenizes an input string, passing the tokens to a callback
+//     as they are produced instead of returning them.
+//   - EncodeReader tokenizes the text read from an [io.Reader] in chunks, for
+//     input too large to hold in memory at once.
+//   - EncodeCtx and CountCtx are like Encode and Count, but stop early if a
+//     context is cancelled.
+//   - CountReader counts the tokens in the text read from an [io.Reader] in
+//     chunks, like EncodeReader.
 //   - Decode un-tokenizes an []int back to its string representation.
+//   - DecodeToken decodes a single token, returning a string shared with the
+//     vocabulary instead of allocating one, for callers that decode every
+//     token separately.
+//   - DecodeEach returns the string for each token of an []int, like
+//     DecodeToken, in one call.
+//   - AppendDecode is like Decode, but appends to a []byte that can be reused
+//     between calls.
+//   - DecodeTo is like Decode, but writes the text to an [io.Writer] as it
+//     goes, for output too large to hold in memory at once.
+//   - Encode32 and Decode32 are like Encode and Decode, but use []uint32 for
+//     tokens, which halves the memory needed to store them.
 //   - Allowed returns an error if the input string contains any sequences
 //     corresponding to special tokens that are not allowed by this tokenizer.
+//   - TokensWithPrefix returns every token in the vocabulary whose byte
+//     representation starts with a given prefix.
+//   - PrefixTokens returns every token in the vocabulary that is a prefix of a
+//     given input, and LongestPrefixToken returns the longest one. These are
+//     building blocks for token healing and constrained decoding.
+//   - NewTrieWalker returns a [TrieWalker] that follows the vocabulary one
+//     byte at a time, for grammar-guided generation.
+//   - HealPrompt encodes a prompt and removes its final, possibly partial token,
+//     returning candidate tokens to continue it with.
+//   - Explain encodes an input string and reports how each part of it was split
+//     and merged into tokens, for debugging.
+//   - VocabIter iterates over every token in the vocabulary in rank order.
+//   - Name returns the name of the encoding, like "cl100k_base". For a
+//     tokenizer obtained through an alias, this is the canonical name.
+//   - MaxToken returns the highest token ID in the encoding, including special
+//     tokens, and IsValidToken reports whether a token ID is in the encoding.
+//     These can be used to validate tokens from untrusted sources.
+//   - IsSpecialToken reports whether a token ID is a special token, like
+//     "<|endoftext|>", for example to filter them out of model output.
+//   - SpecialTokenID returns the token ID of a special token given its text.
+//   - EOTToken returns the token ID of "<|endoftext|>", which is commonly
+//     appended to encoded text to separate documents.
+//   - ByteToken returns the token that encodes a single byte, and TokenByte is
+//     its inverse. This is useful for converting to other tokenizer formats.
 type Tokenizer interface {
 	Count(input string) int
+	CountCtx(ctx context.Context, input string) (int, error)
+	CountReader(r io.Reader) (int64, error)
 	Encode(input string) ([]int, error)
+	EncodeCtx(ctx context.Context, input string) ([]int, error)
+	EncodeOrdinary(input string) ([]int, error)
+	EncodeSpecial(input string, allowed, disallowed []string) ([]int, error)
+	EncodeWithOffsets(input string) ([]int, []int, error)
+	EncodeSuffix(tokens []int, suffix string) ([]int, error)
+	EncodeBatch(inputs []string, workers int) ([][]int, error)
+	EncodeFunc(input string, fn func([]int) error) error
+	EncodeReader(r io.Reader, emit func([]int) error) error
 	Decode(input []int) (string, error)
+	DecodeToken(token int) (string, error)
+	DecodeEach(input []int) ([]string, error)
+	AppendDecode(dst []byte, input []int) ([]byte, error)
+	DecodeTo(w io.Writer, input []int) error
+	Encode32(input string) ([]uint32, error)
+	Decode32(input []uint32) (string, error)
 	Allowed(input string) error
+	TokensWithPrefix(prefix string) []int
+	PrefixTokens(input string) []int
+	LongestPrefixToken(input string) (int, int)
+	NewTrieWalker() TrieWalker
+	HealPrompt(prompt string) (HealedPrompt, error)
+	Explain(input string) ([]ExplainedPiece, error)
+	VocabIter() func(yield func(int, []byte) bool)
+	Name() string
+	MaxToken() int
+	IsValidToken(token int) bool
+	IsSpecialToken(token int) bool
+	SpecialTokenID(name string) (int, bool)
+	EOTToken() (int, bool)
+	ByteToken(b byte) int
+	TokenByte(token int) (byte, bool)
+}
+
+// AllSpecial can be included in the allowed or disallowed special tokens passed
+// to [Tokenizer.EncodeSpecial] to stand for every special token of the
+// encoding, like "all" in tiktoken.
+const AllSpecial = "all"
+
+// Describer is an optional interface implemented by tokenizers that can
+// describe their encoding in more detail than [Tokenizer.Name]. All tokenizers
+// returned by [GetTokenizer] implement it, and it can be retrieved with a type
+// assertion:
+//
+//	if d, ok := tok.(gotoken.Describer); ok {
+//	    log.Printf("using %s (%d tokens)", d.Name(), d.VocabSize())
+//	}
+//
+// Describer supports the following methods:
+//
+//   - Name is the same as [Tokenizer.Name].
+//   - VocabSize returns one more than the highest token ID in the encoding,
+//     including special tokens. This matches tiktoken's n_vocab.
+//   - EOTToken is the same as [Tokenizer.EOTToken].
+//   - DataInfo returns where the encoding's data came from, so that a service
+//     can log exactly which vocabulary it runs. See [DataInfo].
+type Describer interface {
+	Name() string
+	VocabSize() int
+	EOTToken() (int, bool)
+	DataInfo() DataInfo
+}
+
+// DataInfo describes the source of an encoding's data, as recorded by
+// gotoken-gen when it generated the encoding package. It is returned by the
+// DataInfo function of each encoding package, and by [Describer]. It is the
+// zero DataInfo if the source is unknown, such as for data loaded with
+// [WithDataFile].
+type DataInfo struct {
+	Source    string    // the URL of the .tiktoken file, or its file name if it was local
+	SHA256    string    // the SHA-256 of the .tiktoken file, in hex
+	Generated time.Time // when gotoken-gen generated the data
+}
+
+// HealedPrompt is the result of [Tokenizer.HealPrompt].
+//
+// A prompt that ends in the middle of a word, URL, or other sequence often ends
+// with a token that the model would not have chosen, like "http" instead of
+// "https". Token healing removes the final token from the prompt and restricts
+// the model's next token to those that begin with the removed text, so the
+// model can pick the natural token boundary itself.
+type HealedPrompt struct {
+	Tokens     []int  // the encoded prompt, without its final token
+	Removed    string // the text of the removed token
+	Candidates []int  // every token beginning with Removed, in ascending order
+}
+
+// TrieWalker walks the vocabulary of a tokenizer one byte at a time, as a
+// trie. It is returned by [Tokenizer.NewTrieWalker], and is for constrained
+// decoding: a grammar-guided generator can advance a walker by each byte that
+// its grammar allows, to find which tokens keep the output valid without
+// testing every token in the vocabulary. Special tokens are not included.
+//
+// A TrieWalker is not safe for concurrent use, but each walker is independent,
+// so goroutines can walk the same vocabulary with walkers of their own.
+//
+// TrieWalker supports the following methods:
+//
+//   - Advance appends a byte to the walker's prefix, and reports whether any
+//     token begins with the new prefix. If none does, the walker is unchanged.
+//   - Token returns the token whose bytes are exactly the prefix, if any.
+//   - Candidates returns every token that begins with the prefix, in
+//     ascending order, like [Tokenizer.TokensWithPrefix]. Before the first
+//     Advance, this is the entire vocabulary.
+//   - Len returns the number of bytes in the prefix.
+//   - Reset empties the prefix, returning the walker to the root of the trie.
+type TrieWalker interface {
+	Advance(b byte) bool
+	Token() (int, bool)
+	Candidates() []int
+	Len() int
+	Reset()
+}
+
+// ExplainedPiece describes how one piece of the input to [Tokenizer.Explain] was
+// encoded. Before byte-pair encoding, input is split into pieces (pre-tokens)
+// like words, numbers, or runs of punctuation, and each piece is encoded
+// separately.
+//
+// Each piece starts as one token per byte, listed in ByteTokens. The merges in
+// Merges are then applied in order, each combining two adjacent tokens, until
+// Tokens remain. If a piece is found in the vocabulary as a whole, it is
+// encoded directly, and Merges is empty. Special tokens have no ByteTokens or
+// Merges.
+type ExplainedPiece struct {
+	Text       string     // the text of this piece
+	Special    bool       // true if this piece was encoded as a special token
+	ByteTokens []int      // the token for each byte of Text
+	Merges     []BPEMerge // the merges applied, in order
+	Tokens     []int      // the resulting tokens
+}
+
+// BPEMerge describes a single byte-pair merge in an [ExplainedPiece]. The
+// tokens Left and Right were adjacent and were combined into Result.
+type BPEMerge struct {
+	Offset int // byte offset of Left within the piece's Text
+	Left   int // the left token of the pair
+	Right  int // the right token of the pair
+	Result int // the merged token
 }
 
 // Option is a functional option for a tokenizer, such as [WithSpecialTokens] or
 // [WithSpecialTokensAsText].
-type Option func(*tokenizerOptions)
+type Option func(*TokenizerOptions)
 
-// tokenizerOptions collects data from our functional options.
-type tokenizerOptions struct {
+// TokenizerOptions collects data from our functional options. It is passed to
+// the factory function of an encoding registered with [RegisterTokenizer].
+type TokenizerOptions struct {
 	AllowSpecialAsText   bool
 	AllowedSpecialTokens []string
-}
+	PartialResults       bool
+	MaxTokens            int
+	MaxInputBytes        int
+	MaxDecodeBytes       int
+	MaxPieceBytes        int
+	StrictUTF8           bool
+	TimingCallback       func(EncodeTiming)
+	MetricsHook          func(encoding, op string, tokens int, dur time.Duration)
+	TraceHook            func(context.Context, TraceSpan)
 
-// These errors can be returned by functions in this library. Errors will be
-// wrapped with fmt.Errorf; use [errors.Is] or [errors.As] to check for the
-// underlying error type.
-var (
-	ErrUnknownEncoding = errors.New("unknown tokenizer encoding")
-	ErrInvalidToken    = errors.New("invalid token")
-	ErrSpecialToken    = errors.New("unexpected special token found")
-)
+	// Where to load the encoding's data from, set by [WithDataFile] and
+	// [WithDataFS]. If DataPath is empty, the embedded data is used.
+	DataFS   fs.FS // nil means the OS file system
+	DataPath string
+
+	// Tuning knobs, set by [WithPreset]. Zero values select the defaults.
+	BytesPerToken     int // expected input bytes per token, for pre-sizing output
+	ParallelThreshold int // input size in bytes to encode in parallel; <0 disables
+	CacheSize         int // number of pieces to cache the encoding of; <0 disables
+}
 
 var (
-	registered = make(map[string]func(bool, []string) (Tokenizer, error))
+	registered = make(map[string]func(TokenizerOptions) (Tokenizer, error))
+	aliases    = make(map[string]string) // alias -> canonical name
 	regMu      sync.RWMutex
 )
 
@@ -96,27 +302,37 @@ var (
 //
 //   - "cl100k_base" in [github.com/peterheb/gotoken/cl100kbase]
 //   - "p50k_base" and "p50k_edit" in [github.com/peterheb/gotoken/p50kbase]
-//   - "r50k_base" in [github.com/peterheb/gotoken/r50kbase]
+//   - "r50k_base" in [github.com/peterheb/gotoken/r50kbase], also known by the
+//     alias "gpt2"
+//
+// An alias registered with [RegisterAlias] can be used in place of the name it
+// refers to.
 func GetTokenizer(encodingName string, opts ...Option) (Tokenizer, error) {
 	regMu.RLock()
 	defer regMu.RUnlock()
 
 	// If options are provided, apply them.
-	options := tokenizerOptions{}
+	options := TokenizerOptions{}
 	for _, opt := range opts {
 		opt(&options)
 	}
 
 	// Return a new tokenizer instance
 	if tokenFactory, ok := registered[encodingName]; ok {
-		return tokenFactory(options.AllowSpecialAsText, options.AllowedSpecialTokens)
+		return tokenFactory(options)
+	}
+	if canonical, ok := aliases[encodingName]; ok {
+		if tokenFactory, ok := registered[canonical]; ok {
+			return tokenFactory(options)
+		}
 	}
 
 	return nil, fmt.Errorf("%w: %s", ErrUnknownEncoding, encodingName)
 }
 
 // ListTokenizers returns a list of all registered tokenizer encodings. These
-// are the valid inputs to [GetTokenizer].
+// are the valid inputs to [GetTokenizer], not counting aliases; see
+// [ListAliases].
 func ListTokenizers() []string {
 	regMu.RLock()
 	defer regMu.RUnlock()
@@ -128,31 +344,346 @@ func ListTokenizers() []string {
 	return encodings
 }
 
+// ListAliases returns a map of all registered aliases to the encoding names they
+// refer to, like "gpt2" to "r50k_base". Aliases are also valid inputs to
+// [GetTokenizer].
+func ListAliases() map[string]string {
+	regMu.RLock()
+	defer regMu.RUnlock()
+	ret := make(map[string]string, len(aliases))
+	for alias, canonical := range aliases {
+		ret[alias] = canonical
+	}
+	return ret
+}
+
+// RegisterAlias registers alias as another name for the canonical encoding
+// name, so that [GetTokenizer](alias) returns the same tokenizer as
+// [GetTokenizer](canonical). Like [RegisterTokenizer], this is typically called
+// by the init function of an encoding package. Names registered with
+// RegisterTokenizer take precedence over aliases.
+func RegisterAlias(alias, canonical string) {
+	regMu.Lock()
+	defer regMu.Unlock()
+	aliases[alias] = canonical
+}
+
 // RegisterTokenizer registers a tokenizer with the given name. This is
-// typically called by the init function of a specific tokenizer's package.
-func RegisterTokenizer(name string, tokFactory func(bool, []string) (Tokenizer, error)) {
+// typically called by the init function of a specific tokenizer's package. The
+// factory function receives the options passed to [GetTokenizer]. If name is
+// already registered, its factory is replaced; use [TryRegisterTokenizer] to
+// get an error instead.
+func RegisterTokenizer(name string, tokFactory func(TokenizerOptions) (Tokenizer, error)) {
+	regMu.Lock()
+	defer regMu.Unlock()
+	registered[name] = tokFactory
+}
+
+// TryRegisterTokenizer is like [RegisterTokenizer], but if name is already
+// registered, it leaves the existing registration in place and returns an error
+// that wraps [ErrRegistered].
+func TryRegisterTokenizer(name string, tokFactory func(TokenizerOptions) (Tokenizer, error)) error {
 	regMu.Lock()
 	defer regMu.Unlock()
+	if _, ok := registered[name]; ok {
+		return fmt.Errorf("%w: %s", ErrRegistered, name)
+	}
 	registered[name] = tokFactory
+	return nil
+}
+
+// UnregisterTokenizer removes the tokenizer registered with the given name, and
+// reports whether there was one. Tokenizers already returned by [GetTokenizer]
+// keep working. Aliases that refer to name are kept, so registering name again
+// makes them valid again.
+func UnregisterTokenizer(name string) bool {
+	regMu.Lock()
+	defer regMu.Unlock()
+	_, ok := registered[name]
+	delete(registered, name)
+	return ok
 }
 
 // WithSpecialTokensAsText is a functional option for [GetTokenizer] that
 // configures the tokenizer to treat special tokens as text. This allows strings
 // like "<|endoftext|>" to be encoded as text tokens, rather than causing an
 // encoding error (which is the default behavior).
-func WithSpecialTokensAsText() func(*tokenizerOptions) {
-	return func(opts *tokenizerOptions) {
+func WithSpecialTokensAsText() Option {
+	return func(opts *TokenizerOptions) {
 		opts.AllowSpecialAsText = true
 	}
 }
 
 // WithSpecialTokens is a functional option for [GetTokenizer] that configures
 // the tokenizer to encode special tokens to their special token values. This
-// should only be used when a Tokenizer is encoding trusted input.
-func WithSpecialTokens(tokens ...string) func(*tokenizerOptions) {
-	return func(opts *tokenizerOptions) {
+// should only be used when a Tokenizer is encoding trusted input. [AllSpecial]
+// allows every special token of the encoding.
+func WithSpecialTokens(tokens ...string) Option {
+	return func(opts *TokenizerOptions) {
 		for _, tok := range tokens {
 			opts.AllowedSpecialTokens = append(opts.AllowedSpecialTokens, tok)
 		}
 	}
 }
+
+// Preset identifies a type of workload for [WithPreset].
+type Preset int
+
+// These presets are supported by [WithPreset].
+const (
+	PresetDefault  Preset = iota // general-purpose settings
+	PresetChat                   // natural language, like chat messages or documents
+	PresetCode                   // source code
+	PresetLogLines               // log files, dense with numbers and punctuation
+)
+
+// WithPreset is a functional option for [GetTokenizer] that tunes a Tokenizer's
+// internal settings for a type of workload: the expected bytes per token, the
+// size of the piece cache, the input size for parallel encoding, and the
+// length above which a piece is sliced. Presets are meant to affect only
+// performance, but each sets a piece-length limit below the default, so a
+// piece longer than that limit is sliced as described for [WithMaxPieceBytes].
+// Options that follow WithPreset, like [WithCacheSize], override the preset's
+// settings.
+//
+// Words in natural language are short, so the chat preset slices pieces over
+// 16 KiB, and since its text has the fewest tokens per byte, it encodes in
+// parallel only from 2 MiB. Source code has longer runs, like minified code,
+// and gets 32 KiB. Log lines are dense with numbers and punctuation, which
+// makes more tokens per byte, so they are encoded in parallel from 256 KiB,
+// and their long runs, like hex dumps, are sliced from 8 KiB.
+func WithPreset(preset Preset) Option {
+	return func(opts *TokenizerOptions) {
+		switch preset {
+		case PresetChat:
+			opts.BytesPerToken = 4
+			opts.CacheSize = 16384
+			opts.ParallelThreshold = 2 << 20
+			opts.MaxPieceBytes = 16 << 10
+		case PresetCode:
+			opts.BytesPerToken = 3
+			opts.CacheSize = 32768
+			opts.ParallelThreshold = 1 << 20
+			opts.MaxPieceBytes = 32 << 10
+		case PresetLogLines:
+			opts.BytesPerToken = 2
+			opts.CacheSize = 4096
+			opts.ParallelThreshold = 256 << 10
+			opts.MaxPieceBytes = 8 << 10
+		default:
+			opts.BytesPerToken = 0
+			opts.CacheSize = 0
+			opts.ParallelThreshold = 0
+			opts.MaxPieceBytes = 0
+		}
+	}
+}
+
+// WithParallelThreshold is a functional option for [GetTokenizer] that sets the
+// input size, in bytes, at which Encode splits its input into chunks and
+// encodes them on multiple goroutines. The result is identical to encoding the
+// input sequentially. The default is 1 MiB; a value of n < 0 disables parallel
+// encoding. Inputs are never encoded in parallel when [WithMaxTokens] or
+// [WithTimingCallback] is used, or by EncodeCtx.
+func WithParallelThreshold(n int) Option {
+	return func(opts *TokenizerOptions) {
+		opts.ParallelThreshold = n
+	}
+}
+
+// WithMaxPieceBytes is a functional option for [GetTokenizer] that sets the
+// length, in bytes, above which a piece (pre-token) is cut into slices of at
+// most n bytes that are encoded separately. A piece is a run of similar
+// characters, like a word, so only unusual input, like megabytes of
+// punctuation with no spaces, makes a long one. Merging the bytes of a piece
+// takes time and memory in proportion to its length, so this bounds the cost
+// of encoding such input.
+//
+// The slices are cut at the start of a character where possible, and decode
+// back to the original text, but their tokens can differ from those of
+// tiktoken at the cuts. The default is 64 KiB; a value of n < 0 disables
+// slicing.
+func WithMaxPieceBytes(n int) Option {
+	return func(opts *TokenizerOptions) {
+		opts.MaxPieceBytes = n
+	}
+}
+
+// WithCacheSize is a functional option for [GetTokenizer] that sets the number of
+// pieces (pre-tokens, like " the" or "ing") whose encodings are cached by a
+// Tokenizer. Common words recur constantly in natural text, and the cache saves
+// recomputing their byte-pair merges each time. The cache is shared by all
+// goroutines using the Tokenizer. The default size is 8192; a value of n < 0
+// disables the cache.
+func WithCacheSize(n int) Option {
+	return func(opts *TokenizerOptions) {
+		opts.CacheSize = n
+	}
+}
+
+// WithMaxTokens is a functional option for [GetTokenizer] that limits the
+// number of tokens Encode will produce. Once the output of Encode would exceed
+// n tokens, encoding stops and a [*TooManyTokensError] is returned. A value of
+// n <= 0 means no limit, which is the default.
+func WithMaxTokens(n int) Option {
+	return func(opts *TokenizerOptions) {
+		opts.MaxTokens = n
+	}
+}
+
+// WithMaxInputBytes is a functional option for [GetTokenizer] that limits the
+// size of the inputs a tokenizer will process. Encode, Count, Allowed, and the
+// methods built on them reject an input longer than n bytes with an
+// [*InputTooLargeError] before doing any work; Count returns 0. This protects
+// services that tokenize untrusted input from spending CPU on huge requests. A
+// value of n <= 0 means no limit, which is the default.
+func WithMaxInputBytes(n int) Option {
+	return func(opts *TokenizerOptions) {
+		opts.MaxInputBytes = n
+	}
+}
+
+// WithMaxDecodeBytes is a functional option for [GetTokenizer] that limits the
+// size of the output of Decode, Decode32, AppendDecode, and DecodeTo. Once the
+// decoded text would exceed n bytes, decoding stops and a
+// [*DecodeTooLargeError] is returned, so that a server decoding untrusted
+// tokens cannot be made to allocate without bound. For AppendDecode, only the
+// appended bytes count towards the limit. A value of n <= 0 means no limit,
+// which is the default.
+func WithMaxDecodeBytes(n int) Option {
+	return func(opts *TokenizerOptions) {
+		opts.MaxDecodeBytes = n
+	}
+}
+
+// WithStrictUTF8 is a functional option for [GetTokenizer] that makes Decode,
+// Decode32, and DecodeTo return an [*InvalidUTF8Error] if the decoded text is
+// not valid UTF-8, instead of a string containing the invalid bytes. This can
+// happen when the tokens end in the middle of a character, or were not produced
+// by Encode. A service that passes the text on to a JSON encoder, which would
+// replace the invalid bytes, can use this to reject such input. AppendDecode
+// returns bytes, which are not checked, so that [StreamDecoder] can put
+// characters back together from tokens that arrive separately.
+func WithStrictUTF8() Option {
+	return func(opts *TokenizerOptions) {
+		opts.StrictUTF8 = true
+	}
+}
+
+// WithTimingCallback is a functional option for [GetTokenizer] that reports a
+// breakdown of the time spent in each phase of encoding. After every call to
+// Encode (including indirect calls, like Count), fn is called with the timing
+// for that call. This is intended for investigating latency in production
+// without attaching a profiler. Measuring time has some overhead, so this
+// option should only be enabled when needed.
+func WithTimingCallback(fn func(EncodeTiming)) Option {
+	return func(opts *TokenizerOptions) {
+		opts.TimingCallback = fn
+	}
+}
+
+// WithMetricsHook is a functional option for [GetTokenizer] that calls fn after
+// each call to a tokenizer's Encode, Decode, or Count methods, so that a service
+// can feed token throughput to a metrics system like Prometheus or StatsD
+// without wrapping every call site. The arguments are the name of the encoding,
+// the operation, the number of tokens, and the duration of the call.
+//
+// The operation is "encode" for Encode, Encode32, EncodeCtx, EncodeFunc, and
+// EncodeReader, "count" for Count, CountCtx, and CountReader, and "decode" for
+// Decode, DecodeToken, DecodeEach, Decode32, AppendDecode, and DecodeTo.
+// Methods built on them, like EncodeBatch or EncodeWithOffsets, report each
+// call they make. The number of tokens is those returned, counted, or decoded,
+// and is 0 if the call failed, unless partial results were returned.
+//
+// fn is called on the goroutine that made the call, and may be called
+// concurrently; it should be fast, like incrementing counters.
+func WithMetricsHook(fn func(encoding, op string, tokens int, dur time.Duration)) Option {
+	return func(opts *TokenizerOptions) {
+		opts.MetricsHook = fn
+	}
+}
+
+// WithTraceHook is a functional option for [GetTokenizer] that calls fn with a
+// [TraceSpan] after each call that [WithMetricsHook] would report, so that
+// tokenization can be recorded in a tracing system like OpenTelemetry. The
+// context is the one passed to EncodeCtx or CountCtx, which carries the parent
+// span, or context.Background() for methods without one. With OpenTelemetry,
+// the span can be recorded after the fact using its start and end times:
+//
+//	gotoken.WithTraceHook(func(ctx context.Context, s gotoken.TraceSpan) {
+//	    _, span := tracer.Start(ctx, "gotoken."+s.Op, trace.WithTimestamp(s.Start))
+//	    span.SetAttributes(attribute.Int("gotoken.bytes", s.Bytes), ...)
+//	    if s.Err != nil {
+//	        span.RecordError(s.Err)
+//	    }
+//	    span.End(trace.WithTimestamp(s.End))
+//	})
+//
+// Like the metrics hook, fn is called on the goroutine that made the call, and
+// may be called concurrently.
+func WithTraceHook(fn func(ctx context.Context, span TraceSpan)) Option {
+	return func(opts *TokenizerOptions) {
+		opts.TraceHook = fn
+	}
+}
+
+// TraceSpan describes one call to a tokenizer method, as reported by
+// [WithTraceHook].
+type TraceSpan struct {
+	Encoding string    // name of the encoding
+	Op       string    // "encode", "count", or "decode", as for WithMetricsHook
+	Start    time.Time // when the call started
+	End      time.Time // when the call returned
+	Bytes    int       // length of the text encoded, or decoded if successful
+	Tokens   int       // number of tokens returned, counted, or decoded
+	Err      error     // the error returned by the call, if any
+}
+
+// EncodeTiming is a breakdown of the time spent in one call to Encode, as
+// reported by [WithTimingCallback]. Total includes time not attributed to any
+// of the phases.
+type EncodeTiming struct {
+	Special time.Duration // scanning the input for special tokens
+	Split   time.Duration // splitting the input into pieces (pre-tokenization)
+	Lookup  time.Duration // encoding pieces found directly in the vocabulary
+	Merge   time.Duration // encoding the remaining pieces with byte-pair merges
+	Total   time.Duration // the whole call to Encode
+	Bytes   int           // length of the input in bytes
+	Tokens  int           // number of tokens returned
+}
+
+// WithDataFile is a functional option for [GetTokenizer] that loads the
+// encoding's vocabulary from the file at path, instead of using the data
+// embedded in the encoding package. Data files are written by the generator,
+// cmd/gotoken-gen, with its -datadir flag. A data file is only read once per
+// path, no matter how many tokenizers use it.
+//
+// Building with the gotoken_nodata tag leaves the embedded data out of the
+// encoding packages, so that many small binaries can share one copy of the data
+// on disk. In that case, this option or [WithDataFS] is required.
+func WithDataFile(path string) Option {
+	return func(opts *TokenizerOptions) {
+		opts.DataFS = nil
+		opts.DataPath = path
+	}
+}
+
+// WithDataFS is like [WithDataFile], but loads the data file from fsys. Unlike
+// WithDataFile, the file is read every time a tokenizer is created.
+func WithDataFS(fsys fs.FS, path string) Option {
+	return func(opts *TokenizerOptions) {
+		opts.DataFS = fsys
+		opts.DataPath = path
+	}
+}
+
+// WithPartialResults is a functional option for [GetTokenizer] that configures
+// Encode to return the tokens it produced before an error, instead of nil. In
+// this mode, the error returned by Encode is a [*PartialEncodeError] recording
+// the byte offset where encoding stopped. This lets batch pipelines salvage and
+// log partial progress rather than discarding the whole document.
+func WithPartialResults() Option {
+	return func(opts *TokenizerOptions) {
+		opts.PartialResults = true
+	}
+}
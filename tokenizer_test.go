@@ -3,15 +3,19 @@
 package gotoken
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"testing"
+	"unicode/utf8"
 )
 
 func TestMain(m *testing.M) {
 	// For these tests, set up a mock tokenizer named "runes"
-	RegisterTokenizer("runes", func(allowSAT bool, allowedSpc []string) (Tokenizer, error) {
+	RegisterTokenizer("runes", func(opts TokenizerOptions) (Tokenizer, error) {
 		return &runeTokenizer{
-			allowSpecialAsText:   allowSAT,
-			allowedSpecialTokens: allowedSpc,
+			allowSpecialAsText:   opts.AllowSpecialAsText,
+			allowedSpecialTokens: opts.AllowedSpecialTokens,
 		}, nil
 	})
 
@@ -57,6 +61,56 @@ func TestGetTokenizer(t *testing.T) {
 	}
 }
 
+func TestWithPreset(t *testing.T) {
+	tests := []struct {
+		preset        Preset
+		want          int
+		wantCache     int
+		wantParallel  int
+		wantMaxPieces int
+	}{
+		{PresetDefault, 0, 0, 0, 0},
+		{PresetChat, 4, 16384, 2 << 20, 16 << 10},
+		{PresetCode, 3, 32768, 1 << 20, 32 << 10},
+		{PresetLogLines, 2, 4096, 256 << 10, 8 << 10},
+	}
+	for _, tt := range tests {
+		opts := TokenizerOptions{}
+		WithPreset(tt.preset)(&opts)
+		if opts.BytesPerToken != tt.want {
+			t.Errorf("WithPreset(%d): BytesPerToken = %d, want %d", tt.preset, opts.BytesPerToken, tt.want)
+		}
+		if opts.CacheSize != tt.wantCache {
+			t.Errorf("WithPreset(%d): CacheSize = %d, want %d", tt.preset, opts.CacheSize, tt.wantCache)
+		}
+		if opts.ParallelThreshold != tt.wantParallel {
+			t.Errorf("WithPreset(%d): ParallelThreshold = %d, want %d", tt.preset, opts.ParallelThreshold, tt.wantParallel)
+		}
+		if opts.MaxPieceBytes != tt.wantMaxPieces {
+			t.Errorf("WithPreset(%d): MaxPieceBytes = %d, want %d", tt.preset, opts.MaxPieceBytes, tt.wantMaxPieces)
+		}
+	}
+
+	// Options after the preset override it
+	opts := TokenizerOptions{}
+	for _, opt := range []Option{WithPreset(PresetLogLines), WithParallelThreshold(-1), WithMaxPieceBytes(-1)} {
+		opt(&opts)
+	}
+	if opts.ParallelThreshold != -1 || opts.MaxPieceBytes != -1 {
+		t.Errorf("WithPreset then overrides: ParallelThreshold = %d, MaxPieceBytes = %d, want -1, -1", opts.ParallelThreshold, opts.MaxPieceBytes)
+	}
+}
+
+func TestPartialEncodeError(t *testing.T) {
+	err := &PartialEncodeError{Offset: 12, Err: fmt.Errorf("%w: %q", ErrSpecialToken, "<|foo|>")}
+	if !errors.Is(err, ErrSpecialToken) {
+		t.Fatalf("PartialEncodeError does not unwrap to ErrSpecialToken")
+	}
+	if got, want := err.Error(), `encoding stopped at byte 12: unexpected special token found: "<|foo|>"`; got != want {
+		t.Fatalf("PartialEncodeError.Error() = %q, want %q", got, want)
+	}
+}
+
 func TestListTokenizers(t *testing.T) {
 	// In theory, we can't import our real tokenizers here, because it creates
 	// an import cycle. In practice, "cl100k_base" is actually registered when
@@ -71,9 +125,63 @@ func TestListTokenizers(t *testing.T) {
 	t.Fatal("ListTokenizers() did not include 'runes'")
 }
 
+func TestRegisterAlias(t *testing.T) {
+	RegisterAlias("runes_alias", "runes")
+	RegisterAlias("dangling_alias", "not_registered")
+
+	tok, err := GetTokenizer("runes_alias")
+	if err != nil {
+		t.Fatalf("GetTokenizer('runes_alias'): %v", err)
+	}
+	if _, ok := tok.(*runeTokenizer); !ok {
+		t.Fatalf("GetTokenizer('runes_alias'): expected *runeTokenizer, got %T", tok)
+	}
+	if _, err := GetTokenizer("dangling_alias"); !errors.Is(err, ErrUnknownEncoding) {
+		t.Fatalf("GetTokenizer('dangling_alias'): expected ErrUnknownEncoding, got %v", err)
+	}
+
+	if got := ListAliases()["runes_alias"]; got != "runes" {
+		t.Errorf("ListAliases()['runes_alias'] = %q, want 'runes'", got)
+	}
+	for _, name := range ListTokenizers() {
+		if name == "runes_alias" {
+			t.Errorf("ListTokenizers() included alias 'runes_alias'")
+		}
+	}
+}
+
+func TestUnregisterTokenizer(t *testing.T) {
+	factory := func(opts TokenizerOptions) (Tokenizer, error) {
+		return &runeTokenizer{}, nil
+	}
+	if err := TryRegisterTokenizer("runes_tmp", factory); err != nil {
+		t.Fatalf("TryRegisterTokenizer('runes_tmp'): %v", err)
+	}
+	if err := TryRegisterTokenizer("runes_tmp", factory); !errors.Is(err, ErrRegistered) {
+		t.Fatalf("TryRegisterTokenizer('runes_tmp') again: expected ErrRegistered, got %v", err)
+	}
+	RegisterTokenizer("runes_tmp", factory) // replaces without error
+
+	if !UnregisterTokenizer("runes_tmp") {
+		t.Errorf("UnregisterTokenizer('runes_tmp') = false, want true")
+	}
+	if UnregisterTokenizer("runes_tmp") {
+		t.Errorf("UnregisterTokenizer('runes_tmp') again = true, want false")
+	}
+	if _, err := GetTokenizer("runes_tmp"); !errors.Is(err, ErrUnknownEncoding) {
+		t.Fatalf("GetTokenizer('runes_tmp'): expected ErrUnknownEncoding, got %v", err)
+	}
+	if err := TryRegisterTokenizer("runes_tmp", factory); err != nil {
+		t.Fatalf("TryRegisterTokenizer('runes_tmp') after unregistering: %v", err)
+	}
+	UnregisterTokenizer("runes_tmp")
+}
+
 // runeTokenizer is a mock tokenizer that just returns runes as tokens. It
-// ignores all tokenizer options.
+// ignores all tokenizer options. Methods of Tokenizer not needed by these tests
+// are satisfied by the embedded (nil) interface.
 type runeTokenizer struct {
+	Tokenizer
 	allowSpecialAsText   bool
 	allowedSpecialTokens []string
 }
@@ -86,6 +194,23 @@ func (at *runeTokenizer) Encode(s string) ([]int, error) {
 	return tokens, nil
 }
 
+func (at *runeTokenizer) EncodeWithOffsets(s string) ([]int, []int, error) {
+	var tokens, offsets []int
+	for i, c := range s {
+		tokens = append(tokens, int(c))
+		offsets = append(offsets, i)
+	}
+	return tokens, offsets, nil
+}
+
+func (at *runeTokenizer) Name() string {
+	return "runes"
+}
+
+func (at *runeTokenizer) SpecialTokenID(name string) (int, bool) {
+	return 0, false
+}
+
 func (at *runeTokenizer) Decode(tokens []int) (string, error) {
 	runes := make([]rune, 0, len(tokens))
 	for _, t := range tokens {
@@ -94,6 +219,10 @@ func (at *runeTokenizer) Decode(tokens []int) (string, error) {
 	return string(runes), nil
 }
 
+func (at *runeTokenizer) IsValidToken(token int) bool {
+	return token <= utf8.MaxRune && utf8.ValidRune(rune(token))
+}
+
 func (at *runeTokenizer) Count(s string) int {
 	return len([]rune(s))
 }
@@ -101,3 +230,10 @@ func (at *runeTokenizer) Count(s string) int {
 func (at *runeTokenizer) Allowed(s string) error {
 	return nil
 }
+
+func (at *runeTokenizer) CountCtx(ctx context.Context, s string) (int, error) {
+	if !utf8.ValidString(s) {
+		return 0, errors.New("invalid UTF-8")
+	}
+	return at.Count(s), nil
+}
@@ -0,0 +1,117 @@
+// Copyright 2023 Peter Hebert. Licensed under the MIT license.
+
+package gotoken
+
+import "sort"
+
+// TokenMap maps between byte offsets in an input and the indexes of the
+// tokens it encodes to, for example to find the token under a cursor in an
+// editor. It also maps lines and columns of the input to tokens, for showing
+// the tokens of each line or section of a document. It is created with
+// [NewTokenMap].
+//
+// Token i covers input[Offsets[i]:Offsets[i+1]], with the last token extending
+// to the end of the input. Because tokens may contain partial UTF-8 sequences,
+// a token can start or end in the middle of a rune.
+//
+// Lines and columns are numbered from 0, and columns are byte offsets within a
+// line. Lines end after each '\n', which belongs to the line it ends. A token
+// can span several lines, like a run of blank lines, so the token ranges of
+// adjacent lines can overlap.
+type TokenMap struct {
+	Tokens     []int // the tokens of the input
+	Offsets    []int // the byte offset in the input where each token begins
+	length     int
+	lineStarts []int // the byte offset where each line begins
+}
+
+// NewTokenMap encodes input with tok, using [Tokenizer.EncodeWithOffsets],
+// and returns a TokenMap for it.
+func NewTokenMap(tok Tokenizer, input string) (*TokenMap, error) {
+	tokens, offsets, err := tok.EncodeWithOffsets(input)
+	if err != nil {
+		return nil, err
+	}
+	lineStarts := []int{0}
+	for i := 0; i < len(input); i++ {
+		if input[i] == '\n' {
+			lineStarts = append(lineStarts, i+1)
+		}
+	}
+	return &TokenMap{Tokens: tokens, Offsets: offsets, length: len(input), lineStarts: lineStarts}, nil
+}
+
+// TokenAt returns the index of the token that contains the byte at offset, or
+// -1 if offset is not in the input. An offset in the middle of a rune that is
+// split between tokens returns the token that contains that byte.
+func (m *TokenMap) TokenAt(offset int) int {
+	if offset < 0 || offset >= m.length {
+		return -1
+	}
+	// The first token starting after offset follows the one that contains it
+	return sort.SearchInts(m.Offsets, offset+1) - 1
+}
+
+// Span returns the byte range [start, end) of the input covered by the token
+// at index. It panics if index is out of range, like a slice index.
+func (m *TokenMap) Span(index int) (start, end int) {
+	start, end = m.Offsets[index], m.length
+	if index+1 < len(m.Offsets) {
+		end = m.Offsets[index+1]
+	}
+	return start, end
+}
+
+// Lines returns the number of lines in the input, which is one more than the
+// number of line breaks. An empty last line is counted.
+func (m *TokenMap) Lines() int {
+	return len(m.lineStarts)
+}
+
+// Position returns the line and column of the byte at offset. An offset past
+// the end of the input returns a position past the end of the last line.
+func (m *TokenMap) Position(offset int) (line, column int) {
+	line = sort.SearchInts(m.lineStarts, offset+1) - 1
+	if line < 0 {
+		line = 0
+	}
+	return line, offset - m.lineStarts[line]
+}
+
+// TokenAtPosition returns the index of the token that contains the byte at
+// line and column, or -1 if there is no such byte. A column past the end of a
+// line is not in the input, even if a later line follows.
+func (m *TokenMap) TokenAtPosition(line, column int) int {
+	if line < 0 || line >= len(m.lineStarts) || column < 0 {
+		return -1
+	}
+	start, end := m.lineSpan(line)
+	if column >= end-start {
+		return -1
+	}
+	return m.TokenAt(start + column)
+}
+
+// LineTokens returns the range [first, end) of the indexes of the tokens that
+// contain at least one byte of the given line. For an empty line, or a line
+// out of range, first == end.
+func (m *TokenMap) LineTokens(line int) (first, end int) {
+	if line < 0 || line >= len(m.lineStarts) {
+		return 0, 0
+	}
+	start, stop := m.lineSpan(line)
+	if start == stop {
+		first = sort.SearchInts(m.Offsets, start)
+		return first, first
+	}
+	return m.TokenAt(start), m.TokenAt(stop-1) + 1
+}
+
+// lineSpan returns the byte range of line, including its '\n'.
+func (m *TokenMap) lineSpan(line int) (start, end int) {
+	start, end = m.lineStarts[line], m.length
+	if line+1 < len(m.lineStarts) {
+		end = m.lineStarts[line+1]
+	}
+	return start, end
+}
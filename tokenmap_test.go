@@ -0,0 +1,90 @@
+// Copyright 2023 Peter Hebert. Licensed under the MIT license.
+
+package gotoken
+
+import "testing"
+
+func TestTokenMap(t *testing.T) {
+	tok, err := GetTokenizer("runes")
+	if err != nil {
+		t.Fatalf("GetTokenizer('runes'): %v", err)
+	}
+
+	// The runes tokenizer has one token per rune, so "héllo" has a two-byte
+	// token at index 1
+	m, err := NewTokenMap(tok, "héllo")
+	if err != nil {
+		t.Fatalf("NewTokenMap: %v", err)
+	}
+	for offset, want := range []int{0, 1, 1, 2, 3, 4} {
+		if got := m.TokenAt(offset); got != want {
+			t.Errorf("TokenAt(%d) = %d, want %d", offset, got, want)
+		}
+	}
+	for _, offset := range []int{-1, 6, 100} {
+		if got := m.TokenAt(offset); got != -1 {
+			t.Errorf("TokenAt(%d) = %d, want -1", offset, got)
+		}
+	}
+	for i, want := range [][2]int{{0, 1}, {1, 3}, {3, 4}, {4, 5}, {5, 6}} {
+		if start, end := m.Span(i); start != want[0] || end != want[1] {
+			t.Errorf("Span(%d) = %d, %d; want %d, %d", i, start, end, want[0], want[1])
+		}
+		if got := m.TokenAt(want[0]); got != i {
+			t.Errorf("TokenAt(Span(%d)) = %d", i, got)
+		}
+	}
+
+	empty, err := NewTokenMap(tok, "")
+	if err != nil {
+		t.Fatalf("NewTokenMap(\"\"): %v", err)
+	}
+	if got := empty.TokenAt(0); got != -1 {
+		t.Errorf("TokenAt(0) of an empty input = %d, want -1", got)
+	}
+}
+
+func TestTokenMap_Lines(t *testing.T) {
+	tok, err := GetTokenizer("runes")
+	if err != nil {
+		t.Fatalf("GetTokenizer('runes'): %v", err)
+	}
+	m, err := NewTokenMap(tok, "ab\n\nçd\n")
+	if err != nil {
+		t.Fatalf("NewTokenMap: %v", err)
+	}
+	if got := m.Lines(); got != 4 {
+		t.Errorf("Lines() = %d, want 4", got)
+	}
+
+	// Tokens: 0 "a", 1 "b", 2 "\n", 3 "\n", 4 "ç", 5 "d", 6 "\n"
+	for line, want := range [][2]int{{0, 3}, {3, 4}, {4, 7}, {7, 7}, {0, 0}} {
+		if first, end := m.LineTokens(line); first != want[0] || end != want[1] {
+			t.Errorf("LineTokens(%d) = %d, %d; want %d, %d", line, first, end, want[0], want[1])
+		}
+	}
+
+	tests := []struct {
+		line, column, offset, token int
+	}{
+		{0, 0, 0, 0},
+		{0, 2, 2, 2},
+		{1, 0, 3, 3},
+		{2, 1, 5, 4}, // second byte of "ç"
+		{2, 3, 7, 6},
+		{3, 0, 8, -1},
+	}
+	for _, tt := range tests {
+		if got := m.TokenAtPosition(tt.line, tt.column); got != tt.token {
+			t.Errorf("TokenAtPosition(%d, %d) = %d, want %d", tt.line, tt.column, got, tt.token)
+		}
+		if line, column := m.Position(tt.offset); line != tt.line || column != tt.column {
+			t.Errorf("Position(%d) = %d, %d; want %d, %d", tt.offset, line, column, tt.line, tt.column)
+		}
+	}
+	for _, pos := range [][2]int{{0, 3}, {2, 4}, {4, 0}, {-1, 0}} {
+		if got := m.TokenAtPosition(pos[0], pos[1]); got != -1 {
+			t.Errorf("TokenAtPosition(%d, %d) = %d, want -1", pos[0], pos[1], got)
+		}
+	}
+}
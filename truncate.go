@@ -0,0 +1,92 @@
+// Copyright 2023 Peter Hebert. Licensed under the MIT license.
+
+package gotoken
+
+import (
+	"sort"
+	"unicode/utf8"
+)
+
+// TruncateOption is an option for [Truncate] and [TruncateStart], such as
+// [KeepGraphemes].
+type TruncateOption func(*truncateOptions)
+
+// truncateOptions collects the TruncateOptions passed to Truncate.
+type truncateOptions struct {
+	graphemes bool
+}
+
+// KeepGraphemes is an option for [Truncate] and [TruncateStart] that only cuts
+// text between grapheme clusters, the characters as a user perceives them. A
+// character made of several code points, like an emoji of a family, a flag, or
+// a letter with a combining accent, is then kept or dropped as a whole, at the
+// cost of a few more tokens left unused.
+func KeepGraphemes() TruncateOption {
+	return func(opts *truncateOptions) {
+		opts.graphemes = true
+	}
+}
+
+// Truncate returns the longest beginning of text that encodes to at most
+// maxTokens tokens, cut where a token starts a new character, so the result is
+// valid UTF-8 if text is. With [KeepGraphemes], it is cut between grapheme
+// clusters instead. If text already fits, it is returned unchanged. Special
+// tokens in text are handled as they are by [Tokenizer.Encode].
+func Truncate(tok Tokenizer, text string, maxTokens int, opts ...TruncateOption) (string, error) {
+	tokens, offsets, err := tok.EncodeWithOffsets(text)
+	if err != nil || len(tokens) <= maxTokens {
+		return text, err
+	}
+	if maxTokens <= 0 {
+		return "", nil
+	}
+	cuts := truncateCuts(text, offsets, opts)
+	for i := sort.SearchInts(cuts, offsets[maxTokens]+1) - 1; i > 0; i-- {
+		// Encoded on its own, the result can split into tokens differently
+		// at the cut, so check that it still fits
+		if ret := text[:cuts[i]]; tok.Count(ret) <= maxTokens {
+			return ret, nil
+		}
+	}
+	return "", nil
+}
+
+// TruncateStart is like [Truncate], but keeps the end of text instead, like
+// the most recent part of a conversation or log.
+func TruncateStart(tok Tokenizer, text string, maxTokens int, opts ...TruncateOption) (string, error) {
+	tokens, offsets, err := tok.EncodeWithOffsets(text)
+	if err != nil || len(tokens) <= maxTokens {
+		return text, err
+	}
+	if maxTokens <= 0 {
+		return "", nil
+	}
+	cuts := truncateCuts(text, offsets, opts)
+	for i := sort.SearchInts(cuts, offsets[len(tokens)-maxTokens]); i < len(cuts)-1; i++ {
+		if ret := text[cuts[i]:]; tok.Count(ret) <= maxTokens {
+			return ret, nil
+		}
+	}
+	return "", nil
+}
+
+// truncateCuts returns the offsets where text may be cut, in ascending order,
+// from 0 to len(text). By default, these are the offsets of the tokens that
+// start a new character; with KeepGraphemes, they are the grapheme cluster
+// boundaries, which need not be at the start of a token.
+func truncateCuts(text string, offsets []int, opts []TruncateOption) []int {
+	var o truncateOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+	if o.graphemes {
+		return graphemeBoundaries(text)
+	}
+	cuts := make([]int, 1, len(offsets)+1)
+	for _, offset := range offsets {
+		if offset > 0 && utf8.RuneStart(text[offset]) {
+			cuts = append(cuts, offset)
+		}
+	}
+	return append(cuts, len(text))
+}
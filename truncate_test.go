@@ -0,0 +1,69 @@
+// Copyright 2023 Peter Hebert. Licensed under the MIT license.
+
+package gotoken
+
+import "testing"
+
+func TestTruncate(t *testing.T) {
+	tok, err := GetTokenizer("runes")
+	if err != nil {
+		t.Fatalf("GetTokenizer('runes'): %v", err)
+	}
+
+	// The runes tokenizer has one token per rune, so maxTokens is in runes
+	tests := []struct {
+		text      string
+		maxTokens int
+		want      string
+		wantStart string
+	}{
+		{"", 3, "", ""},
+		{"abc", 3, "abc", "abc"},
+		{"abcdef", 3, "abc", "def"},
+		{"日本語です", 2, "日本", "です"},
+		{"abc", 0, "", ""},
+		{"abc", -1, "", ""},
+	}
+	for _, tt := range tests {
+		if got, err := Truncate(tok, tt.text, tt.maxTokens); got != tt.want || err != nil {
+			t.Errorf("Truncate(%q, %d) = %q, %v; want %q", tt.text, tt.maxTokens, got, err, tt.want)
+		}
+		if got, err := TruncateStart(tok, tt.text, tt.maxTokens); got != tt.wantStart || err != nil {
+			t.Errorf("TruncateStart(%q, %d) = %q, %v; want %q", tt.text, tt.maxTokens, got, err, tt.wantStart)
+		}
+	}
+}
+
+func TestTruncateKeepGraphemes(t *testing.T) {
+	tok, err := GetTokenizer("runes")
+	if err != nil {
+		t.Fatalf("GetTokenizer('runes'): %v", err)
+	}
+
+	family := "\U0001F468\u200D\U0001F469\u200D\U0001F467" // 👨‍👩‍👧, 5 runes
+	tests := []struct {
+		text      string
+		maxTokens int
+		want      string
+		wantStart string
+	}{
+		{"ab" + family, 5, "ab", family},
+		{family + "ab", 6, family + "a", "ab"},
+		{"ae\u0301e\u0301", 4, "ae\u0301", "e\u0301e\u0301"},
+		{"\U0001F1EB\U0001F1F7\U0001F1EF\U0001F1F5", 3, "\U0001F1EB\U0001F1F7", "\U0001F1EF\U0001F1F5"},
+		{family, 4, "", ""},
+	}
+	for _, tt := range tests {
+		if got, err := Truncate(tok, tt.text, tt.maxTokens, KeepGraphemes()); got != tt.want || err != nil {
+			t.Errorf("Truncate(%q, %d, KeepGraphemes()) = %q, %v; want %q", tt.text, tt.maxTokens, got, err, tt.want)
+		}
+		if got, err := TruncateStart(tok, tt.text, tt.maxTokens, KeepGraphemes()); got != tt.wantStart || err != nil {
+			t.Errorf("TruncateStart(%q, %d, KeepGraphemes()) = %q, %v; want %q", tt.text, tt.maxTokens, got, err, tt.wantStart)
+		}
+	}
+
+	// Without the option, the family is cut apart
+	if got, _ := Truncate(tok, "ab"+family, 4); got != "ab\U0001F468\u200D" {
+		t.Errorf("Truncate without KeepGraphemes = %q, want the family cut after the first ZWJ", got)
+	}
+}
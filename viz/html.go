@@ -0,0 +1,86 @@
+// Copyright 2023 Peter Hebert. Licensed under the MIT license.
+
+package viz
+
+import (
+	"html"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/peterheb/gotoken"
+)
+
+// HTMLOption is an option for [HTML] and [RenderHTML], such as [WithClasses].
+type HTMLOption func(*htmlOptions)
+
+// htmlOptions collects the HTMLOptions passed to HTML.
+type htmlOptions struct {
+	classes []string
+}
+
+// WithClasses is an option for [HTML] that gives the span of each token a class
+// attribute, cycling through classes for consecutive tokens, so that a style
+// sheet can color neighboring tokens differently.
+func WithClasses(classes ...string) HTMLOption {
+	return func(opts *htmlOptions) {
+		opts.classes = classes
+	}
+}
+
+// HTML renders input as HTML with a span for each token, given the tokens and
+// offsets returned by EncodeWithOffsets, for web-based prompt inspectors. Each
+// span has a data-token-id attribute with the token value, and contains the
+// text of the token's [Segment], escaped. A token that lies entirely within a
+// character has an empty span. Bytes of input that are not valid UTF-8 are
+// rendered as U+FFFD, the Unicode replacement character.
+//
+// Line breaks and runs of spaces are kept as they are, so the result should be
+// displayed in a <pre> element, or one styled with white-space: pre-wrap.
+func HTML(input string, tokens, offsets []int, opts ...HTMLOption) string {
+	var o htmlOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+	var sb strings.Builder
+	for _, seg := range Segments(input, tokens, offsets) {
+		sb.WriteString(`<span data-token-id="`)
+		sb.WriteString(strconv.Itoa(seg.Token))
+		if len(o.classes) > 0 {
+			sb.WriteString(`" class="`)
+			sb.WriteString(html.EscapeString(o.classes[seg.Index%len(o.classes)]))
+		}
+		sb.WriteString(`">`)
+		sb.WriteString(html.EscapeString(validUTF8(seg.Text)))
+		sb.WriteString("</span>")
+	}
+	return sb.String()
+}
+
+// RenderHTML encodes input with tok and returns it rendered by [HTML].
+func RenderHTML(tok gotoken.Tokenizer, input string, opts ...HTMLOption) (string, error) {
+	tokens, offsets, err := tok.EncodeWithOffsets(input)
+	if err != nil {
+		return "", err
+	}
+	return HTML(input, tokens, offsets, opts...), nil
+}
+
+// validUTF8 returns s with each byte that is not part of a valid UTF-8
+// character replaced by U+FFFD.
+func validUTF8(s string) string {
+	if utf8.ValidString(s) {
+		return s
+	}
+	var sb strings.Builder
+	for i := 0; i < len(s); {
+		r, size := utf8.DecodeRuneInString(s[i:])
+		if r == utf8.RuneError && size == 1 {
+			sb.WriteRune(utf8.RuneError)
+		} else {
+			sb.WriteString(s[i : i+size])
+		}
+		i += size
+	}
+	return sb.String()
+}
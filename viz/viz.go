@@ -0,0 +1,113 @@
+// Copyright 2023 Peter Hebert. Licensed under the MIT license.
+
+// Package viz renders the token boundaries of a string for display, which is
+// useful when debugging prompts. Its input is the output of
+// [gotoken.Tokenizer.EncodeWithOffsets].
+//
+// Example of printing a string with each token highlighted in a terminal:
+//
+//	out, err := viz.Render(tok, "Salutations, world!")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Println(out)
+package viz
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/peterheb/gotoken"
+)
+
+// Palette lists the ANSI 256-color background colors that [ANSI] cycles
+// through for consecutive tokens. Text is drawn in black.
+var Palette = []int{153, 223, 194, 218, 230}
+
+// Segment is the part of an input string that corresponds to one token.
+type Segment struct {
+	Index int    // index of the token in the encoded output
+	Token int    // the token value
+	Start int    // byte offset in the input where Text begins
+	End   int    // byte offset in the input where Text ends
+	Text  string // input[Start:End]
+}
+
+// Segments splits input into one Segment per token, given the tokens and
+// offsets returned by EncodeWithOffsets. Segments can be styled by the caller,
+// for example by alternating colors based on Index.
+//
+// Tokens may begin or end in the middle of a multi-byte UTF-8 character, which
+// cannot be displayed in two pieces. These boundaries are moved forward to the
+// start of the next character, so a character belongs to the segment where it
+// begins, and a token that lies entirely within a character has empty Text.
+func Segments(input string, tokens, offsets []int) []Segment {
+	segments := make([]Segment, len(tokens))
+	for i, token := range tokens {
+		start := runeStart(input, offsets[i])
+		end := len(input)
+		if i+1 < len(offsets) {
+			end = runeStart(input, offsets[i+1])
+		}
+		segments[i] = Segment{
+			Index: i,
+			Token: token,
+			Start: start,
+			End:   end,
+			Text:  input[start:end],
+		}
+	}
+	return segments
+}
+
+// runeStart returns ofs if it is the start of a UTF-8 character in s, or
+// otherwise the offset of the next character. Invalid bytes count as
+// characters of their own.
+func runeStart(s string, ofs int) int {
+	for i := ofs; i > 0 && i > ofs-utf8.UTFMax; i-- {
+		if utf8.RuneStart(s[i-1]) {
+			_, size := utf8.DecodeRuneInString(s[i-1:])
+			if i-1+size > ofs {
+				return i - 1 + size
+			}
+			break
+		}
+	}
+	return ofs
+}
+
+// ANSI renders input with alternating ANSI background colors from [Palette]
+// for each token, given the tokens and offsets returned by EncodeWithOffsets.
+// Colors are reset around line breaks, so that the highlight does not extend
+// to the edge of the terminal.
+func ANSI(input string, tokens, offsets []int) string {
+	var sb strings.Builder
+	for _, seg := range Segments(input, tokens, offsets) {
+		if seg.Text == "" {
+			continue
+		}
+		color := fmt.Sprintf("\x1b[30;48;5;%dm", Palette[seg.Index%len(Palette)])
+		lines := strings.Split(seg.Text, "\n")
+		for i, line := range lines {
+			if i > 0 {
+				sb.WriteString("\n")
+			}
+			if line != "" {
+				sb.WriteString(color)
+				sb.WriteString(line)
+				sb.WriteString("\x1b[0m")
+			}
+		}
+	}
+	return sb.String()
+}
+
+// Render encodes input with tok and returns it rendered by [ANSI].
+func Render(tok gotoken.Tokenizer, input string) (string, error) {
+	tokens, offsets, err := tok.EncodeWithOffsets(input)
+	if err != nil {
+		return "", err
+	}
+	return ANSI(input, tokens, offsets), nil
+}
@@ -0,0 +1,101 @@
+// Copyright 2023 Peter Hebert. Licensed under the MIT license.
+
+package viz_test
+
+import (
+	"testing"
+
+	"github.com/peterheb/gotoken"
+	_ "github.com/peterheb/gotoken/r50kbase"
+	"github.com/peterheb/gotoken/viz"
+)
+
+func TestSegments(t *testing.T) {
+	tok, err := gotoken.GetTokenizer("r50k_base")
+	if err != nil {
+		t.Fatalf("instantiating tokenizer: %v", err)
+	}
+
+	// The emoji is split across the last two tokens: " \xf0\x9f\x98", "\x84"
+	input := "Salutations, world! 😄"
+	tokens, offsets, err := tok.EncodeWithOffsets(input)
+	if err != nil {
+		t.Fatalf("EncodeWithOffsets(%q): %v", input, err)
+	}
+	want := []string{"Sal", "utations", ",", " world", "!", " 😄", ""}
+	segments := viz.Segments(input, tokens, offsets)
+	if len(segments) != len(want) {
+		t.Fatalf("Segments() returned %d segments, want %d", len(segments), len(want))
+	}
+	for i, seg := range segments {
+		if seg.Text != want[i] || seg.Token != tokens[i] || seg.Index != i || input[seg.Start:seg.End] != seg.Text {
+			t.Errorf("Segments()[%d] = %+v, want Text %q", i, seg, want[i])
+		}
+	}
+}
+
+func TestRender(t *testing.T) {
+	tok, err := gotoken.GetTokenizer("r50k_base")
+	if err != nil {
+		t.Fatalf("instantiating tokenizer: %v", err)
+	}
+
+	got, err := viz.Render(tok, "a b\nc")
+	if err != nil {
+		t.Fatalf("Render: %v", err)
+	}
+	want := "\x1b[30;48;5;153ma\x1b[0m\x1b[30;48;5;223m b\x1b[0m\n\x1b[30;48;5;218mc\x1b[0m"
+	if got != want {
+		t.Errorf("Render() = %q, want %q", got, want)
+	}
+
+	if _, err := viz.Render(tok, "<|endoftext|>"); err == nil {
+		t.Errorf("Render() did not return error for disallowed special token")
+	}
+}
+
+func TestHTML(t *testing.T) {
+	tok, err := gotoken.GetTokenizer("r50k_base")
+	if err != nil {
+		t.Fatalf("instantiating tokenizer: %v", err)
+	}
+
+	got, err := viz.RenderHTML(tok, `a<b> "c"`, viz.WithClasses("even", "odd"))
+	if err != nil {
+		t.Fatalf("RenderHTML: %v", err)
+	}
+	// "a", "<", "b", ">", " \"", "c", "\""
+	want := `<span data-token-id="64" class="even">a</span>` +
+		`<span data-token-id="27" class="odd">&lt;</span>` +
+		`<span data-token-id="65" class="even">b</span>` +
+		`<span data-token-id="29" class="odd">&gt;</span>` +
+		`<span data-token-id="366" class="even"> &#34;</span>` +
+		`<span data-token-id="66" class="odd">c</span>` +
+		`<span data-token-id="1" class="even">&#34;</span>`
+	if got != want {
+		t.Errorf("RenderHTML() =\n%s\nwant\n%s", got, want)
+	}
+
+	// The second token of the emoji lies within it, and has an empty span
+	input := "! 😄"
+	tokens, offsets, err := tok.EncodeWithOffsets(input)
+	if err != nil {
+		t.Fatalf("EncodeWithOffsets(%q): %v", input, err)
+	}
+	got = viz.HTML(input, tokens, offsets)
+	want = `<span data-token-id="0">!</span><span data-token-id="30325"> 😄</span><span data-token-id="226"></span>`
+	if got != want {
+		t.Errorf("HTML(%q) = %s, want %s", input, got, want)
+	}
+
+	// Invalid UTF-8 is replaced, byte by byte
+	got = viz.HTML("a\xff\xfe", []int{1, 2}, []int{0, 1})
+	want = `<span data-token-id="1">a</span><span data-token-id="2">` + "��" + `</span>`
+	if got != want {
+		t.Errorf("HTML() of invalid UTF-8 = %q, want %q", got, want)
+	}
+
+	if _, err := viz.RenderHTML(tok, "<|endoftext|>"); err == nil {
+		t.Errorf("RenderHTML() did not return error for disallowed special token")
+	}
+}
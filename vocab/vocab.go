@@ -0,0 +1,101 @@
+// Copyright 2023 Peter Hebert. Licensed under the MIT license.
+
+// Package vocab lets packages outside of gotoken provide their own BPE
+// encodings. The encoding data is generated from a .tiktoken file with
+// gotoken-gen's -standalone flag, which writes a data.go that embeds it:
+//
+//	gotoken-gen -standalone -encoding my_vocab -source my_vocab.tiktoken -out ./myvocab
+//
+// The package then registers the encoding with gotoken in an init function,
+// after which gotoken.GetTokenizer("my_vocab") returns a tokenizer for it:
+//
+//	func init() {
+//	    vocab.Register(vocab.Encoding{
+//	        Name:          "my_vocab",
+//	        Data:          encodingData,
+//	        DataInfo:      encodingDataInfo,
+//	        Splitter:      vocab.GPT2Splitter,
+//	        SpecialTokens: map[string]int{"<|endoftext|>": 50256},
+//	    })
+//	}
+package vocab
+
+import (
+	"fmt"
+
+	"github.com/peterheb/gotoken"
+	"github.com/peterheb/gotoken/internal"
+)
+
+// Span is the byte range [Start, End) of one part of a splitter's input.
+type Span = internal.Span
+
+// Splitter splits input into the parts (pre-tokens) that are byte-pair encoded
+// separately, appending the span of each part to dst and returning the
+// extended slice. The spans must cover input in order, without gaps.
+type Splitter func(dst []Span, input []byte) []Span
+
+// These are the splitters of the encodings in gotoken, for encodings that
+// pre-tokenize text the same way.
+var (
+	GPT2Splitter       Splitter = internal.GPT2SpanSplitter       // r50k_base and p50k_base
+	CL100KBaseSplitter Splitter = internal.CL100KBaseSpanSplitter // cl100k_base
+	O200KBaseSplitter  Splitter = internal.O200KBaseSpanSplitter  // o200k_base
+)
+
+// Encoding describes an encoding to register with [Register].
+type Encoding struct {
+	Name          string           // the name to register, like "my_vocab"
+	Data          []byte           // the data written by gotoken-gen, gzipped or not
+	DataInfo      gotoken.DataInfo // the source of Data, also written by gotoken-gen
+	Splitter      Splitter         // the pre-tokenizer of the encoding
+	SpecialTokens map[string]int   // the special tokens of the encoding, if any
+}
+
+// Register registers e with [gotoken.RegisterTokenizer]. The data is decoded
+// the first time a tokenizer for e is created, and an error in it is returned
+// by [gotoken.GetTokenizer]. The gotoken.WithDataFile and gotoken.WithDataFS
+// options work as they do for gotoken's own encodings, so e.Data may be nil if
+// they are always used.
+func Register(e Encoding) {
+	// the complete params, shared by every tokenizer of e
+	var cache internal.ParamsCache
+	gotoken.RegisterTokenizer(e.Name, func(opts gotoken.TokenizerOptions) (gotoken.Tokenizer, error) {
+		if e.Splitter == nil {
+			return nil, fmt.Errorf("encoding %q has no splitter", e.Name)
+		}
+		params, err := cache.Get(opts, func() (internal.BPEParams, error) {
+			var p internal.BPEParams
+			var err error
+			if opts.DataPath != "" {
+				p, err = internal.ExternalParams(opts.DataFS, opts.DataPath, e.Name)
+			} else {
+				p, err = loadParams(e)
+				p.DataInfo = e.DataInfo
+			}
+			p.Name = e.Name
+			p.Splitter = e.Splitter
+			p.SpecialTokens = e.SpecialTokens
+			return p, err
+		})
+		if err != nil {
+			return nil, err
+		}
+		return internal.NewBPETokenizer(params, opts)
+	})
+}
+
+// loadParams decodes the embedded data of e.
+func loadParams(e Encoding) (internal.BPEParams, error) {
+	if e.Data == nil {
+		return internal.BPEParams{}, fmt.Errorf("%s has no embedded data; use gotoken.WithDataFile", e.Name)
+	}
+	d, err := internal.InflateEncodingData(e.Data)
+	if err != nil {
+		return internal.BPEParams{}, fmt.Errorf("loading %s data: %w", e.Name, err)
+	}
+	if d.Name != e.Name {
+		return internal.BPEParams{}, fmt.Errorf("embedded data is for %q, not %q", d.Name, e.Name)
+	}
+	return d.Params(), nil
+}
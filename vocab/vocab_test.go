@@ -0,0 +1,33 @@
+// Copyright 2023 Peter Hebert. Licensed under the MIT license.
+
+package vocab_test
+
+import (
+	"testing"
+
+	"github.com/peterheb/gotoken"
+	"github.com/peterheb/gotoken/vocab"
+)
+
+// TestRegisterErrors checks that problems with a registered encoding are
+// reported by GetTokenizer. Registering working encodings is tested with the
+// data generated in cmd/gotoken-gen.
+func TestRegisterErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		e    vocab.Encoding
+	}{
+		{"no data", vocab.Encoding{Name: "vocab_no_data", Splitter: vocab.GPT2Splitter}},
+		{"bad data", vocab.Encoding{Name: "vocab_bad_data", Data: []byte("not data"), Splitter: vocab.GPT2Splitter}},
+		{"no splitter", vocab.Encoding{Name: "vocab_no_splitter"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			vocab.Register(tt.e)
+			defer gotoken.UnregisterTokenizer(tt.e.Name)
+			if _, err := gotoken.GetTokenizer(tt.e.Name); err == nil {
+				t.Errorf("GetTokenizer(%q) did not return an error", tt.e.Name)
+			}
+		})
+	}
+}